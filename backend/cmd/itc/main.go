@@ -0,0 +1,88 @@
+// Command itc runs a scheduler on an ITC-2007 curriculum-based timetabling instance and reports
+// the result in the competition's terms.
+//
+//	go run ./cmd/itc [-algorithm greedy|csp] [-max-duration ms] [-solution out.sol] instance.ctt
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/itc"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/csp"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+func main() {
+	algorithm := flag.String("algorithm", string(scheduler.AlgorithmGreedy), "scheduler to run: greedy or csp")
+	maxDuration := flag.Int("max-duration", 0, "time limit in milliseconds, 0 for none")
+	solution := flag.String("solution", "", "file to write the solution to, in the competition's format")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: itc [flags] instance.ctt")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	var s scheduler.Scheduler
+	switch scheduler.Algorithm(*algorithm) {
+	case scheduler.AlgorithmGreedy:
+		s = greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	case scheduler.AlgorithmCSP:
+		s = csp.NewCSPScheduler()
+	default:
+		log.Fatalf("unknown algorithm %q", *algorithm)
+	}
+
+	file, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	instance, err := itc.Parse(file)
+	file.Close()
+	if err != nil {
+		log.Fatalf("%s: %v", flag.Arg(0), err)
+	}
+
+	config := scheduler.Config{Algorithm: scheduler.Algorithm(*algorithm), MaxDuration: *maxDuration}
+	output, err := s.Generate(context.Background(), instance.Input(config))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	assignments, err := instance.Assign(output.ScheduledSessions)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *solution != "" {
+		out, err := os.Create(*solution)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := itc.WriteSolution(out, assignments); err != nil {
+			log.Fatal(err)
+		}
+		if err := out.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	fmt.Printf("Instance %s: %d courses, %d rooms, %d days of %d periods\n",
+		instance.Name, len(instance.Courses), len(instance.Rooms), instance.Days, instance.PeriodsPerDay)
+	fmt.Printf("Scheduled %d lectures with %s", len(assignments), *algorithm)
+	if output.Incomplete {
+		fmt.Print(", stopped at the time limit")
+	}
+	fmt.Print("\n\n")
+
+	if err := instance.Evaluate(assignments).Write(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
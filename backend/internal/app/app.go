@@ -15,8 +15,11 @@ import (
 
 	appmiddleware "github.com/TerrenceMurray/course-scheduler/internal/middleware"
 	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/csp"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/jobs"
 	"github.com/TerrenceMurray/course-scheduler/internal/service"
 )
 
@@ -25,15 +28,28 @@ type App struct {
 	DB     *sql.DB
 	Router chi.Router
 	Logger *zap.Logger
+	Jobs   *jobs.Manager
 
 	// Services
-	BuildingService      service.BuildingServiceInterface
-	CourseService        service.CourseServiceInterface
-	CourseSessionService service.CourseSessionServiceInterface
-	RoomService          service.RoomServiceInterface
-	RoomTypeService      service.RoomTypeServiceInterface
-	ScheduleService      service.ScheduleServiceInterface
-	SchedulerService     service.SchedulerServiceInterface
+	BuildingService          service.BuildingServiceInterface
+	BundleService            service.BundleServiceInterface
+	CalendarService          service.CalendarServiceInterface
+	CalendarFeedService      service.CalendarFeedServiceInterface
+	BuildingDistanceService  service.BuildingDistanceServiceInterface
+	CohortService            service.CohortServiceInterface
+	ImportService            service.ImportServiceInterface
+	CourseService            service.CourseServiceInterface
+	CourseSessionService     service.CourseSessionServiceInterface
+	InstructorService        service.InstructorServiceInterface
+	RoomService              service.RoomServiceInterface
+	RoomBlackoutService      service.RoomBlackoutServiceInterface
+	RoomTypeService          service.RoomTypeServiceInterface
+	ScheduleService          service.ScheduleServiceInterface
+	SchedulerService         service.SchedulerServiceInterface
+	SchedulerJobService      service.SchedulerJobServiceInterface
+	SessionConstraintService service.SessionConstraintServiceInterface
+	TermService              service.TermServiceInterface
+	TimetableService         service.TimetableServiceInterface
 }
 
 // New initializes the application with all dependencies
@@ -56,24 +72,46 @@ func New(cfg *Config) (*App, error) {
 
 	// Initialize repositories
 	buildingRepo := repository.NewBuildingRepository(db, logger)
+	buildingDistanceRepo := repository.NewBuildingDistanceRepository(db, logger)
+	calendarFeedRepo := repository.NewCalendarFeedRepository(db, logger)
+	cohortRepo := repository.NewCohortRepository(db, logger)
 	courseRepo := repository.NewCourseRepository(db, logger)
 	courseSessionRepo := repository.NewCourseSessionRepository(db, logger)
+	instructorRepo := repository.NewInstructorRepository(db, logger)
 	roomRepo := repository.NewRoomRepository(db, logger)
+	roomBlackoutRepo := repository.NewRoomBlackoutRepository(db, logger)
 	roomTypeRepo := repository.NewRoomTypeRepository(db, logger)
 	scheduleRepo := repository.NewScheduleRepository(db, logger)
+	sessionConstraintRepo := repository.NewSessionConstraintRepository(db, logger)
+	termRepo := repository.NewTermRepository(db, logger)
 
 	// Initialize services
 	buildingService := service.NewBuildingService(buildingRepo)
+	buildingDistanceService := service.NewBuildingDistanceService(buildingDistanceRepo, buildingRepo)
+	bundleService := service.NewBundleService(roomTypeRepo, buildingRepo, buildingDistanceRepo, roomRepo, roomBlackoutRepo, instructorRepo, courseRepo, termRepo, courseSessionRepo, sessionConstraintRepo, cohortRepo, scheduleRepo)
+	calendarService := service.NewCalendarService(scheduleRepo, termRepo, roomRepo, buildingRepo, courseRepo, courseSessionRepo, instructorRepo)
+	calendarFeedService := service.NewCalendarFeedService(calendarFeedRepo, scheduleRepo, calendarService)
+	cohortService := service.NewCohortService(cohortRepo)
 	courseService := service.NewCourseService(courseRepo)
 	courseSessionService := service.NewCourseSessionService(courseSessionRepo)
+	importService := service.NewImportService(buildingRepo, roomTypeRepo, roomRepo, courseRepo, courseSessionRepo, instructorRepo, termRepo)
+	instructorService := service.NewInstructorService(instructorRepo)
 	roomService := service.NewRoomService(roomRepo)
+	roomBlackoutService := service.NewRoomBlackoutService(roomBlackoutRepo)
 	roomTypeService := service.NewRoomTypeService(roomTypeRepo)
 	scheduleService := service.NewScheduleService(scheduleRepo)
+	sessionConstraintService := service.NewSessionConstraintService(sessionConstraintRepo)
+	termService := service.NewTermService(termRepo, scheduleRepo)
+	timetableService := service.NewTimetableService(scheduleRepo, roomRepo, buildingRepo, courseRepo, courseSessionRepo, instructorRepo)
 
 	// Initialize scheduler
 	weightStrategy := &weight.TotalTimeWeight{}
-	scheduler := greedy.NewGreedyScheduler(weightStrategy)
-	schedulerService := service.NewSchedulerService(scheduler, scheduleRepo, roomRepo, courseRepo, courseSessionRepo)
+	greedyScheduler := greedy.NewGreedyScheduler(weightStrategy)
+	schedulerService := service.NewSchedulerService(greedyScheduler, scheduleRepo, roomRepo, courseRepo, courseSessionRepo, cohortRepo, instructorRepo, roomBlackoutRepo, buildingDistanceRepo, sessionConstraintRepo, termRepo).
+		RegisterAlgorithm(scheduler.AlgorithmGreedy, greedyScheduler).
+		RegisterAlgorithm(scheduler.AlgorithmCSP, csp.NewCSPScheduler())
+	jobManager := jobs.NewManager(cfg.SchedulerWorkers, jobs.DefaultQueueSize, jobs.DefaultRetention)
+	schedulerJobService := service.NewSchedulerJobService(schedulerService, jobManager)
 
 	// Initialize router
 	router := chi.NewRouter()
@@ -97,17 +135,30 @@ func New(cfg *Config) (*App, error) {
 	router.Use(middleware.RequestID)
 
 	app := &App{
-		Config:               cfg,
-		DB:                   db,
-		Router:               router,
-		Logger:               logger,
-		BuildingService:      buildingService,
-		CourseService:        courseService,
-		CourseSessionService: courseSessionService,
-		RoomService:          roomService,
-		RoomTypeService:      roomTypeService,
-		ScheduleService:      scheduleService,
-		SchedulerService:     schedulerService,
+		Config:                   cfg,
+		DB:                       db,
+		Router:                   router,
+		Logger:                   logger,
+		Jobs:                     jobManager,
+		BuildingService:          buildingService,
+		BuildingDistanceService:  buildingDistanceService,
+		BundleService:            bundleService,
+		CalendarService:          calendarService,
+		CalendarFeedService:      calendarFeedService,
+		CohortService:            cohortService,
+		CourseService:            courseService,
+		CourseSessionService:     courseSessionService,
+		ImportService:            importService,
+		InstructorService:        instructorService,
+		RoomService:              roomService,
+		RoomBlackoutService:      roomBlackoutService,
+		RoomTypeService:          roomTypeService,
+		ScheduleService:          scheduleService,
+		SchedulerService:         schedulerService,
+		SchedulerJobService:      schedulerJobService,
+		SessionConstraintService: sessionConstraintService,
+		TermService:              termService,
+		TimetableService:         timetableService,
 	}
 
 	app.setupRoutes()
@@ -122,6 +173,9 @@ func (a *App) Run() error {
 
 // Close cleans up resources
 func (a *App) Close() error {
+	if a.Jobs != nil {
+		a.Jobs.Close()
+	}
 	if a.Logger != nil {
 		a.Logger.Sync()
 	}
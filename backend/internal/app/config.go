@@ -1,12 +1,17 @@
 package app
 
-import "os"
+import (
+	"os"
+	"strconv"
+)
 
 type Config struct {
 	Addr         string // :8080
 	DatabaseURL  string // pg connection string
 	JWTSecretKey string
 	CORSOrigin   string // Allowed CORS origin
+
+	SchedulerWorkers int // Background schedule generation jobs run at once (0 = default)
 }
 
 func LoadConfig() *Config {
@@ -14,6 +19,7 @@ func LoadConfig() *Config {
 	databaseURL := os.Getenv("DATABASE_URL")
 	jwtSecretKey := os.Getenv("JWT_SECRET_KEY")
 	corsOrigin := os.Getenv("CORS_ORIGIN")
+	schedulerWorkers, _ := strconv.Atoi(os.Getenv("SCHEDULER_WORKERS"))
 
 	if address == "" {
 		address = ":8080"
@@ -32,5 +38,7 @@ func LoadConfig() *Config {
 		DatabaseURL:  databaseURL,
 		JWTSecretKey: jwtSecretKey,
 		CORSOrigin:   corsOrigin,
+
+		SchedulerWorkers: schedulerWorkers,
 	}
 }
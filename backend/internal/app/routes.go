@@ -13,12 +13,24 @@ import (
 func (a *App) setupRoutes() {
 	// Initialize handlers
 	buildingHandler := handlers.NewBuildingHandler(a.BuildingService)
+	buildingDistanceHandler := handlers.NewBuildingDistanceHandler(a.BuildingDistanceService)
+	bundleHandler := handlers.NewBundleHandler(a.BundleService)
+	calendarHandler := handlers.NewCalendarHandler(a.CalendarService)
+	calendarFeedHandler := handlers.NewCalendarFeedHandler(a.CalendarFeedService)
+	cohortHandler := handlers.NewCohortHandler(a.CohortService)
 	courseHandler := handlers.NewCourseHandler(a.CourseService)
 	courseSessionHandler := handlers.NewCourseSessionHandler(a.CourseSessionService)
+	importHandler := handlers.NewImportHandler(a.ImportService)
+	instructorHandler := handlers.NewInstructorHandler(a.InstructorService)
 	roomHandler := handlers.NewRoomHandler(a.RoomService)
+	roomBlackoutHandler := handlers.NewRoomBlackoutHandler(a.RoomBlackoutService)
 	roomTypeHandler := handlers.NewRoomTypeHandler(a.RoomTypeService)
-	scheduleHandler := handlers.NewScheduleHandler(a.ScheduleService)
+	scheduleHandler := handlers.NewScheduleHandler(a.ScheduleService, a.SchedulerService)
 	schedulerHandler := handlers.NewSchedulerHandler(a.SchedulerService)
+	schedulerJobHandler := handlers.NewSchedulerJobHandler(a.SchedulerJobService)
+	sessionConstraintHandler := handlers.NewSessionConstraintHandler(a.SessionConstraintService)
+	termHandler := handlers.NewTermHandler(a.TermService)
+	timetableHandler := handlers.NewTimetableHandler(a.TimetableService)
 
 	// Health check endpoint (no auth required)
 	a.Router.Get("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -27,8 +39,25 @@ func (a *App) setupRoutes() {
 		w.Write([]byte(`{"status":"ok"}`))
 	})
 
+	// Calendar feeds are public so calendar clients can poll them; the share token in the URL
+	// stands in for signing in and reads the feed as the user who shared it
+	a.Router.Group(func(r chi.Router) {
+		r.Use(middleware.ShareTokenMiddleware("token", calendarFeedHandler.Owner, a.Logger))
+		r.Use(middleware.TransactionMiddleware(a.DB))
+
+		r.Get("/feeds/{token}.ics", calendarFeedHandler.Feed)
+	})
+
 	a.Router.Route("/api/v1", func(r chi.Router) {
 
+		// Streaming routes are authenticated but run outside a transaction, which would
+		// otherwise stay open for as long as the client listens
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.AuthMiddleware(a.Config.JWTSecretKey, a.Logger))
+
+			r.Get("/scheduler/jobs/{id}/events", schedulerJobHandler.Events)
+		})
+
 		// Protected Routes
 		r.Group(func(r chi.Router) {
 			r.Use(middleware.AuthMiddleware(a.Config.JWTSecretKey, a.Logger))
@@ -41,6 +70,26 @@ func (a *App) setupRoutes() {
 				r.Get("/{id}", buildingHandler.GetByID)
 				r.Put("/{id}", buildingHandler.Update)
 				r.Delete("/{id}", buildingHandler.Delete)
+				r.Get("/{id}/distances", buildingDistanceHandler.GetByBuildingID)
+				r.Put("/{id}/distances/{toId}", buildingDistanceHandler.Set)
+				r.Delete("/{id}/distances/{toId}", buildingDistanceHandler.Delete)
+			})
+
+			// Calendar Feeds
+			r.Route("/calendar-feeds", func(r chi.Router) {
+				r.Get("/", calendarFeedHandler.List)
+				r.Post("/", calendarFeedHandler.Create)
+				r.Get("/{id}", calendarFeedHandler.GetByID)
+				r.Delete("/{id}", calendarFeedHandler.Delete)
+			})
+
+			// Cohorts
+			r.Route("/cohorts", func(r chi.Router) {
+				r.Get("/", cohortHandler.List)
+				r.Post("/", cohortHandler.Create)
+				r.Get("/{id}", cohortHandler.GetByID)
+				r.Put("/{id}", cohortHandler.Update)
+				r.Delete("/{id}", cohortHandler.Delete)
 			})
 
 			// Courses
@@ -51,6 +100,7 @@ func (a *App) setupRoutes() {
 				r.Put("/{id}", courseHandler.Update)
 				r.Delete("/{id}", courseHandler.Delete)
 				r.Get("/{id}/sessions", courseSessionHandler.GetByCourseID)
+				r.Get("/{id}/constraints", sessionConstraintHandler.GetByCourseID)
 			})
 
 			// Course Sessions
@@ -62,6 +112,30 @@ func (a *App) setupRoutes() {
 				r.Delete("/{id}", courseSessionHandler.Delete)
 			})
 
+			// Session Constraints
+			r.Route("/session-constraints", func(r chi.Router) {
+				r.Get("/", sessionConstraintHandler.List)
+				r.Post("/", sessionConstraintHandler.Create)
+				r.Get("/{id}", sessionConstraintHandler.GetByID)
+				r.Delete("/{id}", sessionConstraintHandler.Delete)
+			})
+
+			// Export and Import
+			r.Get("/export", bundleHandler.Export)
+			r.Route("/import", func(r chi.Router) {
+				r.Post("/", importHandler.Import)
+				r.Post("/bundle", bundleHandler.Restore)
+			})
+
+			// Instructors
+			r.Route("/instructors", func(r chi.Router) {
+				r.Get("/", instructorHandler.List)
+				r.Post("/", instructorHandler.Create)
+				r.Get("/{id}", instructorHandler.GetByID)
+				r.Put("/{id}", instructorHandler.Update)
+				r.Delete("/{id}", instructorHandler.Delete)
+			})
+
 			// Rooms
 			r.Route("/rooms", func(r chi.Router) {
 				r.Get("/", roomHandler.List)
@@ -69,6 +143,16 @@ func (a *App) setupRoutes() {
 				r.Get("/{id}", roomHandler.GetByID)
 				r.Put("/{id}", roomHandler.Update)
 				r.Delete("/{id}", roomHandler.Delete)
+				r.Get("/{id}/blackouts", roomBlackoutHandler.GetByRoomID)
+			})
+
+			// Room Blackouts
+			r.Route("/room-blackouts", func(r chi.Router) {
+				r.Get("/", roomBlackoutHandler.List)
+				r.Post("/", roomBlackoutHandler.Create)
+				r.Get("/{id}", roomBlackoutHandler.GetByID)
+				r.Put("/{id}", roomBlackoutHandler.Update)
+				r.Delete("/{id}", roomBlackoutHandler.Delete)
 			})
 
 			// Room Types
@@ -91,12 +175,34 @@ func (a *App) setupRoutes() {
 				r.Post("/{id}/set-active", scheduleHandler.SetActive)
 				r.Post("/{id}/archive", scheduleHandler.Archive)
 				r.Post("/{id}/unarchive", scheduleHandler.Unarchive)
+				r.Post("/{id}/optimize", schedulerHandler.Optimize)
+				r.Get("/{id}/occurrences", calendarHandler.Occurrences)
+				r.Get("/{id}/ical", calendarHandler.ICal)
+				r.Get("/{id}/grid.csv", timetableHandler.GridCSV)
+				r.Get("/{id}/grid.xlsx", timetableHandler.GridXLSX)
+				r.Get("/{id}/timetable.pdf", timetableHandler.PDF)
+				r.Get("/{id}/room-timetables.pdf", timetableHandler.RoomsPDF)
+			})
+
+			// Terms
+			r.Route("/terms", func(r chi.Router) {
+				r.Get("/", termHandler.List)
+				r.Post("/", termHandler.Create)
+				r.Get("/{id}", termHandler.GetByID)
+				r.Put("/{id}", termHandler.Update)
+				r.Delete("/{id}", termHandler.Delete)
+				r.Get("/{id}/schedules", scheduleHandler.ListByTerm)
 			})
 
 			// Scheduler
 			r.Route("/scheduler", func(r chi.Router) {
 				r.Post("/generate", schedulerHandler.Generate)
 				r.Post("/generate-and-save", schedulerHandler.GenerateAndSave)
+				r.Post("/repair", schedulerHandler.Repair)
+				r.Get("/jobs", schedulerJobHandler.List)
+				r.Post("/jobs", schedulerJobHandler.Submit)
+				r.Get("/jobs/{id}", schedulerJobHandler.GetByID)
+				r.Delete("/jobs/{id}", schedulerJobHandler.Cancel)
 			})
 		})
 	})
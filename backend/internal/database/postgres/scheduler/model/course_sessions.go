@@ -14,13 +14,18 @@ import (
 
 // Defines scheduling requirements for each course (e.g., "Calculus I needs 2 lectures and 1 tutorial per week")
 type CourseSessions struct {
-	ID               uuid.UUID `sql:"primary_key"`
-	CourseID         uuid.UUID
-	RequiredRoom     string            // Room type needed (e.g., lecture_room, computer_lab)
-	Type             CourseSessionType // Session type: lecture, lab, or tutorial
-	Duration         *int32            // Session length in minutes
-	NumberOfSessions *int32            // How many times per week this session occurs
-	CreatedAt        *time.Time
-	UpdatedAt        *time.Time
-	CreatedBy        uuid.UUID
+	ID                 uuid.UUID `sql:"primary_key"`
+	CourseID           uuid.UUID
+	RequiredRoom       string            // Room type needed (e.g., lecture_room, computer_lab)
+	Type               CourseSessionType // Session type: lecture, lab, or tutorial
+	Duration           *int32            // Session length in minutes
+	NumberOfSessions   *int32            // How many times per week this session occurs
+	CreatedAt          *time.Time
+	UpdatedAt          *time.Time
+	CreatedBy          uuid.UUID
+	InstructorID       *uuid.UUID // Instructor teaching this session (NULL if unassigned)
+	ExpectedEnrollment *int32     // Overrides the course enrollment for this session (NULL to inherit)
+	Blocks             *int32     // Back-to-back blocks of duration minutes per occurrence, in the same room (NULL for 1)
+	ParallelSections   *int32     // Sections meeting at the same time in different rooms per occurrence (NULL for 1)
+	TermID             *uuid.UUID // Term this session is offered in (NULL for every term of its course)
 }
@@ -13,9 +13,10 @@ import (
 )
 
 type Courses struct {
-	ID        uuid.UUID `sql:"primary_key"`
-	Name      string
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
-	CreatedBy uuid.UUID
+	ID                 uuid.UUID `sql:"primary_key"`
+	Name               string
+	CreatedAt          *time.Time
+	UpdatedAt          *time.Time
+	CreatedBy          uuid.UUID
+	ExpectedEnrollment *int32 // Expected number of students (NULL if unknown)
 }
@@ -21,4 +21,6 @@ type Schedules struct {
 	IsArchived *bool
 	IsActive   *bool
 	CreatedBy  uuid.UUID
+	Pins       string     // JSONB array: [{course_session_id, day (0-6), start_time (mins), room_id?}, ...]
+	TermID     *uuid.UUID // Term the schedule was generated for (NULL if not tied to a term)
 }
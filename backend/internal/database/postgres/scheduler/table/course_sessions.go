@@ -18,15 +18,20 @@ type courseSessionsTable struct {
 	postgres.Table
 
 	// Columns
-	ID               postgres.ColumnString
-	CourseID         postgres.ColumnString
-	RequiredRoom     postgres.ColumnString  // Room type needed (e.g., lecture_room, computer_lab)
-	Type             postgres.ColumnString  // Session type: lecture, lab, or tutorial
-	Duration         postgres.ColumnInteger // Session length in minutes
-	NumberOfSessions postgres.ColumnInteger // How many times per week this session occurs
-	CreatedAt        postgres.ColumnTimestamp
-	UpdatedAt        postgres.ColumnTimestamp
-	CreatedBy        postgres.ColumnString
+	ID                 postgres.ColumnString
+	CourseID           postgres.ColumnString
+	RequiredRoom       postgres.ColumnString  // Room type needed (e.g., lecture_room, computer_lab)
+	Type               postgres.ColumnString  // Session type: lecture, lab, or tutorial
+	Duration           postgres.ColumnInteger // Session length in minutes
+	NumberOfSessions   postgres.ColumnInteger // How many times per week this session occurs
+	CreatedAt          postgres.ColumnTimestamp
+	UpdatedAt          postgres.ColumnTimestamp
+	CreatedBy          postgres.ColumnString
+	InstructorID       postgres.ColumnString  // Instructor teaching this session (NULL if unassigned)
+	ExpectedEnrollment postgres.ColumnInteger // Overrides the course enrollment for this session (NULL to inherit)
+	Blocks             postgres.ColumnInteger // Back-to-back blocks of duration minutes per occurrence, in the same room (NULL for 1)
+	ParallelSections   postgres.ColumnInteger // Sections meeting at the same time in different rooms per occurrence (NULL for 1)
+	TermID             postgres.ColumnString  // Term this session is offered in (NULL for every term of its course)
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -68,33 +73,43 @@ func newCourseSessionsTable(schemaName, tableName, alias string) *CourseSessions
 
 func newCourseSessionsTableImpl(schemaName, tableName, alias string) courseSessionsTable {
 	var (
-		IDColumn               = postgres.StringColumn("id")
-		CourseIDColumn         = postgres.StringColumn("course_id")
-		RequiredRoomColumn     = postgres.StringColumn("required_room")
-		TypeColumn             = postgres.StringColumn("type")
-		DurationColumn         = postgres.IntegerColumn("duration")
-		NumberOfSessionsColumn = postgres.IntegerColumn("number_of_sessions")
-		CreatedAtColumn        = postgres.TimestampColumn("created_at")
-		UpdatedAtColumn        = postgres.TimestampColumn("updated_at")
-		CreatedByColumn        = postgres.StringColumn("created_by")
-		allColumns             = postgres.ColumnList{IDColumn, CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn}
-		mutableColumns         = postgres.ColumnList{CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn}
-		defaultColumns         = postgres.ColumnList{CreatedAtColumn}
+		IDColumn                 = postgres.StringColumn("id")
+		CourseIDColumn           = postgres.StringColumn("course_id")
+		RequiredRoomColumn       = postgres.StringColumn("required_room")
+		TypeColumn               = postgres.StringColumn("type")
+		DurationColumn           = postgres.IntegerColumn("duration")
+		NumberOfSessionsColumn   = postgres.IntegerColumn("number_of_sessions")
+		CreatedAtColumn          = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn          = postgres.TimestampColumn("updated_at")
+		CreatedByColumn          = postgres.StringColumn("created_by")
+		InstructorIDColumn       = postgres.StringColumn("instructor_id")
+		ExpectedEnrollmentColumn = postgres.IntegerColumn("expected_enrollment")
+		BlocksColumn             = postgres.IntegerColumn("blocks")
+		ParallelSectionsColumn   = postgres.IntegerColumn("parallel_sections")
+		TermIDColumn             = postgres.StringColumn("term_id")
+		allColumns               = postgres.ColumnList{IDColumn, CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn, InstructorIDColumn, ExpectedEnrollmentColumn, BlocksColumn, ParallelSectionsColumn, TermIDColumn}
+		mutableColumns           = postgres.ColumnList{CourseIDColumn, RequiredRoomColumn, TypeColumn, DurationColumn, NumberOfSessionsColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn, InstructorIDColumn, ExpectedEnrollmentColumn, BlocksColumn, ParallelSectionsColumn, TermIDColumn}
+		defaultColumns           = postgres.ColumnList{CreatedAtColumn}
 	)
 
 	return courseSessionsTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:               IDColumn,
-		CourseID:         CourseIDColumn,
-		RequiredRoom:     RequiredRoomColumn,
-		Type:             TypeColumn,
-		Duration:         DurationColumn,
-		NumberOfSessions: NumberOfSessionsColumn,
-		CreatedAt:        CreatedAtColumn,
-		UpdatedAt:        UpdatedAtColumn,
-		CreatedBy:        CreatedByColumn,
+		ID:                 IDColumn,
+		CourseID:           CourseIDColumn,
+		RequiredRoom:       RequiredRoomColumn,
+		Type:               TypeColumn,
+		Duration:           DurationColumn,
+		NumberOfSessions:   NumberOfSessionsColumn,
+		CreatedAt:          CreatedAtColumn,
+		UpdatedAt:          UpdatedAtColumn,
+		CreatedBy:          CreatedByColumn,
+		InstructorID:       InstructorIDColumn,
+		ExpectedEnrollment: ExpectedEnrollmentColumn,
+		Blocks:             BlocksColumn,
+		ParallelSections:   ParallelSectionsColumn,
+		TermID:             TermIDColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
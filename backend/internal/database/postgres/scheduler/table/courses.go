@@ -17,11 +17,12 @@ type coursesTable struct {
 	postgres.Table
 
 	// Columns
-	ID        postgres.ColumnString
-	Name      postgres.ColumnString
-	CreatedAt postgres.ColumnTimestamp
-	UpdatedAt postgres.ColumnTimestamp
-	CreatedBy postgres.ColumnString
+	ID                 postgres.ColumnString
+	Name               postgres.ColumnString
+	CreatedAt          postgres.ColumnTimestamp
+	UpdatedAt          postgres.ColumnTimestamp
+	CreatedBy          postgres.ColumnString
+	ExpectedEnrollment postgres.ColumnInteger // Expected number of students (NULL if unknown)
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -63,25 +64,27 @@ func newCoursesTable(schemaName, tableName, alias string) *CoursesTable {
 
 func newCoursesTableImpl(schemaName, tableName, alias string) coursesTable {
 	var (
-		IDColumn        = postgres.StringColumn("id")
-		NameColumn      = postgres.StringColumn("name")
-		CreatedAtColumn = postgres.TimestampColumn("created_at")
-		UpdatedAtColumn = postgres.TimestampColumn("updated_at")
-		CreatedByColumn = postgres.StringColumn("created_by")
-		allColumns      = postgres.ColumnList{IDColumn, NameColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn}
-		mutableColumns  = postgres.ColumnList{NameColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn}
-		defaultColumns  = postgres.ColumnList{CreatedAtColumn}
+		IDColumn                 = postgres.StringColumn("id")
+		NameColumn               = postgres.StringColumn("name")
+		CreatedAtColumn          = postgres.TimestampColumn("created_at")
+		UpdatedAtColumn          = postgres.TimestampColumn("updated_at")
+		CreatedByColumn          = postgres.StringColumn("created_by")
+		ExpectedEnrollmentColumn = postgres.IntegerColumn("expected_enrollment")
+		allColumns               = postgres.ColumnList{IDColumn, NameColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn, ExpectedEnrollmentColumn}
+		mutableColumns           = postgres.ColumnList{NameColumn, CreatedAtColumn, UpdatedAtColumn, CreatedByColumn, ExpectedEnrollmentColumn}
+		defaultColumns           = postgres.ColumnList{CreatedAtColumn}
 	)
 
 	return coursesTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:        IDColumn,
-		Name:      NameColumn,
-		CreatedAt: CreatedAtColumn,
-		UpdatedAt: UpdatedAtColumn,
-		CreatedBy: CreatedByColumn,
+		ID:                 IDColumn,
+		Name:               NameColumn,
+		CreatedAt:          CreatedAtColumn,
+		UpdatedAt:          UpdatedAtColumn,
+		CreatedBy:          CreatedByColumn,
+		ExpectedEnrollment: ExpectedEnrollmentColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
@@ -25,6 +25,8 @@ type schedulesTable struct {
 	IsArchived postgres.ColumnBool
 	IsActive   postgres.ColumnBool
 	CreatedBy  postgres.ColumnString
+	Pins       postgres.ColumnString // JSONB array: [{course_session_id, day (0-6), start_time (mins), room_id?}, ...]
+	TermID     postgres.ColumnString // Term the schedule was generated for (NULL if not tied to a term)
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -73,9 +75,11 @@ func newSchedulesTableImpl(schemaName, tableName, alias string) schedulesTable {
 		IsArchivedColumn = postgres.BoolColumn("is_archived")
 		IsActiveColumn   = postgres.BoolColumn("is_active")
 		CreatedByColumn  = postgres.StringColumn("created_by")
-		allColumns       = postgres.ColumnList{IDColumn, NameColumn, CreatedAtColumn, SessionsColumn, IsArchivedColumn, IsActiveColumn, CreatedByColumn}
-		mutableColumns   = postgres.ColumnList{NameColumn, CreatedAtColumn, SessionsColumn, IsArchivedColumn, IsActiveColumn, CreatedByColumn}
-		defaultColumns   = postgres.ColumnList{CreatedAtColumn, IsArchivedColumn, IsActiveColumn}
+		PinsColumn       = postgres.StringColumn("pins")
+		TermIDColumn     = postgres.StringColumn("term_id")
+		allColumns       = postgres.ColumnList{IDColumn, NameColumn, CreatedAtColumn, SessionsColumn, IsArchivedColumn, IsActiveColumn, CreatedByColumn, PinsColumn, TermIDColumn}
+		mutableColumns   = postgres.ColumnList{NameColumn, CreatedAtColumn, SessionsColumn, IsArchivedColumn, IsActiveColumn, CreatedByColumn, PinsColumn, TermIDColumn}
+		defaultColumns   = postgres.ColumnList{CreatedAtColumn, IsArchivedColumn, IsActiveColumn, PinsColumn}
 	)
 
 	return schedulesTable{
@@ -89,6 +93,8 @@ func newSchedulesTableImpl(schemaName, tableName, alias string) schedulesTable {
 		IsArchived: IsArchivedColumn,
 		IsActive:   IsActiveColumn,
 		CreatedBy:  CreatedByColumn,
+		Pins:       PinsColumn,
+		TermID:     TermIDColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
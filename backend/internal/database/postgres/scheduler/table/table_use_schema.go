@@ -10,10 +10,19 @@ package table
 // UseSchema sets a new schema name for all generated table SQL builder types. It is recommended to invoke
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
+	BuildingDistances = BuildingDistances.FromSchema(schema)
 	Buildings = Buildings.FromSchema(schema)
+	CalendarFeeds = CalendarFeeds.FromSchema(schema)
+	CohortCourses = CohortCourses.FromSchema(schema)
+	Cohorts = Cohorts.FromSchema(schema)
 	CourseSessions = CourseSessions.FromSchema(schema)
 	Courses = Courses.FromSchema(schema)
+	Instructors = Instructors.FromSchema(schema)
+	RoomBlackouts = RoomBlackouts.FromSchema(schema)
 	RoomTypes = RoomTypes.FromSchema(schema)
 	Rooms = Rooms.FromSchema(schema)
 	Schedules = Schedules.FromSchema(schema)
+	SessionConstraints = SessionConstraints.FromSchema(schema)
+	TermCourses = TermCourses.FromSchema(schema)
+	Terms = Terms.FromSchema(schema)
 }
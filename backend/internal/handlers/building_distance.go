@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type BuildingDistanceHandler struct {
+	service service.BuildingDistanceServiceInterface
+}
+
+func NewBuildingDistanceHandler(s service.BuildingDistanceServiceInterface) *BuildingDistanceHandler {
+	return &BuildingDistanceHandler{service: s}
+}
+
+type SetBuildingDistanceRequest struct {
+	Minutes int32 `json:"minutes"`
+}
+
+func (h *BuildingDistanceHandler) GetByBuildingID(w http.ResponseWriter, r *http.Request) {
+	buildingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid building id")
+		return
+	}
+
+	distances, err := h.service.GetByBuildingID(r.Context(), buildingID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "building not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get building distances")
+		return
+	}
+	JSON(w, http.StatusOK, distances)
+}
+
+func (h *BuildingDistanceHandler) Set(w http.ResponseWriter, r *http.Request) {
+	fromID, toID, ok := parseBuildingPair(w, r)
+	if !ok {
+		return
+	}
+
+	var req SetBuildingDistanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	distance := models.NewBuildingDistance(fromID, toID, req.Minutes, nil, nil)
+	if err := distance.Validate(); err != nil {
+		Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	saved, err := h.service.Set(r.Context(), distance)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "building not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to set building distance")
+		return
+	}
+	JSON(w, http.StatusOK, saved)
+}
+
+func (h *BuildingDistanceHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	fromID, toID, ok := parseBuildingPair(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), fromID, toID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "building distance not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete building distance")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseBuildingPair reads the {id} and {toId} URL parameters, writing a 400 response if either is invalid
+func parseBuildingPair(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	fromID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid building id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	toID, err := uuid.Parse(chi.URLParam(r, "toId"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid building id")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return fromID, toID, true
+}
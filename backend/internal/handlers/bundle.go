@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type BundleHandler struct {
+	service service.BundleServiceInterface
+}
+
+func NewBundleHandler(s service.BundleServiceInterface) *BundleHandler {
+	return &BundleHandler{service: s}
+}
+
+// Export downloads a bundle of all the user's records
+func (h *BundleHandler) Export(w http.ResponseWriter, r *http.Request) {
+	bundle, err := h.service.Export(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to export")
+		return
+	}
+
+	name := fmt.Sprintf("course-scheduler-%s.json", bundle.ExportedAt.Format("2006-01-02"))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+	JSON(w, http.StatusOK, bundle)
+}
+
+// Restore creates the records of an exported bundle alongside the user's own
+func (h *BundleHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	var bundle models.Bundle
+	if err := json.NewDecoder(r.Body).Decode(&bundle); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	report, err := h.service.Restore(r.Context(), &bundle)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBundle) {
+			Error(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to restore bundle")
+		return
+	}
+	JSON(w, http.StatusCreated, report)
+}
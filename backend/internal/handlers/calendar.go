@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type CalendarHandler struct {
+	service service.CalendarServiceInterface
+}
+
+func NewCalendarHandler(s service.CalendarServiceInterface) *CalendarHandler {
+	return &CalendarHandler{service: s}
+}
+
+// Occurrences lists a schedule's dated occurrences, optionally between ?from= and ?to= and
+// for one ?room_id=, ?course_id=, ?building_id= or ?instructor_id=
+func (h *CalendarHandler) Occurrences(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	filter, ok := parseOccurrenceFilter(w, r)
+	if !ok {
+		return
+	}
+
+	occurrences, err := h.service.Occurrences(r.Context(), id, filter)
+	if err != nil {
+		h.writeError(w, err, "failed to list occurrences")
+		return
+	}
+	JSON(w, http.StatusOK, occurrences)
+}
+
+// ICal downloads a schedule as an iCalendar file, taking the same filters as Occurrences
+func (h *CalendarHandler) ICal(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	filter, ok := parseOccurrenceFilter(w, r)
+	if !ok {
+		return
+	}
+
+	calendar, err := h.service.ICal(r.Context(), id, filter)
+	if err != nil {
+		h.writeError(w, err, "failed to export calendar")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.ics"`, fileName(calendar.Name)))
+	w.WriteHeader(http.StatusOK)
+	calendar.Encode(w)
+}
+
+// writeError maps an error from the calendar service to a response
+func (h *CalendarHandler) writeError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, repository.ErrNotFound) {
+		Error(w, http.StatusNotFound, "schedule not found")
+		return
+	}
+	if errors.Is(err, service.ErrInvalidDateRange) {
+		Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	Error(w, http.StatusInternalServerError, message)
+}
+
+// parseOccurrenceFilter reads the occurrence filter from the query string, writing a 400
+// response and returning false if an ID is malformed
+func parseOccurrenceFilter(w http.ResponseWriter, r *http.Request) (models.OccurrenceFilter, bool) {
+	query := r.URL.Query()
+	filter := models.OccurrenceFilter{
+		From: query.Get("from"),
+		To:   query.Get("to"),
+	}
+
+	for _, param := range []struct {
+		name string
+		dest **uuid.UUID
+	}{
+		{"room_id", &filter.RoomID},
+		{"course_id", &filter.CourseID},
+		{"building_id", &filter.BuildingID},
+		{"instructor_id", &filter.InstructorID},
+	} {
+		id, err := parseOptionalUUID(query.Get(param.name))
+		if err != nil {
+			Error(w, http.StatusBadRequest, "invalid "+param.name)
+			return models.OccurrenceFilter{}, false
+		}
+		*param.dest = id
+	}
+
+	return filter, true
+}
+
+// parseOptionalUUID parses a query parameter that may be left out
+func parseOptionalUUID(value string) (*uuid.UUID, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// fileName turns a name into a safe ASCII download file name
+func fileName(name string) string {
+	safe := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_') {
+			return r
+		}
+		return '_'
+	}, name)
+
+	if safe == "" {
+		return "schedule"
+	}
+	return safe
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type CalendarFeedHandler struct {
+	service service.CalendarFeedServiceInterface
+}
+
+func NewCalendarFeedHandler(s service.CalendarFeedServiceInterface) *CalendarFeedHandler {
+	return &CalendarFeedHandler{service: s}
+}
+
+func (h *CalendarFeedHandler) List(w http.ResponseWriter, r *http.Request) {
+	feeds, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list calendar feeds")
+		return
+	}
+	JSON(w, http.StatusOK, feeds)
+}
+
+func (h *CalendarFeedHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var feed models.CalendarFeed
+	if err := json.NewDecoder(r.Body).Decode(&feed); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	feed.ID = uuid.New()
+
+	created, err := h.service.Create(r.Context(), &feed)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create calendar feed")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *CalendarFeedHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	feed, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "calendar feed not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get calendar feed")
+		return
+	}
+	JSON(w, http.StatusOK, feed)
+}
+
+// Delete revokes the feed, so its URL stops working
+func (h *CalendarFeedHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "calendar feed not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete calendar feed")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Feed serves the iCalendar feed for the share token in the URL. It is public, so it runs
+// behind ShareTokenMiddleware with Owner rather than behind AuthMiddleware.
+func (h *CalendarFeedHandler) Feed(w http.ResponseWriter, r *http.Request) {
+	calendar, err := h.service.ICal(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "calendar feed not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to export calendar")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s.ics"`, fileName(calendar.Name)))
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	calendar.Encode(w)
+}
+
+// Owner returns the ID of the user who shared a feed token, or "" for an unknown token
+func (h *CalendarFeedHandler) Owner(ctx context.Context, token string) (string, error) {
+	owner, err := h.service.Owner(ctx, token)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return owner.String(), nil
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/middleware"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type ImportHandler struct {
+	service service.ImportServiceInterface
+}
+
+func NewImportHandler(s service.ImportServiceInterface) *ImportHandler {
+	return &ImportHandler{service: s}
+}
+
+// Import reads a multipart form with a CSV file per entity, in fields named after the entities.
+// With ?dry_run=true the rows are only validated. Otherwise a report with row errors is returned
+// with 422 and nothing is created.
+func (h *ImportHandler) Import(w http.ResponseWriter, r *http.Request) {
+	dryRun := false
+	if value := r.URL.Query().Get("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			Error(w, http.StatusBadRequest, "invalid dry_run")
+			return
+		}
+		dryRun = parsed
+	}
+
+	if err := r.ParseMultipartForm(middleware.DefaultMaxBodySize); err != nil {
+		Error(w, http.StatusBadRequest, "invalid multipart form")
+		return
+	}
+
+	known := make(map[models.ImportEntity]bool, len(models.ImportEntities))
+	for _, entity := range models.ImportEntities {
+		known[entity] = true
+	}
+
+	files := make(map[models.ImportEntity]io.Reader)
+	for field, headers := range r.MultipartForm.File {
+		entity := models.ImportEntity(field)
+		if !known[entity] {
+			Error(w, http.StatusBadRequest, "unknown import file "+strconv.Quote(field))
+			return
+		}
+		if len(headers) != 1 {
+			Error(w, http.StatusBadRequest, "expected one file for "+field)
+			return
+		}
+
+		file, err := headers[0].Open()
+		if err != nil {
+			Error(w, http.StatusBadRequest, "invalid file for "+field)
+			return
+		}
+		defer file.Close()
+		files[entity] = file
+	}
+
+	if len(files) == 0 {
+		Error(w, http.StatusBadRequest, "no files to import")
+		return
+	}
+
+	report, err := h.service.Import(r.Context(), files, dryRun)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidImport) {
+			JSON(w, http.StatusUnprocessableEntity, report)
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to import")
+		return
+	}
+
+	if dryRun {
+		JSON(w, http.StatusOK, report)
+		return
+	}
+	JSON(w, http.StatusCreated, report)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type InstructorHandler struct {
+	service service.InstructorServiceInterface
+}
+
+func NewInstructorHandler(s service.InstructorServiceInterface) *InstructorHandler {
+	return &InstructorHandler{service: s}
+}
+
+func (h *InstructorHandler) List(w http.ResponseWriter, r *http.Request) {
+	instructors, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list instructors")
+		return
+	}
+	JSON(w, http.StatusOK, instructors)
+}
+
+func (h *InstructorHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var instructor models.Instructor
+	if err := json.NewDecoder(r.Body).Decode(&instructor); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	instructor.ID = uuid.New()
+
+	created, err := h.service.Create(r.Context(), &instructor)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create instructor")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *InstructorHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	instructor, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get instructor")
+		return
+	}
+	JSON(w, http.StatusOK, instructor)
+}
+
+func (h *InstructorHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var updates models.InstructorUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update instructor")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *InstructorHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "instructor not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete instructor")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type RoomBlackoutHandler struct {
+	service service.RoomBlackoutServiceInterface
+}
+
+func NewRoomBlackoutHandler(s service.RoomBlackoutServiceInterface) *RoomBlackoutHandler {
+	return &RoomBlackoutHandler{service: s}
+}
+
+func (h *RoomBlackoutHandler) List(w http.ResponseWriter, r *http.Request) {
+	blackouts, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list room blackouts")
+		return
+	}
+	JSON(w, http.StatusOK, blackouts)
+}
+
+func (h *RoomBlackoutHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var blackout models.RoomBlackout
+	if err := json.NewDecoder(r.Body).Decode(&blackout); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	blackout.ID = uuid.New()
+	if blackout.Recurrence == "" {
+		blackout.Recurrence = models.RecurrenceWeekly
+	}
+
+	created, err := h.service.Create(r.Context(), &blackout)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create room blackout")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *RoomBlackoutHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	blackout, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "room blackout not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get room blackout")
+		return
+	}
+	JSON(w, http.StatusOK, blackout)
+}
+
+func (h *RoomBlackoutHandler) GetByRoomID(w http.ResponseWriter, r *http.Request) {
+	roomID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+
+	blackouts, err := h.service.GetByRoomID(r.Context(), roomID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to get room blackouts")
+		return
+	}
+	JSON(w, http.StatusOK, blackouts)
+}
+
+func (h *RoomBlackoutHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var updates models.RoomBlackoutUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "room blackout not found")
+			return
+		}
+		if errors.Is(err, repository.ErrInvalidInput) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update room blackout")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *RoomBlackoutHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "room blackout not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete room blackout")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
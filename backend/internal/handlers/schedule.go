@@ -15,10 +15,11 @@ import (
 
 type ScheduleHandler struct {
 	service service.ScheduleServiceInterface
+	scorer  service.SchedulerServiceInterface
 }
 
-func NewScheduleHandler(s service.ScheduleServiceInterface) *ScheduleHandler {
-	return &ScheduleHandler{service: s}
+func NewScheduleHandler(s service.ScheduleServiceInterface, scorer service.SchedulerServiceInterface) *ScheduleHandler {
+	return &ScheduleHandler{service: s, scorer: scorer}
 }
 
 func (h *ScheduleHandler) List(w http.ResponseWriter, r *http.Request) {
@@ -62,6 +63,14 @@ func (h *ScheduleHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		Error(w, http.StatusInternalServerError, "failed to get schedule")
 		return
 	}
+
+	score, err := h.scorer.Score(r.Context(), schedule.TermID, schedule.Sessions)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to score schedule")
+		return
+	}
+	schedule.Score = score
+
 	JSON(w, http.StatusOK, schedule)
 }
 
@@ -117,6 +126,21 @@ func (h *ScheduleHandler) ListArchived(w http.ResponseWriter, r *http.Request) {
 	JSON(w, http.StatusOK, schedules)
 }
 
+func (h *ScheduleHandler) ListByTerm(w http.ResponseWriter, r *http.Request) {
+	termID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	schedules, err := h.service.ListByTerm(r.Context(), termID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list schedules")
+		return
+	}
+	JSON(w, http.StatusOK, schedules)
+}
+
 func (h *ScheduleHandler) SetActive(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(chi.URLParam(r, "id"))
 	if err != nil {
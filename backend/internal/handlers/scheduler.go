@@ -2,8 +2,15 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
 	"github.com/TerrenceMurray/course-scheduler/internal/service"
 )
@@ -28,6 +35,35 @@ type GenerateResponse struct {
 	Error    string                     `json:"error,omitempty"`
 }
 
+type OptimizeRequest struct {
+	Name   string            `json:"name,omitempty"` // defaults to "<original name> (optimized)"
+	Config *scheduler.Config `json:"config,omitempty"`
+}
+
+type OptimizeResponse struct {
+	Schedule    *models.Schedule `json:"schedule"`
+	InitialCost float64          `json:"initial_cost"`
+	FinalCost   float64          `json:"final_cost"`
+	Moves       int              `json:"moves"`
+	Incomplete  bool             `json:"incomplete,omitempty"` // MaxDuration ran out before every iteration ran
+}
+
+type RepairRequest struct {
+	Name   string            `json:"name,omitempty"` // defaults to "<active schedule name> (repaired)"
+	Config *scheduler.Config `json:"config,omitempty"`
+}
+
+type RepairResponse struct {
+	Schedule    *models.Schedule           `json:"schedule"`
+	Output      *scheduler.Output          `json:"output"`
+	Kept        int                        `json:"kept"`
+	Moved       []scheduler.SessionMove    `json:"moved"`
+	Added       []*models.ScheduledSession `json:"added"`
+	Removed     []*models.ScheduledSession `json:"removed"`
+	RemovedPins []models.SessionPin        `json:"removed_pins,omitempty"` // active schedule pins that no longer resolve
+	Failures    []*scheduler.FailedSession `json:"failures,omitempty"`
+}
+
 func (h *SchedulerHandler) Generate(w http.ResponseWriter, r *http.Request) {
 	var req GenerateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -37,6 +73,18 @@ func (h *SchedulerHandler) Generate(w http.ResponseWriter, r *http.Request) {
 
 	output, err := h.service.Generate(r.Context(), req.Config)
 	if err != nil {
+		if errors.Is(err, service.ErrUnknownAlgorithm) || errors.Is(err, service.ErrUnknownTerm) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, scheduler.ErrInvalidPin) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, scheduler.ErrPinConflict) {
+			Error(w, http.StatusConflict, err.Error())
+			return
+		}
 		Error(w, http.StatusInternalServerError, "failed to generate schedule")
 		return
 	}
@@ -61,6 +109,19 @@ func (h *SchedulerHandler) GenerateAndSave(w http.ResponseWriter, r *http.Reques
 
 	schedule, output, err := h.service.GenerateAndSave(r.Context(), req.Name, req.Config)
 	if err != nil {
+		if errors.Is(err, service.ErrUnknownAlgorithm) || errors.Is(err, service.ErrUnknownTerm) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, scheduler.ErrInvalidPin) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, scheduler.ErrPinConflict) {
+			Error(w, http.StatusConflict, err.Error())
+			return
+		}
+
 		// If we have output but save failed, still return the generated schedule info
 		if output != nil {
 			JSON(w, http.StatusInternalServerError, GenerateResponse{
@@ -80,3 +141,74 @@ func (h *SchedulerHandler) GenerateAndSave(w http.ResponseWriter, r *http.Reques
 		Failures: output.Failures,
 	})
 }
+
+func (h *SchedulerHandler) Optimize(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	// The body is optional
+	var req OptimizeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	schedule, result, err := h.service.Optimize(r.Context(), id, req.Name, req.Config)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "schedule not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to optimize schedule")
+		return
+	}
+
+	JSON(w, http.StatusCreated, OptimizeResponse{
+		Schedule:    schedule,
+		InitialCost: result.InitialCost,
+		FinalCost:   result.FinalCost,
+		Moves:       result.Moves,
+		Incomplete:  result.Incomplete,
+	})
+}
+
+func (h *SchedulerHandler) Repair(w http.ResponseWriter, r *http.Request) {
+	// The body is optional
+	var req RepairRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	schedule, result, err := h.service.Repair(r.Context(), req.Name, req.Config)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "no active schedule")
+			return
+		}
+		if errors.Is(err, service.ErrUnknownAlgorithm) || errors.Is(err, scheduler.ErrInvalidPin) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, scheduler.ErrPinConflict) {
+			Error(w, http.StatusConflict, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to repair schedule")
+		return
+	}
+
+	JSON(w, http.StatusCreated, RepairResponse{
+		Schedule:    schedule,
+		Output:      result.Output,
+		Kept:        result.Kept,
+		Moved:       result.Moved,
+		Added:       result.Added,
+		Removed:     result.Removed,
+		RemovedPins: result.RemovedPins,
+		Failures:    result.Output.Failures,
+	})
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/middleware"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/jobs"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type SchedulerJobHandler struct {
+	service service.SchedulerJobServiceInterface
+}
+
+func NewSchedulerJobHandler(s service.SchedulerJobServiceInterface) *SchedulerJobHandler {
+	return &SchedulerJobHandler{service: s}
+}
+
+type SubmitJobRequest struct {
+	Config *scheduler.Config `json:"config,omitempty"`
+}
+
+func (h *SchedulerJobHandler) List(w http.ResponseWriter, r *http.Request) {
+	list, err := h.service.List(r.Context(), middleware.GetUserID(r.Context()))
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list jobs")
+		return
+	}
+	if list == nil {
+		list = []*jobs.Job{}
+	}
+	JSON(w, http.StatusOK, list)
+}
+
+func (h *SchedulerJobHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	// The body is optional
+	var req SubmitJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	job, err := h.service.Submit(r.Context(), middleware.GetUserID(r.Context()), req.Config)
+	if err != nil {
+		if errors.Is(err, service.ErrUnknownAlgorithm) || errors.Is(err, service.ErrUnknownTerm) {
+			Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, jobs.ErrQueueFull) || errors.Is(err, jobs.ErrClosed) {
+			Error(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to submit job")
+		return
+	}
+	JSON(w, http.StatusAccepted, job)
+}
+
+func (h *SchedulerJobHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	job, err := h.service.GetByID(r.Context(), middleware.GetUserID(r.Context()), id)
+	if err != nil {
+		if errors.Is(err, jobs.ErrNotFound) {
+			Error(w, http.StatusNotFound, "job not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get job")
+		return
+	}
+	JSON(w, http.StatusOK, job)
+}
+
+func (h *SchedulerJobHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	job, err := h.service.Cancel(r.Context(), middleware.GetUserID(r.Context()), id)
+	if err != nil {
+		if errors.Is(err, jobs.ErrNotFound) {
+			Error(w, http.StatusNotFound, "job not found")
+			return
+		}
+		if errors.Is(err, jobs.ErrFinished) {
+			Error(w, http.StatusConflict, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to cancel job")
+		return
+	}
+	JSON(w, http.StatusOK, job)
+}
+
+// Events streams the job over Server-Sent Events: a "progress" event each time it advances and a
+// final "result" event with the finished job, after which the stream ends
+func (h *SchedulerJobHandler) Events(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	updates, stop, err := h.service.Watch(r.Context(), middleware.GetUserID(r.Context()), id)
+	if err != nil {
+		if errors.Is(err, jobs.ErrNotFound) {
+			Error(w, http.StatusNotFound, "job not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to watch job")
+		return
+	}
+	defer stop()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		Error(w, http.StatusInternalServerError, "streaming not supported")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case job, ok := <-updates:
+			if !ok {
+				return
+			}
+
+			event := "progress"
+			if job.Status.Finished() {
+				event = "result"
+			}
+
+			data, err := json.Marshal(job)
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type SessionConstraintHandler struct {
+	service service.SessionConstraintServiceInterface
+}
+
+func NewSessionConstraintHandler(s service.SessionConstraintServiceInterface) *SessionConstraintHandler {
+	return &SessionConstraintHandler{service: s}
+}
+
+func (h *SessionConstraintHandler) List(w http.ResponseWriter, r *http.Request) {
+	constraints, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list session constraints")
+		return
+	}
+	JSON(w, http.StatusOK, constraints)
+}
+
+func (h *SessionConstraintHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var constraint models.SessionConstraint
+	if err := json.NewDecoder(r.Body).Decode(&constraint); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	constraint.ID = uuid.New()
+
+	created, err := h.service.Create(r.Context(), &constraint)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create session constraint")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *SessionConstraintHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	constraint, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session constraint not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get session constraint")
+		return
+	}
+	JSON(w, http.StatusOK, constraint)
+}
+
+func (h *SessionConstraintHandler) GetByCourseID(w http.ResponseWriter, r *http.Request) {
+	courseID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid course id")
+		return
+	}
+
+	constraints, err := h.service.GetByCourseID(r.Context(), courseID)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to get session constraints")
+		return
+	}
+	JSON(w, http.StatusOK, constraints)
+}
+
+func (h *SessionConstraintHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "session constraint not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete session constraint")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
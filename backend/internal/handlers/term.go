@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type TermHandler struct {
+	service service.TermServiceInterface
+}
+
+func NewTermHandler(s service.TermServiceInterface) *TermHandler {
+	return &TermHandler{service: s}
+}
+
+func (h *TermHandler) List(w http.ResponseWriter, r *http.Request) {
+	terms, err := h.service.List(r.Context())
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to list terms")
+		return
+	}
+	JSON(w, http.StatusOK, terms)
+}
+
+func (h *TermHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var term models.Term
+	if err := json.NewDecoder(r.Body).Decode(&term); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	term.ID = uuid.New()
+
+	created, err := h.service.Create(r.Context(), &term)
+	if err != nil {
+		Error(w, http.StatusInternalServerError, "failed to create term")
+		return
+	}
+	JSON(w, http.StatusCreated, created)
+}
+
+func (h *TermHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	term, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "term not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to get term")
+		return
+	}
+	JSON(w, http.StatusOK, term)
+}
+
+func (h *TermHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var updates models.TermUpdate
+	if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+		Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.service.Update(r.Context(), id, &updates)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "term not found")
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to update term")
+		return
+	}
+	JSON(w, http.StatusOK, updated)
+}
+
+func (h *TermHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.service.Delete(r.Context(), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			Error(w, http.StatusNotFound, "term not found")
+			return
+		}
+		if errors.Is(err, service.ErrTermInUse) {
+			Error(w, http.StatusConflict, err.Error())
+			return
+		}
+		Error(w, http.StatusInternalServerError, "failed to delete term")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
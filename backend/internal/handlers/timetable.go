@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/render"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+type TimetableHandler struct {
+	service service.TimetableServiceInterface
+}
+
+func NewTimetableHandler(s service.TimetableServiceInterface) *TimetableHandler {
+	return &TimetableHandler{service: s}
+}
+
+// GridCSV downloads a schedule's rooms-by-time grid as CSV, with rows of ?slot= minutes
+func (h *TimetableHandler) GridCSV(w http.ResponseWriter, r *http.Request) {
+	timetable, ok := h.grid(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, fileName(timetable.Name)))
+	w.WriteHeader(http.StatusOK)
+	render.WriteTimetableCSV(w, timetable)
+}
+
+// GridXLSX downloads a schedule's rooms-by-time grid as a workbook with a sheet per day, taking
+// the same ?slot= as GridCSV
+func (h *TimetableHandler) GridXLSX(w http.ResponseWriter, r *http.Request) {
+	timetable, ok := h.grid(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.xlsx"`, fileName(timetable.Name)))
+	w.WriteHeader(http.StatusOK)
+	render.TimetableWorkbook(timetable).Encode(w)
+}
+
+// PDF downloads the weekly timetable of one ?room_id=, ?course_id= or ?building_id= as a PDF,
+// taking the same ?slot= as GridCSV
+func (h *TimetableHandler) PDF(w http.ResponseWriter, r *http.Request) {
+	id, slot, ok := parseTimetableRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var filter models.TimetableFilter
+	query := r.URL.Query()
+	for _, param := range []struct {
+		name string
+		dest **uuid.UUID
+	}{
+		{"room_id", &filter.RoomID},
+		{"course_id", &filter.CourseID},
+		{"building_id", &filter.BuildingID},
+	} {
+		id, err := parseOptionalUUID(query.Get(param.name))
+		if err != nil {
+			Error(w, http.StatusBadRequest, "invalid "+param.name)
+			return
+		}
+		*param.dest = id
+	}
+
+	timetable, err := h.service.Weekly(r.Context(), id, filter, slot)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writePDF(w, []*models.WeeklyTimetable{timetable}, timetable.Title)
+}
+
+// RoomsPDF downloads the weekly timetable of every room of a ?building_id= as one PDF, with a
+// page per room, taking the same ?slot= as GridCSV
+func (h *TimetableHandler) RoomsPDF(w http.ResponseWriter, r *http.Request) {
+	id, slot, ok := parseTimetableRequest(w, r)
+	if !ok {
+		return
+	}
+
+	buildingID, err := uuid.Parse(r.URL.Query().Get("building_id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid building_id")
+		return
+	}
+
+	timetables, err := h.service.RoomTimetables(r.Context(), id, buildingID, slot)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	name := "rooms"
+	if len(timetables) > 0 {
+		name = timetables[0].Schedule + " rooms"
+	}
+	writePDF(w, timetables, name)
+}
+
+// grid lays out the schedule in the URL, writing an error response and returning false if it can't
+func (h *TimetableHandler) grid(w http.ResponseWriter, r *http.Request) (*models.Timetable, bool) {
+	id, slot, ok := parseTimetableRequest(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	timetable, err := h.service.Grid(r.Context(), id, slot)
+	if err != nil {
+		h.writeError(w, err)
+		return nil, false
+	}
+	return timetable, true
+}
+
+// writeError maps an error from the timetable service to a response
+func (h *TimetableHandler) writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		Error(w, http.StatusNotFound, "schedule not found")
+	case errors.Is(err, service.ErrInvalidTimetableSlot), errors.Is(err, service.ErrInvalidTimetableFilter):
+		Error(w, http.StatusBadRequest, err.Error())
+	default:
+		Error(w, http.StatusInternalServerError, "failed to lay out timetable")
+	}
+}
+
+// parseTimetableRequest reads the schedule ID from the URL and the slot length from the query
+// string, writing a 400 response and returning false if either is malformed. Schedules don't keep
+// the config they were generated with, so the slot can't default to its PreferredSlotDuration;
+// it defaults to an hour instead, and ?slot= should match PreferredSlotDuration if one was used.
+func parseTimetableRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, int, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		Error(w, http.StatusBadRequest, "invalid id")
+		return uuid.Nil, 0, false
+	}
+
+	slot := models.DefaultTimetableSlot
+	if value := r.URL.Query().Get("slot"); value != "" {
+		if slot, err = strconv.Atoi(value); err != nil {
+			Error(w, http.StatusBadRequest, "invalid slot")
+			return uuid.Nil, 0, false
+		}
+	}
+
+	return id, slot, true
+}
+
+// writePDF prints weekly timetables as a PDF download
+func writePDF(w http.ResponseWriter, timetables []*models.WeeklyTimetable, name string) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.pdf"`, fileName(name)))
+	w.WriteHeader(http.StatusOK)
+	render.TimetablePDF(timetables).Encode(w)
+}
@@ -0,0 +1,126 @@
+// Package ical writes iCalendar (RFC 5545) files of weekly recurring events.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ProductID identifies the application that wrote a calendar
+const ProductID = "-//course-scheduler//Timetable//EN"
+
+// maxLineOctets is the longest a content line may be before it is folded
+const maxLineOctets = 75
+
+// Times are written as floating local times, which calendar apps show in the viewer's time zone
+const (
+	dateTimeFormat = "20060102T150405"
+	stampFormat    = "20060102T150405Z"
+)
+
+// Calendar is an iCalendar object holding events
+type Calendar struct {
+	Name   string // shown as the calendar's name by most calendar apps
+	Events []Event
+}
+
+// Event is a VEVENT that repeats weekly from Start until Until, except on ExDates
+type Event struct {
+	UID         string
+	Stamp       time.Time // when the event was written, in any time zone
+	Summary     string
+	Location    string
+	Description string
+	Start       time.Time // local date and time of the first occurrence
+	End         time.Time // local date and time the first occurrence ends
+	Until       time.Time // local date of the last occurrence; zero for a single occurrence
+	ExDates     []time.Time
+}
+
+// Encode writes the calendar to w with CRLF line endings and folded lines
+func (c *Calendar) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	write := func(name, value string) {
+		writeLine(bw, name+":"+value)
+	}
+
+	write("BEGIN", "VCALENDAR")
+	write("VERSION", "2.0")
+	write("PRODID", ProductID)
+	write("CALSCALE", "GREGORIAN")
+	if c.Name != "" {
+		write("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, e := range c.Events {
+		write("BEGIN", "VEVENT")
+		write("UID", e.UID)
+		write("DTSTAMP", e.Stamp.UTC().Format(stampFormat))
+		write("DTSTART", e.Start.Format(dateTimeFormat))
+		write("DTEND", e.End.Format(dateTimeFormat))
+		if !e.Until.IsZero() {
+			// UNTIL must be local like DTSTART, and inclusive of the last occurrence
+			until := time.Date(e.Until.Year(), e.Until.Month(), e.Until.Day(), 23, 59, 59, 0, e.Until.Location())
+			write("RRULE", "FREQ=WEEKLY;UNTIL="+until.Format(dateTimeFormat))
+		}
+		if len(e.ExDates) > 0 {
+			dates := make([]string, len(e.ExDates))
+			for i, date := range e.ExDates {
+				dates[i] = date.Format(dateTimeFormat)
+			}
+			write("EXDATE", strings.Join(dates, ","))
+		}
+		write("SUMMARY", escapeText(e.Summary))
+		if e.Location != "" {
+			write("LOCATION", escapeText(e.Location))
+		}
+		if e.Description != "" {
+			write("DESCRIPTION", escapeText(e.Description))
+		}
+		write("END", "VEVENT")
+	}
+
+	write("END", "VCALENDAR")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+	return nil
+}
+
+// writeLine writes a content line, folding it onto continuation lines that start with a space
+// so that no line is longer than 75 octets. Multi-byte characters are never split.
+func writeLine(w *bufio.Writer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1 // the leading space counts towards the next line
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+// textEscaper escapes the characters that are special in TEXT values
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText escapes a TEXT value
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
@@ -0,0 +1,93 @@
+package itc
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+const (
+	// DayStart is when the first period of a day starts (in minutes from midnight)
+	DayStart = 480
+
+	// PeriodMinutes is the length of a period, and so of every lecture
+	PeriodMinutes = 60
+
+	// RoomType is the room type of every room and lecture; the format has only one
+	RoomType = "room"
+
+	// SessionType is the session type of every lecture
+	SessionType = "lecture"
+)
+
+// namespace derives the IDs of an instance's records from their names, so that a schedule can
+// be mapped back onto the instance
+var namespace = uuid.MustParse("6f1d3c2e-4b8a-5e7f-9a0c-1d2e3f4a5b6c")
+
+func recordID(kind, name string) uuid.UUID {
+	return uuid.NewSHA1(namespace, []byte(kind+"/"+name))
+}
+
+// Input maps the instance onto a scheduler input for config, which is copied and given the
+// instance's days and periods. Each period is an hour-long slot starting from DayStart.
+//
+//   - Courses become a course with one lecture session of Lectures weekly occurrences
+//   - Rooms become rooms of a single type in a single building
+//   - Teachers become instructors, so that a teacher's lectures don't overlap
+//   - Curricula become cohorts, so that a curriculum's lectures don't overlap
+//
+// The scheduler treats room capacity as a hard constraint, where the competition only charges
+// for the students that don't fit, so lectures are given at most the largest room's capacity as
+// their enrollment. Unavailability and minimum working days have no counterpart in the model and
+// are only reported, by Evaluate.
+func (in *Instance) Input(config scheduler.Config) *scheduler.Input {
+	config.OperatingDays = make([]scheduler.Day, in.Days)
+	for day := range in.Days {
+		config.OperatingDays[day] = scheduler.Day(day)
+	}
+	config.OperatingHours = scheduler.TimeRange{Start: DayStart, End: DayStart + in.PeriodsPerDay*PeriodMinutes}
+	config.PreferredSlotDuration = PeriodMinutes
+
+	input := &scheduler.Input{Config: &config}
+
+	building := recordID("building", in.Name)
+	largest := 0
+	for _, room := range in.Rooms {
+		input.Rooms = append(input.Rooms, models.NewRoom(recordID("room", room.ID), room.ID, RoomType, building, int32(room.Capacity), nil, nil))
+		largest = max(largest, room.Capacity)
+	}
+
+	teachers := make(map[string]bool)
+	for _, course := range in.Courses {
+		if !teachers[course.Teacher] {
+			teachers[course.Teacher] = true
+			input.Instructors = append(input.Instructors, models.NewInstructor(recordID("teacher", course.Teacher), course.Teacher, nil, nil))
+		}
+
+		students := int32(course.Students)
+		input.Courses = append(input.Courses, &models.Course{ID: recordID("course", course.ID), Name: course.ID, ExpectedEnrollment: &students})
+
+		if course.Lectures == 0 {
+			continue
+		}
+
+		duration, lectures := int32(PeriodMinutes), int32(course.Lectures)
+		enrollment := int32(min(course.Students, largest))
+		teacher := recordID("teacher", course.Teacher)
+		session := models.NewCourseSession(recordID("lecture", course.ID), recordID("course", course.ID), RoomType, SessionType, &duration, &lectures, nil, nil)
+		session.ExpectedEnrollment = &enrollment
+		session.InstructorID = &teacher
+		input.CourseSessions = append(input.CourseSessions, session)
+	}
+
+	for _, curriculum := range in.Curricula {
+		cohort := &models.Cohort{ID: recordID("curriculum", curriculum.ID), Programme: curriculum.ID, Year: 1}
+		for _, course := range curriculum.Courses {
+			cohort.CourseIDs = append(cohort.CourseIDs, recordID("course", course))
+		}
+		input.Cohorts = append(input.Cohorts, cohort)
+	}
+
+	return input
+}
@@ -0,0 +1,259 @@
+// Package itc reads curriculum-based course timetabling instances of the second International
+// Timetabling Competition (ITC-2007, track 3), so the schedulers can be run against its public
+// benchmarks, and reports on a schedule in the competition's terms.
+package itc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Instance is a problem in the competition's .ctt format. Time is divided into Days of
+// PeriodsPerDay periods each, and every lecture takes one period.
+type Instance struct {
+	Name           string
+	Days           int
+	PeriodsPerDay  int
+	Courses        []Course
+	Rooms          []Room
+	Curricula      []Curriculum
+	Unavailability []Unavailability
+}
+
+// Course is a course whose lectures are spread over the week
+type Course struct {
+	ID             string
+	Teacher        string
+	Lectures       int
+	MinWorkingDays int // days the lectures should be spread over at least
+	Students       int
+}
+
+// Room is a room any lecture can be held in
+type Room struct {
+	ID       string
+	Capacity int
+}
+
+// Curriculum is a group of courses with students in common, whose lectures must not overlap
+type Curriculum struct {
+	ID      string
+	Courses []string
+}
+
+// Unavailability is a period in which a course's lectures can't be held
+type Unavailability struct {
+	Course string
+	Day    int
+	Period int
+}
+
+// MaxPeriodsPerDay is the most periods a day can have, so that the periods fit between
+// DayStart and midnight
+const MaxPeriodsPerDay = (24*60 - DayStart) / PeriodMinutes
+
+// Parse reads an instance in the .ctt format
+func Parse(r io.Reader) (*Instance, error) {
+	p := &parser{scanner: bufio.NewScanner(r)}
+	in := &Instance{}
+
+	fields, err := p.expect("Name:", 2)
+	if err != nil {
+		return nil, err
+	}
+	in.Name = fields[1]
+
+	var counts struct{ courses, rooms, curricula, constraints int }
+	for _, header := range []struct {
+		key  string
+		dest *int
+	}{
+		{"Courses:", &counts.courses},
+		{"Rooms:", &counts.rooms},
+		{"Days:", &in.Days},
+		{"Periods_per_day:", &in.PeriodsPerDay},
+		{"Curricula:", &counts.curricula},
+		{"Constraints:", &counts.constraints},
+	} {
+		fields, err := p.expect(header.key, 2)
+		if err != nil {
+			return nil, err
+		}
+		if *header.dest, err = p.count(fields[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Days < 1 || in.Days > 7 {
+		return nil, fmt.Errorf("days must be between 1 and 7, got %d", in.Days)
+	}
+	if in.PeriodsPerDay < 1 || in.PeriodsPerDay > MaxPeriodsPerDay {
+		return nil, fmt.Errorf("periods per day must be between 1 and %d, got %d", MaxPeriodsPerDay, in.PeriodsPerDay)
+	}
+
+	if _, err := p.expect("COURSES:", 1); err != nil {
+		return nil, err
+	}
+	courses := make(map[string]bool, counts.courses)
+	for range counts.courses {
+		fields, err := p.fields(5)
+		if err != nil {
+			return nil, err
+		}
+
+		course := Course{ID: fields[0], Teacher: fields[1]}
+		for i, dest := range []*int{&course.Lectures, &course.MinWorkingDays, &course.Students} {
+			if *dest, err = p.count(fields[i+2]); err != nil {
+				return nil, err
+			}
+		}
+		if courses[course.ID] {
+			return nil, p.errorf("course %q appears more than once", course.ID)
+		}
+		courses[course.ID] = true
+		in.Courses = append(in.Courses, course)
+	}
+
+	if _, err := p.expect("ROOMS:", 1); err != nil {
+		return nil, err
+	}
+	rooms := make(map[string]bool, counts.rooms)
+	for range counts.rooms {
+		fields, err := p.fields(2)
+		if err != nil {
+			return nil, err
+		}
+
+		room := Room{ID: fields[0]}
+		if room.Capacity, err = p.count(fields[1]); err != nil {
+			return nil, err
+		}
+		if rooms[room.ID] {
+			return nil, p.errorf("room %q appears more than once", room.ID)
+		}
+		rooms[room.ID] = true
+		in.Rooms = append(in.Rooms, room)
+	}
+
+	if _, err := p.expect("CURRICULA:", 1); err != nil {
+		return nil, err
+	}
+	for range counts.curricula {
+		fields, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) < 2 {
+			return nil, p.errorf("expected a curriculum and its number of courses")
+		}
+
+		n, err := p.count(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) != n+2 {
+			return nil, p.errorf("expected %d courses, found %d", n, len(fields)-2)
+		}
+
+		curriculum := Curriculum{ID: fields[0], Courses: fields[2:]}
+		for _, course := range curriculum.Courses {
+			if !courses[course] {
+				return nil, p.errorf("unknown course %q", course)
+			}
+		}
+		in.Curricula = append(in.Curricula, curriculum)
+	}
+
+	if _, err := p.expect("UNAVAILABILITY_CONSTRAINTS:", 1); err != nil {
+		return nil, err
+	}
+	for range counts.constraints {
+		fields, err := p.fields(3)
+		if err != nil {
+			return nil, err
+		}
+
+		constraint := Unavailability{Course: fields[0]}
+		if !courses[constraint.Course] {
+			return nil, p.errorf("unknown course %q", constraint.Course)
+		}
+		if constraint.Day, err = p.count(fields[1]); err != nil {
+			return nil, err
+		}
+		if constraint.Period, err = p.count(fields[2]); err != nil {
+			return nil, err
+		}
+		if constraint.Day >= in.Days || constraint.Period >= in.PeriodsPerDay {
+			return nil, p.errorf("day %d, period %d is outside the week", constraint.Day, constraint.Period)
+		}
+		in.Unavailability = append(in.Unavailability, constraint)
+	}
+
+	if _, err := p.expect("END.", 1); err != nil {
+		return nil, err
+	}
+
+	return in, nil
+}
+
+// parser reads an instance line by line, skipping blank lines
+type parser struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// next returns the fields of the next line that isn't blank
+func (p *parser) next() ([]string, error) {
+	for p.scanner.Scan() {
+		p.line++
+		if fields := strings.Fields(p.scanner.Text()); len(fields) > 0 {
+			return fields, nil
+		}
+	}
+
+	if err := p.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("unexpected end of file")
+}
+
+// fields returns the next line, which must have n fields
+func (p *parser) fields(n int) ([]string, error) {
+	fields, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != n {
+		return nil, p.errorf("expected %d values, found %d", n, len(fields))
+	}
+	return fields, nil
+}
+
+// expect returns the next line, which must start with key and have n fields
+func (p *parser) expect(key string, n int) ([]string, error) {
+	fields, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if fields[0] != key || len(fields) != n {
+		return nil, p.errorf("expected %q", key)
+	}
+	return fields, nil
+}
+
+// count parses a number that can't be negative
+func (p *parser) count(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, p.errorf("expected a whole number, found %q", value)
+	}
+	return n, nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,274 @@
+package itc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// Weights of the soft constraints in the competition's cost
+const (
+	RoomCapacityWeight          = 1 // per student without a seat
+	MinWorkingDaysWeight        = 5 // per day short of a course's minimum working days
+	CurriculumCompactnessWeight = 2 // per lecture not adjacent to another of its curriculum
+	RoomStabilityWeight         = 1 // per room a course uses beyond its first
+)
+
+// Assignment places one lecture of a course in a room and period, as in the competition's
+// solution format
+type Assignment struct {
+	Course string
+	Room   string
+	Day    int
+	Period int
+}
+
+// Assign maps scheduled sessions of an input built by Input back onto the instance. Sessions
+// must start on a period and last one period.
+func (in *Instance) Assign(sessions []*models.ScheduledSession) ([]Assignment, error) {
+	courses := make(map[uuid.UUID]string, len(in.Courses))
+	for _, course := range in.Courses {
+		courses[recordID("course", course.ID)] = course.ID
+	}
+	rooms := make(map[uuid.UUID]string, len(in.Rooms))
+	for _, room := range in.Rooms {
+		rooms[recordID("room", room.ID)] = room.ID
+	}
+
+	assignments := make([]Assignment, 0, len(sessions))
+	for _, session := range sessions {
+		course, ok := courses[session.CourseID]
+		if !ok {
+			return nil, fmt.Errorf("course %s is not in the instance", session.CourseID)
+		}
+		room, ok := rooms[session.RoomID]
+		if !ok {
+			return nil, fmt.Errorf("room %s is not in the instance", session.RoomID)
+		}
+
+		offset := session.StartTime - DayStart
+		if offset < 0 || offset%PeriodMinutes != 0 || session.EndTime-session.StartTime != PeriodMinutes {
+			return nil, fmt.Errorf("lecture of %s at %d-%d doesn't fill one period", course, session.StartTime, session.EndTime)
+		}
+		period := offset / PeriodMinutes
+		if session.Day >= in.Days || period >= in.PeriodsPerDay {
+			return nil, fmt.Errorf("lecture of %s on day %d, period %d is outside the week", course, session.Day, period)
+		}
+
+		assignments = append(assignments, Assignment{Course: course, Room: room, Day: session.Day, Period: period})
+	}
+
+	return assignments, nil
+}
+
+// WriteSolution writes assignments in the competition's solution format, which its validator reads
+func WriteSolution(w io.Writer, assignments []Assignment) error {
+	bw := bufio.NewWriter(w)
+	for _, a := range assignments {
+		fmt.Fprintf(bw, "%s %s %d %d\n", a.Course, a.Room, a.Day, a.Period)
+	}
+	return bw.Flush()
+}
+
+// Report counts the violations of a solution's hard constraints and the cost of its soft ones,
+// as the competition's validator does
+type Report struct {
+	// Hard constraints
+	Lectures      int // lectures missing, or more than one of a course in a period
+	Conflicts     int // periods in which two courses of a curriculum or teacher both have a lecture
+	Availability  int // lectures in a period their course is unavailable
+	RoomOccupancy int // lectures sharing a room and period with another
+
+	// Soft constraints, weighted
+	RoomCapacity          int
+	MinWorkingDays        int
+	CurriculumCompactness int
+	RoomStability         int
+}
+
+// Violations is the total number of hard constraint violations; a feasible solution has none
+func (r *Report) Violations() int {
+	return r.Lectures + r.Conflicts + r.Availability + r.RoomOccupancy
+}
+
+// Cost is the competition's objective, the weighted total of the soft constraints
+func (r *Report) Cost() int {
+	return r.RoomCapacity + r.MinWorkingDays + r.CurriculumCompactness + r.RoomStability
+}
+
+// Write prints the report in the layout of the competition's validator
+func (r *Report) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, line := range []struct {
+		name  string
+		hard  bool
+		value int
+	}{
+		{"Lectures", true, r.Lectures},
+		{"Conflicts", true, r.Conflicts},
+		{"Availability", true, r.Availability},
+		{"RoomOccupancy", true, r.RoomOccupancy},
+		{"RoomCapacity", false, r.RoomCapacity},
+		{"MinWorkingDays", false, r.MinWorkingDays},
+		{"CurriculumCompactness", false, r.CurriculumCompactness},
+		{"RoomStability", false, r.RoomStability},
+	} {
+		if line.hard {
+			fmt.Fprintf(bw, "Violations of %s (hard) : %d\n", line.name, line.value)
+		} else {
+			fmt.Fprintf(bw, "Cost of %s (soft) : %d\n", line.name, line.value)
+		}
+	}
+	fmt.Fprintf(bw, "\nSummary: Violations = %d, Total Cost = %d\n", r.Violations(), r.Cost())
+	return bw.Flush()
+}
+
+// Evaluate scores a solution of the instance. Assignments to courses or rooms that aren't in the
+// instance are ignored.
+func (in *Instance) Evaluate(assignments []Assignment) *Report {
+	report := &Report{}
+	periods := in.Days * in.PeriodsPerDay
+
+	courseIndex := make(map[string]int, len(in.Courses))
+	for i, course := range in.Courses {
+		courseIndex[course.ID] = i
+	}
+	roomIndex := make(map[string]int, len(in.Rooms))
+	for i, room := range in.Rooms {
+		roomIndex[room.ID] = i
+	}
+
+	// Lectures of each course, and in each room, per period
+	courseLectures := make([][]int, len(in.Courses))
+	for i := range courseLectures {
+		courseLectures[i] = make([]int, periods)
+	}
+	roomLectures := make([][]int, len(in.Rooms))
+	for i := range roomLectures {
+		roomLectures[i] = make([]int, periods)
+	}
+	courseRooms := make([]map[int]bool, len(in.Courses))
+	for i := range courseRooms {
+		courseRooms[i] = make(map[int]bool)
+	}
+
+	for _, a := range assignments {
+		c, ok := courseIndex[a.Course]
+		if !ok {
+			continue
+		}
+		r, ok := roomIndex[a.Room]
+		if !ok {
+			continue
+		}
+		p := a.Day*in.PeriodsPerDay + a.Period
+
+		courseLectures[c][p]++
+		roomLectures[r][p]++
+		courseRooms[c][r] = true
+		report.RoomCapacity += max(in.Courses[c].Students-in.Rooms[r].Capacity, 0) * RoomCapacityWeight
+	}
+
+	for c, course := range in.Courses {
+		total, days := 0, make(map[int]bool)
+		for p, n := range courseLectures[c] {
+			total += n
+			if n > 0 {
+				days[p/in.PeriodsPerDay] = true
+			}
+			if n > 1 {
+				report.Lectures += n - 1
+			}
+		}
+		report.Lectures += max(course.Lectures-total, 0) + max(total-course.Lectures, 0)
+
+		if len(days) < course.MinWorkingDays {
+			report.MinWorkingDays += (course.MinWorkingDays - len(days)) * MinWorkingDaysWeight
+		}
+		if len(courseRooms[c]) > 1 {
+			report.RoomStability += (len(courseRooms[c]) - 1) * RoomStabilityWeight
+		}
+	}
+
+	for _, u := range in.Unavailability {
+		report.Availability += courseLectures[courseIndex[u.Course]][u.Day*in.PeriodsPerDay+u.Period]
+	}
+
+	for _, lectures := range roomLectures {
+		for _, n := range lectures {
+			if n > 1 {
+				report.RoomOccupancy += n - 1
+			}
+		}
+	}
+
+	conflicts := in.conflicts(courseIndex)
+	for c1 := range in.Courses {
+		for c2 := c1 + 1; c2 < len(in.Courses); c2++ {
+			if !conflicts[c1][c2] {
+				continue
+			}
+			for p := range periods {
+				if courseLectures[c1][p] > 0 && courseLectures[c2][p] > 0 {
+					report.Conflicts++
+				}
+			}
+		}
+	}
+
+	for _, curriculum := range in.Curricula {
+		lectures := make([]int, periods)
+		for _, course := range curriculum.Courses {
+			for p, n := range courseLectures[courseIndex[course]] {
+				lectures[p] += n
+			}
+		}
+
+		for p, n := range lectures {
+			if n == 0 {
+				continue
+			}
+			period := p % in.PeriodsPerDay
+			before := period > 0 && lectures[p-1] > 0
+			after := period < in.PeriodsPerDay-1 && lectures[p+1] > 0
+			if !before && !after {
+				report.CurriculumCompactness += n * CurriculumCompactnessWeight
+			}
+		}
+	}
+
+	return report
+}
+
+// conflicts reports which pairs of courses can't have lectures in the same period, because they
+// share a teacher or a curriculum
+func (in *Instance) conflicts(courseIndex map[string]int) [][]bool {
+	conflicts := make([][]bool, len(in.Courses))
+	for i := range conflicts {
+		conflicts[i] = make([]bool, len(in.Courses))
+	}
+
+	for c1 := range in.Courses {
+		for c2 := range in.Courses {
+			if c1 != c2 && in.Courses[c1].Teacher == in.Courses[c2].Teacher {
+				conflicts[c1][c2] = true
+			}
+		}
+	}
+
+	for _, curriculum := range in.Curricula {
+		for _, course1 := range curriculum.Courses {
+			for _, course2 := range curriculum.Courses {
+				if c1, c2 := courseIndex[course1], courseIndex[course2]; c1 != c2 {
+					conflicts[c1][c2] = true
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+// ShareTokenOwnerFunc returns the ID of the user who shared a token, or "" if no one did
+type ShareTokenOwnerFunc func(ctx context.Context, token string) (string, error)
+
+// ShareTokenMiddleware signs a request in as the user who shared the token in the URL parameter,
+// so public links such as calendar feeds read that user's data through row-level security.
+// It takes the place of AuthMiddleware and must run before TransactionMiddleware.
+func ShareTokenMiddleware(param string, owner ShareTokenOwnerFunc, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reqID := middleware.GetReqID(r.Context())
+
+			userID, err := owner(r.Context(), chi.URLParam(r, param))
+			if err != nil {
+				logger.Error("share token: failed to look up owner",
+					zap.String("request_id", reqID),
+					zap.Error(err),
+				)
+				http.Error(w, "failed to look up share token", http.StatusInternalServerError)
+				return
+			}
+
+			if userID == "" {
+				logger.Debug("share token: unknown token",
+					zap.String("request_id", reqID),
+				)
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), UserIDKey, userID)
+
+			h.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
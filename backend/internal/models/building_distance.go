@@ -0,0 +1,44 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BuildingDistance is the walking time from one building to another. Distances are kept symmetric:
+// setting one direction sets the other too.
+type BuildingDistance struct {
+	FromBuildingID uuid.UUID  `json:"from_building_id"`
+	ToBuildingID   uuid.UUID  `json:"to_building_id"`
+	Minutes        int32      `json:"minutes"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewBuildingDistance(fromBuildingID, toBuildingID uuid.UUID, minutes int32, createdAt *time.Time, updatedAt *time.Time) *BuildingDistance {
+	return &BuildingDistance{
+		FromBuildingID: fromBuildingID,
+		ToBuildingID:   toBuildingID,
+		Minutes:        minutes,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
+
+func (d *BuildingDistance) Validate() error {
+	if d.FromBuildingID == uuid.Nil || d.ToBuildingID == uuid.Nil {
+		return errors.New("both buildings are required")
+	}
+
+	if d.FromBuildingID == d.ToBuildingID {
+		return errors.New("a building has no distance to itself")
+	}
+
+	if d.Minutes < 0 || d.Minutes > 1440 {
+		return errors.New("minutes must be between 0 and 1440")
+	}
+
+	return nil
+}
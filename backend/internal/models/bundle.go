@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// BundleVersion is the version of the bundle format written by export. Restore rejects bundles
+// of any other version.
+const BundleVersion = 1
+
+// Bundle is a snapshot of everything a user has set up for scheduling, for backing it up or
+// moving it to another account or environment. Records keep their IDs and refer to each other
+// by them; restoring gives every record a new ID. Calendar feeds aren't included, since their
+// tokens grant access.
+type Bundle struct {
+	Version            int                  `json:"version"`
+	ExportedAt         time.Time            `json:"exported_at"`
+	RoomTypes          []*RoomType          `json:"room_types"`
+	Buildings          []Building           `json:"buildings"`
+	BuildingDistances  []*BuildingDistance  `json:"building_distances"`
+	Rooms              []*Room              `json:"rooms"`
+	RoomBlackouts      []*RoomBlackout      `json:"room_blackouts"`
+	Instructors        []*Instructor        `json:"instructors"`
+	Courses            []Course             `json:"courses"`
+	Terms              []*Term              `json:"terms"`
+	CourseSessions     []*CourseSession     `json:"course_sessions"`
+	SessionConstraints []*SessionConstraint `json:"session_constraints"`
+	Cohorts            []*Cohort            `json:"cohorts"`
+	Schedules          []*Schedule          `json:"schedules"` // archived schedules included
+}
+
+// BundleReport is the result of restoring a bundle
+type BundleReport struct {
+	Created map[string]int `json:"created"` // records created per bundle field, e.g. "rooms"
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/validation"
+)
+
+// MinCalendarFeedTokenLength is the shortest share token a feed may have
+const MinCalendarFeedTokenLength = 32
+
+// CalendarFeed shares the active schedule as an iCalendar feed at /feeds/{token}.ics, which calendar
+// clients can poll without signing in. The feed follows whichever schedule is active for its term,
+// optionally narrowed to one course, room or building. Deleting the feed revokes its token.
+type CalendarFeed struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Token      string     `json:"token"`                 // generated when the feed is created
+	TermID     *uuid.UUID `json:"term_id,omitempty"`     // nil for the active schedule without a term
+	CourseID   *uuid.UUID `json:"course_id,omitempty"`   // nil for every course
+	RoomID     *uuid.UUID `json:"room_id,omitempty"`     // nil for every room
+	BuildingID *uuid.UUID `json:"building_id,omitempty"` // nil for every building
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewCalendarFeed(
+	id uuid.UUID,
+	name string,
+	token string,
+	termID *uuid.UUID,
+	courseID *uuid.UUID,
+	roomID *uuid.UUID,
+	buildingID *uuid.UUID,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *CalendarFeed {
+	return &CalendarFeed{
+		ID:         id,
+		Name:       name,
+		Token:      token,
+		TermID:     termID,
+		CourseID:   courseID,
+		RoomID:     roomID,
+		BuildingID: buildingID,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func (f *CalendarFeed) Validate() error {
+	if err := validation.ValidateName(f.Name, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	if len(f.Token) < MinCalendarFeedTokenLength {
+		return errors.New("token is too short")
+	}
+
+	return nil
+}
+
+// Filter returns the occurrence filter for the feed's course, room and building
+func (f *CalendarFeed) Filter() OccurrenceFilter {
+	return OccurrenceFilter{
+		CourseID:   f.CourseID,
+		RoomID:     f.RoomID,
+		BuildingID: f.BuildingID,
+	}
+}
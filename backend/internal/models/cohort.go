@@ -0,0 +1,108 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/validation"
+)
+
+// MaxCohortYear limits the year of study to a sensible range
+const MaxCohortYear = 10
+
+// Cohort is a group of students in the same programme and year who take the same courses.
+// Sessions of courses that share a cohort must never overlap.
+type Cohort struct {
+	ID        uuid.UUID   `json:"id"`
+	Programme string      `json:"programme"`
+	Year      int32       `json:"year"`
+	CourseIDs []uuid.UUID `json:"course_ids"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+func NewCohort(
+	id uuid.UUID,
+	programme string,
+	year int32,
+	courseIDs []uuid.UUID,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *Cohort {
+	return &Cohort{
+		ID:        id,
+		Programme: programme,
+		Year:      year,
+		CourseIDs: courseIDs,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (c *Cohort) Validate() error {
+	if err := validation.ValidateName(c.Programme, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	if err := validateCohortYear(c.Year); err != nil {
+		return err
+	}
+
+	return validateCourseIDs(c.CourseIDs)
+}
+
+// HasCourse reports whether the course is a member of the cohort
+func (c *Cohort) HasCourse(courseID uuid.UUID) bool {
+	for _, id := range c.CourseIDs {
+		if id == courseID {
+			return true
+		}
+	}
+	return false
+}
+
+// CohortUpdate represents partial update fields for a Cohort.
+// A non-nil CourseIDs replaces the cohort's member courses.
+type CohortUpdate struct {
+	Programme *string     `json:"programme,omitempty"`
+	Year      *int32      `json:"year,omitempty"`
+	CourseIDs []uuid.UUID `json:"course_ids,omitempty"`
+}
+
+func (u *CohortUpdate) Validate() error {
+	if err := validation.ValidateOptionalName(u.Programme, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	if u.Year != nil {
+		if err := validateCohortYear(*u.Year); err != nil {
+			return err
+		}
+	}
+
+	return validateCourseIDs(u.CourseIDs)
+}
+
+func validateCohortYear(year int32) error {
+	if year <= 0 || year > MaxCohortYear {
+		return fmt.Errorf("year must be between 1 and %d", MaxCohortYear)
+	}
+	return nil
+}
+
+func validateCourseIDs(courseIDs []uuid.UUID) error {
+	seen := make(map[uuid.UUID]bool, len(courseIDs))
+	for _, id := range courseIDs {
+		if id == uuid.Nil {
+			return errors.New("course_ids cannot contain an empty id")
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate course id: %s", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,10 +10,11 @@ import (
 )
 
 type Course struct {
-	ID        uuid.UUID  `json:"id"`
-	Name      string     `json:"name"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	ID                 uuid.UUID  `json:"id"`
+	Name               string     `json:"name"`
+	ExpectedEnrollment *int32     `json:"expected_enrollment,omitempty"` // nil if unknown
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
 }
 
 func NewCourse(
@@ -30,14 +32,39 @@ func NewCourse(
 }
 
 func (c *Course) Validate() error {
-	return validation.ValidateName(c.Name, validation.MaxNameLength)
+	if err := validation.ValidateName(c.Name, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	return validateExpectedEnrollment(c.ExpectedEnrollment)
 }
 
 // CourseUpdate represents partial update fields for a course.
 type CourseUpdate struct {
-	Name *string `json:"name,omitempty"`
+	Name               *string `json:"name,omitempty"`
+	ExpectedEnrollment *int32  `json:"expected_enrollment,omitempty"`
+
+	// A nil ExpectedEnrollment leaves it as it is; ClearExpectedEnrollment makes it unknown again
+	ClearExpectedEnrollment bool `json:"clear_expected_enrollment,omitempty"`
 }
 
 func (u *CourseUpdate) Validate() error {
-	return validation.ValidateOptionalName(u.Name, validation.MaxNameLength)
+	if u.ClearExpectedEnrollment && u.ExpectedEnrollment != nil {
+		return errors.New("expected_enrollment cannot be set while clearing it")
+	}
+
+	if err := validation.ValidateOptionalName(u.Name, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	return validateExpectedEnrollment(u.ExpectedEnrollment)
+}
+
+// validateExpectedEnrollment checks an optional enrollment is positive
+func validateExpectedEnrollment(enrollment *int32) error {
+	if enrollment != nil && *enrollment <= 0 {
+		return errors.New("expected enrollment must be greater than 0")
+	}
+
+	return nil
 }
@@ -16,14 +16,19 @@ var validSessionTypes = map[string]bool{
 }
 
 type CourseSession struct {
-	ID               uuid.UUID  `json:"id"`
-	CourseID         uuid.UUID  `json:"course_id"`
-	RequiredRoom     string     `json:"required_room"`
-	Type             string     `json:"type"` // enum.course_session_type
-	Duration         *int32     `json:"duration"`
-	NumberOfSessions *int32     `json:"number_of_sessions"`
-	CreatedAt        *time.Time `json:"created_at,omitempty"`
-	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	ID                 uuid.UUID  `json:"id"`
+	CourseID           uuid.UUID  `json:"course_id"`
+	RequiredRoom       string     `json:"required_room"`
+	Type               string     `json:"type"` // enum.course_session_type
+	Duration           *int32     `json:"duration"`
+	NumberOfSessions   *int32     `json:"number_of_sessions"`
+	InstructorID       *uuid.UUID `json:"instructor_id,omitempty"`       // nil if no instructor is assigned
+	ExpectedEnrollment *int32     `json:"expected_enrollment,omitempty"` // nil to use the course's enrollment
+	Blocks             *int32     `json:"blocks,omitempty"`              // back-to-back blocks per occurrence in one room; nil for 1
+	ParallelSections   *int32     `json:"parallel_sections,omitempty"`   // sections meeting at once in different rooms; nil for 1
+	TermID             *uuid.UUID `json:"term_id,omitempty"`             // nil to offer the session in every term of its course
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
 }
 
 func NewCourseSession(
@@ -73,18 +78,45 @@ func (c *CourseSession) Validate() error {
 		return errors.New("number of sessions must be greater than 0")
 	}
 
-	return nil
+	if err := validateExpectedEnrollment(c.ExpectedEnrollment); err != nil {
+		return err
+	}
+
+	return validateLinks(c.Blocks, c.ParallelSections)
 }
 
 // CourseSessionUpdate represents partial update fields for a CourseSession.
 type CourseSessionUpdate struct {
-	RequiredRoom     *string `json:"required_room,omitempty"`
-	Type             *string `json:"type,omitempty"`
-	Duration         *int32  `json:"duration,omitempty"`
-	NumberOfSessions *int32  `json:"number_of_sessions,omitempty"`
+	RequiredRoom       *string    `json:"required_room,omitempty"`
+	Type               *string    `json:"type,omitempty"`
+	Duration           *int32     `json:"duration,omitempty"`
+	NumberOfSessions   *int32     `json:"number_of_sessions,omitempty"`
+	InstructorID       *uuid.UUID `json:"instructor_id,omitempty"`
+	ExpectedEnrollment *int32     `json:"expected_enrollment,omitempty"`
+	Blocks             *int32     `json:"blocks,omitempty"`
+	ParallelSections   *int32     `json:"parallel_sections,omitempty"`
+	TermID             *uuid.UUID `json:"term_id,omitempty"`
+
+	// A nil InstructorID, TermID or ExpectedEnrollment leaves it as it is; these remove it
+	// instead. Without an expected enrollment, the course's is used.
+	ClearInstructor         bool `json:"clear_instructor,omitempty"`
+	ClearTerm               bool `json:"clear_term,omitempty"`
+	ClearExpectedEnrollment bool `json:"clear_expected_enrollment,omitempty"`
 }
 
 func (u *CourseSessionUpdate) Validate() error {
+	if u.ClearInstructor && u.InstructorID != nil {
+		return errors.New("instructor_id cannot be set while clearing the instructor")
+	}
+
+	if u.ClearTerm && u.TermID != nil {
+		return errors.New("term_id cannot be set while clearing the term")
+	}
+
+	if u.ClearExpectedEnrollment && u.ExpectedEnrollment != nil {
+		return errors.New("expected_enrollment cannot be set while clearing it")
+	}
+
 	if u.RequiredRoom != nil && strings.TrimSpace(*u.RequiredRoom) == "" {
 		return errors.New("required_room cannot be empty")
 	}
@@ -101,5 +133,30 @@ func (u *CourseSessionUpdate) Validate() error {
 		return errors.New("number of sessions must be greater than 0")
 	}
 
+	if err := validateExpectedEnrollment(u.ExpectedEnrollment); err != nil {
+		return err
+	}
+
+	return validateLinks(u.Blocks, u.ParallelSections)
+}
+
+const (
+	// MaxSessionBlocks limits how many back-to-back blocks one occurrence may run as
+	MaxSessionBlocks = 4
+
+	// MaxParallelSections limits how many rooms one occurrence may use at once
+	MaxParallelSections = 20
+)
+
+// validateLinks checks optional block and section counts are in range
+func validateLinks(blocks, parallelSections *int32) error {
+	if blocks != nil && (*blocks < 1 || *blocks > MaxSessionBlocks) {
+		return fmt.Errorf("blocks must be between 1 and %d", MaxSessionBlocks)
+	}
+
+	if parallelSections != nil && (*parallelSections < 1 || *parallelSections > MaxParallelSections) {
+		return fmt.Errorf("parallel_sections must be between 1 and %d", MaxParallelSections)
+	}
+
 	return nil
 }
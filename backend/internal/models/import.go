@@ -0,0 +1,33 @@
+package models
+
+// ImportEntity is a kind of record that can be bulk imported from a CSV file
+type ImportEntity string
+
+const (
+	ImportBuildings ImportEntity = "buildings"
+	ImportRooms     ImportEntity = "rooms"
+	ImportCourses   ImportEntity = "courses"
+	ImportSessions  ImportEntity = "sessions"
+)
+
+// ImportEntities lists the entities in the order they are imported, so that rows can refer by
+// name to records created earlier in the same import, e.g. a room to a new building
+var ImportEntities = []ImportEntity{ImportBuildings, ImportRooms, ImportCourses, ImportSessions}
+
+// MaxImportRows limits the number of rows in one imported file
+const MaxImportRows = 5000
+
+// ImportRowError is a problem with one row of an imported file, or with the whole file
+type ImportRowError struct {
+	Entity  ImportEntity `json:"entity"`
+	Row     int          `json:"row"` // line in the file, counting the header as line 1; 0 for the whole file
+	Message string       `json:"message"`
+}
+
+// ImportReport is the result of a bulk import. An import with any error creates nothing.
+type ImportReport struct {
+	DryRun  bool                 `json:"dry_run"`
+	Rows    map[ImportEntity]int `json:"rows"`    // rows read per entity
+	Created map[ImportEntity]int `json:"created"` // records created per entity; empty for a dry run
+	Errors  []ImportRowError     `json:"errors"`
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/validation"
+)
+
+type Instructor struct {
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	PreferredStart *int32     `json:"preferred_start,omitempty"` // minutes from midnight; nil if no preference
+	PreferredEnd   *int32     `json:"preferred_end,omitempty"`   // minutes from midnight; nil if no preference
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewInstructor(
+	id uuid.UUID,
+	name string,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *Instructor {
+	return &Instructor{
+		ID:        id,
+		Name:      name,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+}
+
+func (i *Instructor) Validate() error {
+	if err := validation.ValidateName(i.Name, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	if (i.PreferredStart == nil) != (i.PreferredEnd == nil) {
+		return errors.New("preferred_start and preferred_end must be set together")
+	}
+
+	return validatePreferredHours(i.PreferredStart, i.PreferredEnd)
+}
+
+// InstructorUpdate represents partial update fields for an Instructor.
+type InstructorUpdate struct {
+	Name           *string `json:"name,omitempty"`
+	PreferredStart *int32  `json:"preferred_start,omitempty"`
+	PreferredEnd   *int32  `json:"preferred_end,omitempty"`
+
+	// ClearPreferredHours removes both preferred_start and preferred_end, leaving no preference
+	ClearPreferredHours bool `json:"clear_preferred_hours,omitempty"`
+}
+
+func (u *InstructorUpdate) Validate() error {
+	if u.ClearPreferredHours && (u.PreferredStart != nil || u.PreferredEnd != nil) {
+		return errors.New("preferred hours cannot be set while clearing them")
+	}
+
+	if err := validation.ValidateOptionalName(u.Name, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	return validatePreferredHours(u.PreferredStart, u.PreferredEnd)
+}
+
+// validatePreferredHours checks preferred teaching hours fall within a day, start before they end
+func validatePreferredHours(start, end *int32) error {
+	if start != nil && (*start < 0 || *start >= 1440) {
+		return errors.New("preferred_start must be between 0 and 1439 minutes")
+	}
+
+	if end != nil && (*end <= 0 || *end > 1440) {
+		return errors.New("preferred_end must be between 1 and 1440 minutes")
+	}
+
+	if start != nil && end != nil && *end <= *start {
+		return errors.New("preferred_end must be after preferred_start")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// Occurrence is one dated meeting of a scheduled session
+type Occurrence struct {
+	Date            string     `json:"date"`           // YYYY-MM-DD
+	Week            int        `json:"week,omitempty"` // teaching week of the term, from 1; 0 for schedules without a term
+	CourseID        uuid.UUID  `json:"course_id"`
+	CourseSessionID *uuid.UUID `json:"course_session_id,omitempty"`
+	RoomID          uuid.UUID  `json:"room_id"`
+	InstructorID    *uuid.UUID `json:"instructor_id,omitempty"`
+	StartTime       int        `json:"start_time"`        // minutes from midnight
+	EndTime         int        `json:"end_time"`          // minutes from midnight
+	Block           int        `json:"block,omitempty"`   // which back-to-back block of a linked occurrence, from 0
+	Section         int        `json:"section,omitempty"` // which parallel section of a linked occurrence, from 0
+}
+
+// OccurrenceFilter narrows the occurrences listed for a schedule
+type OccurrenceFilter struct {
+	From         string     // YYYY-MM-DD, inclusive; defaults to the start of the schedule's term
+	To           string     // YYYY-MM-DD, inclusive; defaults to the end of the schedule's term
+	RoomID       *uuid.UUID // only occurrences in this room
+	CourseID     *uuid.UUID // only occurrences of this course
+	BuildingID   *uuid.UUID // only occurrences in rooms of this building
+	InstructorID *uuid.UUID // only occurrences taught by this instructor
+}
+
+// Matches reports whether a scheduled session passes the room, course and instructor filters.
+// The building filter needs the session's room, so it is left to the caller.
+func (f *OccurrenceFilter) Matches(session *ScheduledSession) bool {
+	if f.RoomID != nil && session.RoomID != *f.RoomID {
+		return false
+	}
+	if f.CourseID != nil && session.CourseID != *f.CourseID {
+		return false
+	}
+	if f.InstructorID != nil && (session.InstructorID == nil || *session.InstructorID != *f.InstructorID) {
+		return false
+	}
+	return true
+}
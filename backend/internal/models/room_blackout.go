@@ -0,0 +1,151 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/validation"
+)
+
+// Recurrence says how often a room blackout repeats
+type Recurrence string
+
+const (
+	RecurrenceWeekly Recurrence = "weekly" // every week on Day
+	RecurrenceOnce   Recurrence = "once"   // only on Date
+)
+
+// RoomBlackout blocks a room for a time range, e.g. maintenance, department-reserved hours or evening closures
+type RoomBlackout struct {
+	ID         uuid.UUID  `json:"id"`
+	RoomID     uuid.UUID  `json:"room_id"`
+	Day        int32      `json:"day"`        // 0 = Monday, 6 = Sunday
+	StartTime  int32      `json:"start_time"` // minutes from midnight
+	EndTime    int32      `json:"end_time"`   // minutes from midnight
+	Recurrence Recurrence `json:"recurrence"`
+	Date       *string    `json:"date,omitempty"` // YYYY-MM-DD; only for one-off blackouts
+	Reason     *string    `json:"reason,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+func NewRoomBlackout(
+	id uuid.UUID,
+	roomID uuid.UUID,
+	day int32,
+	startTime int32,
+	endTime int32,
+	recurrence Recurrence,
+	date *string,
+	reason *string,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *RoomBlackout {
+	return &RoomBlackout{
+		ID:         id,
+		RoomID:     roomID,
+		Day:        day,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		Recurrence: recurrence,
+		Date:       date,
+		Reason:     reason,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func (b *RoomBlackout) Validate() error {
+	if b.RoomID == uuid.Nil {
+		return errors.New("room_id is required")
+	}
+
+	if err := validateBlackoutDay(&b.Day); err != nil {
+		return err
+	}
+
+	if err := validateBlackoutTimes(&b.StartTime, &b.EndTime); err != nil {
+		return err
+	}
+
+	if err := validation.ValidateOptionalDescription(b.Reason, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	switch b.Recurrence {
+	case RecurrenceWeekly:
+		if b.Date != nil {
+			return errors.New("date is only allowed for one-off blackouts")
+		}
+	case RecurrenceOnce:
+		if b.Date == nil {
+			return errors.New("date is required for one-off blackouts")
+		}
+
+		date, err := time.Parse(time.DateOnly, *b.Date)
+		if err != nil {
+			return errors.New("date must be formatted as YYYY-MM-DD")
+		}
+
+		// time.Weekday starts on Sunday; blackout days start on Monday
+		if weekday := int32(date.Weekday()+6) % 7; weekday != b.Day {
+			return fmt.Errorf("day %d does not match the weekday of %s", b.Day, *b.Date)
+		}
+	default:
+		return fmt.Errorf("recurrence must be %q or %q", RecurrenceWeekly, RecurrenceOnce)
+	}
+
+	return nil
+}
+
+// RoomBlackoutUpdate represents partial update fields for a RoomBlackout.
+// Recurrence and date can't be changed; delete and recreate the blackout instead. The result is
+// validated as a whole, so a one-off blackout's day has to stay the weekday of its date.
+type RoomBlackoutUpdate struct {
+	Day       *int32  `json:"day,omitempty"`
+	StartTime *int32  `json:"start_time,omitempty"`
+	EndTime   *int32  `json:"end_time,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
+func (u *RoomBlackoutUpdate) Validate() error {
+	if u.Day != nil {
+		if err := validateBlackoutDay(u.Day); err != nil {
+			return err
+		}
+	}
+
+	if err := validateBlackoutTimes(u.StartTime, u.EndTime); err != nil {
+		return err
+	}
+
+	return validation.ValidateOptionalDescription(u.Reason, validation.MaxNameLength)
+}
+
+func validateBlackoutDay(day *int32) error {
+	if *day < 0 || *day > 6 {
+		return errors.New("day must be between 0 (Monday) and 6 (Sunday)")
+	}
+
+	return nil
+}
+
+// validateBlackoutTimes checks blackout times fall within a day, start before they end
+func validateBlackoutTimes(start, end *int32) error {
+	if start != nil && (*start < 0 || *start >= 1440) {
+		return errors.New("start_time must be between 0 and 1439 minutes")
+	}
+
+	if end != nil && (*end <= 0 || *end > 1440) {
+		return errors.New("end_time must be between 1 and 1440 minutes")
+	}
+
+	if start != nil && end != nil && *end <= *start {
+		return errors.New("end_time must be after start_time")
+	}
+
+	return nil
+}
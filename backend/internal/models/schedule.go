@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,11 +12,28 @@ import (
 
 // ScheduledSession represents a single scheduled session within a schedule
 type ScheduledSession struct {
-	CourseID  uuid.UUID `json:"course_id"`
-	RoomID    uuid.UUID `json:"room_id"`
-	Day       int       `json:"day"`        // 0-6 (0 = Monday, 6 = Sunday)
-	StartTime int       `json:"start_time"` // minutes from midnight
-	EndTime   int       `json:"end_time"`   // minutes from midnight
+	CourseID        uuid.UUID  `json:"course_id"`
+	CourseSessionID *uuid.UUID `json:"course_session_id,omitempty"` // nil for schedules saved before sessions were tracked
+	RoomID          uuid.UUID  `json:"room_id"`
+	InstructorID    *uuid.UUID `json:"instructor_id,omitempty"`
+	Day             int        `json:"day"`               // 0-6 (0 = Monday, 6 = Sunday)
+	StartTime       int        `json:"start_time"`        // minutes from midnight
+	EndTime         int        `json:"end_time"`          // minutes from midnight
+	Block           int        `json:"block,omitempty"`   // which back-to-back block of a linked occurrence, from 0
+	Section         int        `json:"section,omitempty"` // which parallel section of a linked occurrence, from 0
+}
+
+// SessionPin fixes one weekly occurrence of a course session to a day and start time,
+// and optionally a room. Pins are placed before anything else is scheduled.
+type SessionPin struct {
+	CourseSessionID uuid.UUID  `json:"course_session_id"`
+	Day             int        `json:"day"`        // 0-6 (0 = Monday, 6 = Sunday)
+	StartTime       int        `json:"start_time"` // minutes from midnight
+	RoomID          *uuid.UUID `json:"room_id,omitempty"`
+
+	// SectionRooms are the rooms of the second and later parallel sections, in order; sections
+	// without one get the tightest-fitting free room
+	SectionRooms []uuid.UUID `json:"section_rooms,omitempty"`
 }
 
 // Schedule represents a complete schedule with all sessions
@@ -23,11 +41,20 @@ type Schedule struct {
 	ID         uuid.UUID          `json:"id"`
 	Name       string             `json:"name"`
 	Sessions   []ScheduledSession `json:"sessions"`
+	Pins       []SessionPin       `json:"pins,omitempty"`    // pins the schedule was generated with
+	TermID     *uuid.UUID         `json:"term_id,omitempty"` // term the schedule is for; one schedule is active per term
 	IsActive   bool               `json:"is_active"`
 	IsArchived bool               `json:"is_archived"`
+	Score      *ScheduleScore     `json:"score,omitempty"` // computed on read, not stored
 	CreatedAt  *time.Time         `json:"created_at,omitempty"`
 }
 
+// ScheduleScore rates a schedule against weighted soft constraints; lower is better
+type ScheduleScore struct {
+	Total     float64            `json:"total"`
+	Breakdown map[string]float64 `json:"breakdown"` // weighted penalty per constraint
+}
+
 // MaxScheduleSessions limits the number of sessions to prevent DoS
 const MaxScheduleSessions = 10000
 
@@ -66,13 +93,14 @@ func (s *Schedule) Validate() error {
 		}
 	}
 
-	return nil
+	return validatePins(s.Pins)
 }
 
 // ScheduleUpdate represents partial update fields for a Schedule.
 type ScheduleUpdate struct {
 	Name       *string            `json:"name,omitempty"`
 	Sessions   []ScheduledSession `json:"sessions,omitempty"`
+	Pins       []SessionPin       `json:"pins,omitempty"` // an empty list clears the pins
 	IsActive   *bool              `json:"is_active,omitempty"`
 	IsArchived *bool              `json:"is_archived,omitempty"`
 }
@@ -96,7 +124,7 @@ func (u *ScheduleUpdate) Validate() error {
 		}
 	}
 
-	return nil
+	return validatePins(u.Pins)
 }
 
 func (ss *ScheduledSession) Validate() error {
@@ -116,5 +144,47 @@ func (ss *ScheduledSession) Validate() error {
 		return errors.New("end_time must be after start_time")
 	}
 
+	if ss.Block < 0 || ss.Block >= MaxSessionBlocks {
+		return fmt.Errorf("block must be between 0 and %d", MaxSessionBlocks-1)
+	}
+
+	if ss.Section < 0 || ss.Section >= MaxParallelSections {
+		return fmt.Errorf("section must be between 0 and %d", MaxParallelSections-1)
+	}
+
+	return nil
+}
+
+func (p *SessionPin) Validate() error {
+	if p.CourseSessionID == uuid.Nil {
+		return errors.New("pin course_session_id is required")
+	}
+
+	if p.Day < 0 || p.Day > 6 {
+		return errors.New("pin day must be between 0 and 6")
+	}
+
+	if p.StartTime < 0 || p.StartTime >= 1440 {
+		return errors.New("pin start_time must be between 0 and 1439 minutes")
+	}
+
+	if len(p.SectionRooms) >= MaxParallelSections {
+		return fmt.Errorf("pin may name at most %d section rooms", MaxParallelSections-1)
+	}
+
+	return nil
+}
+
+func validatePins(pins []SessionPin) error {
+	if len(pins) > MaxScheduleSessions {
+		return errors.New("schedule exceeds maximum number of pins")
+	}
+
+	for _, pin := range pins {
+		if err := pin.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
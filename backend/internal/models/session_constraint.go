@@ -0,0 +1,95 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionConstraintKind says how the sessions of two types of one course must be placed relative to each other
+type SessionConstraintKind string
+
+const (
+	// SessionConstraintAfter puts every session of the second type after the first session of the first type ends
+	SessionConstraintAfter SessionConstraintKind = "after"
+
+	// SessionConstraintMinDaysApart keeps sessions of the two types at least MinDays days apart. With the
+	// same type on both sides it applies to every pair of that type's sessions.
+	SessionConstraintMinDaysApart SessionConstraintKind = "min_days_apart"
+
+	// SessionConstraintConsecutive starts every session of the second type straight after a session of
+	// the first type on the same day
+	SessionConstraintConsecutive SessionConstraintKind = "consecutive"
+)
+
+// SessionConstraint is an ordering or separation rule between the session types of a course,
+// e.g. "the lab comes after the lecture"
+type SessionConstraint struct {
+	ID         uuid.UUID             `json:"id"`
+	CourseID   uuid.UUID             `json:"course_id"`
+	Kind       SessionConstraintKind `json:"kind"`
+	FirstType  string                `json:"first_type"`         // enum.course_session_type
+	SecondType string                `json:"second_type"`        // enum.course_session_type
+	MinDays    *int32                `json:"min_days,omitempty"` // only for min_days_apart
+	CreatedAt  *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time            `json:"updated_at,omitempty"`
+}
+
+func NewSessionConstraint(
+	id uuid.UUID,
+	courseID uuid.UUID,
+	kind SessionConstraintKind,
+	firstType string,
+	secondType string,
+	minDays *int32,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *SessionConstraint {
+	return &SessionConstraint{
+		ID:         id,
+		CourseID:   courseID,
+		Kind:       kind,
+		FirstType:  firstType,
+		SecondType: secondType,
+		MinDays:    minDays,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func (c *SessionConstraint) Validate() error {
+	if c.CourseID == uuid.Nil {
+		return errors.New("course_id is required")
+	}
+
+	if !validSessionTypes[c.FirstType] {
+		return fmt.Errorf("invalid first_type: %s", c.FirstType)
+	}
+
+	if !validSessionTypes[c.SecondType] {
+		return fmt.Errorf("invalid second_type: %s", c.SecondType)
+	}
+
+	switch c.Kind {
+	case SessionConstraintMinDaysApart:
+		if c.MinDays == nil {
+			return errors.New("min_days is required for min_days_apart")
+		}
+		if *c.MinDays < 1 || *c.MinDays > 6 {
+			return errors.New("min_days must be between 1 and 6")
+		}
+	case SessionConstraintAfter, SessionConstraintConsecutive:
+		if c.MinDays != nil {
+			return errors.New("min_days is only allowed for min_days_apart")
+		}
+		if c.FirstType == c.SecondType {
+			return fmt.Errorf("first_type and second_type must differ for %s", c.Kind)
+		}
+	default:
+		return fmt.Errorf("kind must be %q, %q or %q", SessionConstraintAfter, SessionConstraintMinDaysApart, SessionConstraintConsecutive)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/validation"
+)
+
+const (
+	// MaxTeachingWeeks limits the teaching weeks of a term to a year
+	MaxTeachingWeeks = 52
+
+	// MaxTermHolidays limits the number of holidays in a term
+	MaxTermHolidays = 100
+)
+
+// Term is an academic term, e.g. "Fall 2025". Courses are offered per term and schedules are
+// generated for one term at a time, with one active schedule per term.
+type Term struct {
+	ID            uuid.UUID     `json:"id"`
+	Name          string        `json:"name"`
+	StartDate     string        `json:"start_date"` // YYYY-MM-DD, first day of the term
+	EndDate       string        `json:"end_date"`   // YYYY-MM-DD, last day of the term
+	TeachingWeeks int32         `json:"teaching_weeks"`
+	Holidays      []TermHoliday `json:"holidays"`
+	CourseIDs     []uuid.UUID   `json:"course_ids"` // courses offered in the term
+	CreatedAt     *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time    `json:"updated_at,omitempty"`
+}
+
+// TermHoliday is a run of days without teaching within a term, e.g. a reading week
+type TermHoliday struct {
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`   // YYYY-MM-DD, inclusive
+}
+
+func NewTerm(
+	id uuid.UUID,
+	name string,
+	startDate string,
+	endDate string,
+	teachingWeeks int32,
+	holidays []TermHoliday,
+	courseIDs []uuid.UUID,
+	createdAt *time.Time,
+	updatedAt *time.Time,
+) *Term {
+	return &Term{
+		ID:            id,
+		Name:          name,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		TeachingWeeks: teachingWeeks,
+		Holidays:      holidays,
+		CourseIDs:     courseIDs,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}
+}
+
+func (t *Term) Validate() error {
+	if err := validation.ValidateName(t.Name, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	start, end, err := parseDateRange(t.StartDate, t.EndDate)
+	if err != nil {
+		return err
+	}
+
+	if err := validateTeachingWeeks(t.TeachingWeeks); err != nil {
+		return err
+	}
+
+	// Teaching weeks can't outnumber the weeks the term touches
+	if weeks := (int(end.Sub(start).Hours()/24) + 7) / 7; int(t.TeachingWeeks) > weeks {
+		return fmt.Errorf("teaching_weeks cannot exceed the %d weeks between start_date and end_date", weeks)
+	}
+
+	if err := validateHolidays(t.Holidays); err != nil {
+		return err
+	}
+
+	for _, holiday := range t.Holidays {
+		if holiday.StartDate < t.StartDate || holiday.EndDate > t.EndDate {
+			return fmt.Errorf("holiday %q must fall within the term", holiday.Name)
+		}
+	}
+
+	return validateCourseIDs(t.CourseIDs)
+}
+
+// Offers reports whether the course is offered in the term
+func (t *Term) Offers(courseID uuid.UUID) bool {
+	for _, id := range t.CourseIDs {
+		if id == courseID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHoliday reports whether the date, formatted as YYYY-MM-DD, falls in one of the term's holidays
+func (t *Term) IsHoliday(date string) bool {
+	for _, holiday := range t.Holidays {
+		if date >= holiday.StartDate && date <= holiday.EndDate {
+			return true
+		}
+	}
+	return false
+}
+
+// TermUpdate represents partial update fields for a Term.
+// A non-nil Holidays or CourseIDs replaces the term's holidays or courses; an empty list clears them.
+type TermUpdate struct {
+	Name          *string       `json:"name,omitempty"`
+	StartDate     *string       `json:"start_date,omitempty"`
+	EndDate       *string       `json:"end_date,omitempty"`
+	TeachingWeeks *int32        `json:"teaching_weeks,omitempty"`
+	Holidays      []TermHoliday `json:"holidays,omitempty"`
+	CourseIDs     []uuid.UUID   `json:"course_ids,omitempty"`
+}
+
+func (u *TermUpdate) Validate() error {
+	if err := validation.ValidateOptionalName(u.Name, validation.MaxNameLength); err != nil {
+		return err
+	}
+
+	if u.StartDate != nil && u.EndDate != nil {
+		if _, _, err := parseDateRange(*u.StartDate, *u.EndDate); err != nil {
+			return err
+		}
+	} else if u.StartDate != nil {
+		if _, err := parseDate("start_date", *u.StartDate); err != nil {
+			return err
+		}
+	} else if u.EndDate != nil {
+		if _, err := parseDate("end_date", *u.EndDate); err != nil {
+			return err
+		}
+	}
+
+	if u.TeachingWeeks != nil {
+		if err := validateTeachingWeeks(*u.TeachingWeeks); err != nil {
+			return err
+		}
+	}
+
+	if err := validateHolidays(u.Holidays); err != nil {
+		return err
+	}
+
+	return validateCourseIDs(u.CourseIDs)
+}
+
+func (h *TermHoliday) Validate() error {
+	if err := validation.ValidateName(h.Name, validation.MaxNameLength); err != nil {
+		return fmt.Errorf("holiday %w", err)
+	}
+
+	if _, _, err := parseDateRange(h.StartDate, h.EndDate); err != nil {
+		return fmt.Errorf("holiday %q: %w", h.Name, err)
+	}
+
+	return nil
+}
+
+func validateTeachingWeeks(weeks int32) error {
+	if weeks <= 0 || weeks > MaxTeachingWeeks {
+		return fmt.Errorf("teaching_weeks must be between 1 and %d", MaxTeachingWeeks)
+	}
+	return nil
+}
+
+func validateHolidays(holidays []TermHoliday) error {
+	if len(holidays) > MaxTermHolidays {
+		return fmt.Errorf("a term may have at most %d holidays", MaxTermHolidays)
+	}
+
+	for _, holiday := range holidays {
+		if err := holiday.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseDateRange parses a start and end date, which may be the same day
+func parseDateRange(startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := parseDate("start_date", startDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end, err := parseDate("end_date", endDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, errors.New("end_date must not be before start_date")
+	}
+
+	return start, end, nil
+}
+
+func parseDate(field, value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be formatted as YYYY-MM-DD", field)
+	}
+	return date, nil
+}
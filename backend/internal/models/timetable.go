@@ -0,0 +1,79 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// Lengths of a timetable's time slots (in minutes)
+const (
+	DefaultTimetableSlot = 60
+	MinTimetableSlot     = 5
+	MaxTimetableSlot     = 240
+)
+
+// Timetable lays a schedule out as a grid per day, with a row per time slot and a column per room
+type Timetable struct {
+	Name  string          `json:"name"`
+	Slot  int             `json:"slot"`  // minutes per row
+	Rooms []TimetableRoom `json:"rooms"` // columns, by building then room name
+	Days  []TimetableDay  `json:"days"`  // days with sessions, from Monday
+}
+
+// TimetableRoom is a column of a timetable
+type TimetableRoom struct {
+	ID       uuid.UUID `json:"id"`
+	Name     string    `json:"name"`
+	Building string    `json:"building,omitempty"`
+}
+
+// Label names the room and its building, e.g. "Room 101 (Science)"
+func (r *TimetableRoom) Label() string {
+	if r.Building == "" {
+		return r.Name
+	}
+	return r.Name + " (" + r.Building + ")"
+}
+
+// TimetableDay is the grid of one day. Every day has the same slots, from the earliest start to
+// the latest end of the week.
+type TimetableDay struct {
+	Day   int             `json:"day"` // 0-6 (0 = Monday, 6 = Sunday)
+	Slots []TimetableSlot `json:"slots"`
+}
+
+// TimetableSlot is a row of a timetable
+type TimetableSlot struct {
+	StartTime int      `json:"start_time"` // minutes from midnight
+	EndTime   int      `json:"end_time"`   // minutes from midnight
+	Cells     []string `json:"cells"`      // what is on in each room, e.g. "Math 101 Lecture"; empty when free
+}
+
+// TimetableFilter picks what a weekly timetable is for; exactly one of its IDs is set
+type TimetableFilter struct {
+	RoomID     *uuid.UUID
+	CourseID   *uuid.UUID
+	BuildingID *uuid.UUID
+}
+
+// WeeklyTimetable is the week of one room, course or building, with a column per day and a row
+// per time slot, for printing
+type WeeklyTimetable struct {
+	Title     string           `json:"title"`    // what the timetable is for, e.g. "Room 101 (Science)"
+	Schedule  string           `json:"schedule"` // name of the schedule
+	Slot      int              `json:"slot"`     // minutes per row
+	Days      []int            `json:"days"`     // 0-6 (0 = Monday, 6 = Sunday), in order
+	StartTime int              `json:"start_time"`
+	EndTime   int              `json:"end_time"`
+	Entries   []TimetableEntry `json:"entries"`
+}
+
+// TimetableEntry is a scheduled session on a weekly timetable. Entries that overlap are drawn
+// side by side, each in its own lane.
+type TimetableEntry struct {
+	Day       int      `json:"day"`
+	StartTime int      `json:"start_time"`
+	EndTime   int      `json:"end_time"`
+	Lines     []string `json:"lines"` // e.g. "Math 101 Lecture", then where and who
+	Lane      int      `json:"lane"`  // from 0
+	Lanes     int      `json:"lanes"` // lanes of the entries this one overlaps, directly or through others
+}
@@ -0,0 +1,274 @@
+// Package pdf writes PDF documents of text, lines and boxes in the standard Helvetica fonts,
+// which every PDF reader has, so no fonts need embedding.
+package pdf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Page sizes in points, 72 to the inch
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+// Font is one of the standard fonts
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+)
+
+// fontNames are the PostScript names of the fonts, and the names of their resources on a page
+var fontNames = [...]struct{ base, resource string }{
+	Helvetica:     {"Helvetica", "F1"},
+	HelveticaBold: {"Helvetica-Bold", "F2"},
+}
+
+// Document is a PDF document of one or more pages
+type Document struct {
+	Title   string
+	Created time.Time // zero leaves out the creation date
+	pages   []*Page
+}
+
+// Page is a page of a document. Coordinates are in points from the top left corner of the page.
+type Page struct {
+	width, height float64
+	content       bytes.Buffer
+}
+
+// AddPage adds a page of the given size to the end of the document
+func (d *Document) AddPage(width, height float64) *Page {
+	page := &Page{width: width, height: height}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// SetFillGray sets the gray level text and filled boxes are drawn in, from 0 (black) to 1 (white)
+func (p *Page) SetFillGray(gray float64) {
+	fmt.Fprintf(&p.content, "%s g\n", number(gray))
+}
+
+// SetStrokeGray sets the gray level lines and box outlines are drawn in
+func (p *Page) SetStrokeGray(gray float64) {
+	fmt.Fprintf(&p.content, "%s G\n", number(gray))
+}
+
+// SetLineWidth sets the width of lines and box outlines
+func (p *Page) SetLineWidth(width float64) {
+	fmt.Fprintf(&p.content, "%s w\n", number(width))
+}
+
+// Line draws a line from (x1, y1) to (x2, y2)
+func (p *Page) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.content, "%s %s m %s %s l S\n", number(x1), number(p.height-y1), number(x2), number(p.height-y2))
+}
+
+// FillRect fills a box whose top left corner is at (x, y)
+func (p *Page) FillRect(x, y, width, height float64) {
+	fmt.Fprintf(&p.content, "%s %s %s %s re f\n", number(x), number(p.height-y-height), number(width), number(height))
+}
+
+// StrokeRect outlines a box whose top left corner is at (x, y)
+func (p *Page) StrokeRect(x, y, width, height float64) {
+	fmt.Fprintf(&p.content, "%s %s %s %s re S\n", number(x), number(p.height-y-height), number(width), number(height))
+}
+
+// Text writes a line of text whose baseline starts at (x, y). Characters outside Latin-1 are
+// written as "?".
+func (p *Page) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		fontNames[font].resource, number(size), number(x), number(p.height-y), escape(text))
+}
+
+// Encode writes the document to w
+func (d *Document) Encode(w io.Writer) error {
+	if len(d.pages) == 0 {
+		return errors.New("a document needs at least one page")
+	}
+
+	e := &encoder{w: bufio.NewWriter(w)}
+	e.printf("%%PDF-1.4\n%%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 to 4 are the catalog, the page tree, the info dictionary and the page resources,
+	// followed by each page and its content
+	const catalog, pages, info, resources, firstPage = 1, 2, 3, 4, 5
+
+	e.object(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pages))
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+	e.object(pages, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+
+	metadata := "<< /Producer (course-scheduler)"
+	if d.Title != "" {
+		metadata += " /Title (" + escape(d.Title) + ")"
+	}
+	if !d.Created.IsZero() {
+		metadata += " /CreationDate (D:" + d.Created.UTC().Format("20060102150405") + "Z)"
+	}
+	e.object(info, metadata+" >>")
+
+	var fonts strings.Builder
+	for _, font := range fontNames {
+		fmt.Fprintf(&fonts, " /%s << /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font.resource, font.base)
+	}
+	e.object(resources, "<< /Font <<"+fonts.String()+" >> >>")
+
+	for i, page := range d.pages {
+		id := firstPage + 2*i
+		e.object(id, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources %d 0 R /Contents %d 0 R >>",
+			pages, number(page.width), number(page.height), resources, id+1))
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write(page.content.Bytes())
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		e.stream(id+1, compressed.Bytes())
+	}
+
+	// The cross-reference table gives the byte offset of each object
+	xref := e.offset
+	e.printf("xref\n0 %d\n0000000000 65535 f \n", len(e.offsets)+1)
+	for _, offset := range e.offsets {
+		e.printf("%010d 00000 n \n", offset)
+	}
+	e.printf("trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(e.offsets)+1, catalog, info, xref)
+
+	return e.w.Flush()
+}
+
+// encoder writes objects in order, keeping track of where each one starts
+type encoder struct {
+	w       *bufio.Writer
+	offset  int
+	offsets []int // of objects 1 onwards
+}
+
+func (e *encoder) printf(format string, args ...any) {
+	n, _ := fmt.Fprintf(e.w, format, args...)
+	e.offset += n
+}
+
+func (e *encoder) object(id int, value string) {
+	e.offsets = append(e.offsets, e.offset)
+	e.printf("%d 0 obj\n%s\nendobj\n", id, value)
+}
+
+func (e *encoder) stream(id int, data []byte) {
+	e.offsets = append(e.offsets, e.offset)
+	e.printf("%d 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", id, len(data))
+	n, _ := e.w.Write(data)
+	e.offset += n
+	e.printf("\nendstream\nendobj\n")
+}
+
+// number formats a coordinate or size with at most two decimals
+func number(f float64) string {
+	s := strings.TrimRight(strconv.FormatFloat(f, 'f', 2, 64), "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// escape writes text as the contents of a PDF string in WinAnsiEncoding
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		c := winAnsi(r)
+		switch {
+		case c == '\\' || c == '(' || c == ')':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 32 || c > 126:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// winAnsi maps a character to its code in WinAnsiEncoding, which matches Latin-1 for the
+// characters it shares with it
+func winAnsi(r rune) byte {
+	switch {
+	case r == '\t' || r == '\n' || r == '\r':
+		return ' '
+	case r >= 32 && r <= 126, r >= 160 && r <= 255:
+		return byte(r)
+	default:
+		return '?'
+	}
+}
+
+// TextWidth measures text in points, as written by Text
+func TextWidth(font Font, size float64, text string) float64 {
+	widths := &helveticaWidths
+	if font == HelveticaBold {
+		widths = &helveticaBoldWidths
+	}
+
+	total := 0
+	for _, r := range text {
+		c := winAnsi(r)
+		if c >= 32 && c <= 126 {
+			total += widths[c-32]
+		} else {
+			total += 556 // most accented letters are as wide as their plain letter
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// Fit shortens text to fit in width, ending it with "..." if anything was cut
+func Fit(font Font, size, width float64, text string) string {
+	if TextWidth(font, size, text) <= width {
+		return text
+	}
+
+	runes := []rune(text)
+	for n := len(runes) - 1; n > 0; n-- {
+		short := strings.TrimRight(string(runes[:n]), " ") + "..."
+		if TextWidth(font, size, short) <= width {
+			return short
+		}
+	}
+	return ""
+}
+
+// Widths of the printable ASCII characters, from space to tilde, in thousandths of the font size
+var (
+	helveticaWidths = [95]int{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	}
+	helveticaBoldWidths = [95]int{
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	}
+)
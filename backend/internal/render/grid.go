@@ -0,0 +1,63 @@
+package render
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/xlsx"
+)
+
+// WriteTimetableCSV writes a timetable as one CSV table, with a row for each slot of each day
+// and a column for each room
+func WriteTimetableCSV(w io.Writer, timetable *models.Timetable) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(timetableHeader([]string{"Day", "Time"}, timetable)); err != nil {
+		return err
+	}
+	for _, day := range timetable.Days {
+		for _, slot := range day.Slots {
+			if err := cw.Write(append([]string{dayNames[day.Day], slotTime(&slot)}, slot.Cells...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// TimetableWorkbook lays a timetable out as a workbook with a sheet for each day. A timetable
+// without sessions gets a single empty sheet, as a workbook can't be empty.
+func TimetableWorkbook(timetable *models.Timetable) *xlsx.Workbook {
+	header := timetableHeader([]string{"Time"}, timetable)
+
+	workbook := &xlsx.Workbook{}
+	for _, day := range timetable.Days {
+		sheet := xlsx.Sheet{Name: dayNames[day.Day], Rows: [][]string{header}, Header: true}
+		for _, slot := range day.Slots {
+			sheet.Rows = append(sheet.Rows, append([]string{slotTime(&slot)}, slot.Cells...))
+		}
+		workbook.Sheets = append(workbook.Sheets, sheet)
+	}
+
+	if len(workbook.Sheets) == 0 {
+		workbook.Sheets = []xlsx.Sheet{{Name: timetable.Name, Rows: [][]string{header}, Header: true}}
+	}
+	return workbook
+}
+
+// timetableHeader labels the grid's columns: the given leading columns, then the rooms
+func timetableHeader(leading []string, timetable *models.Timetable) []string {
+	header := leading
+	for _, room := range timetable.Rooms {
+		header = append(header, room.Label())
+	}
+	return header
+}
+
+// slotTime formats a slot's times, e.g. "09:00-10:00"
+func slotTime(slot *models.TimetableSlot) string {
+	return clock(slot.StartTime) + "-" + clock(slot.EndTime)
+}
@@ -0,0 +1,116 @@
+package render
+
+import (
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/pdf"
+)
+
+// Layout of a printed weekly timetable on a landscape A4 page, in points
+const (
+	pageMargin     = 36
+	titleSize      = 18
+	subtitleSize   = 10
+	gridTop        = 84
+	dayHeader      = 18
+	timeColumn     = 42
+	maxRowHeight   = 48
+	minLabelHeight = 10 // rows are labelled no closer together than this
+	entryPadding   = 3
+)
+
+// TimetablePDF prints weekly timetables on one landscape A4 page each. A building's room
+// timetables print as door signs, one room per page.
+func TimetablePDF(timetables []*models.WeeklyTimetable) *pdf.Document {
+	document := &pdf.Document{Created: time.Now()}
+	if len(timetables) > 0 {
+		document.Title = timetables[0].Schedule
+	}
+
+	for _, timetable := range timetables {
+		printTimetable(document.AddPage(pdf.A4Height, pdf.A4Width), timetable)
+	}
+	if len(timetables) == 0 {
+		page := document.AddPage(pdf.A4Height, pdf.A4Width)
+		page.Text(pageMargin, pageMargin+titleSize, pdf.HelveticaBold, titleSize, "No rooms")
+	}
+	return document
+}
+
+func printTimetable(page *pdf.Page, timetable *models.WeeklyTimetable) {
+	width, height := pdf.A4Height-2*pageMargin, pdf.A4Width-2*pageMargin
+
+	page.SetFillGray(0)
+	page.Text(pageMargin, pageMargin+titleSize, pdf.HelveticaBold, titleSize, pdf.Fit(pdf.HelveticaBold, titleSize, width, timetable.Title))
+	page.Text(pageMargin, pageMargin+titleSize+subtitleSize+8, pdf.Helvetica, subtitleSize, pdf.Fit(pdf.Helvetica, subtitleSize, width, timetable.Schedule))
+
+	rows := (timetable.EndTime - timetable.StartTime) / timetable.Slot
+	if rows == 0 || len(timetable.Days) == 0 {
+		return
+	}
+	rowHeight := min((pageMargin+height-gridTop-dayHeader)/float64(rows), maxRowHeight)
+	columnWidth := (width - timeColumn) / float64(len(timetable.Days))
+	top := float64(gridTop + dayHeader)
+	bottom := top + rowHeight*float64(rows)
+	perMinute := rowHeight / float64(timetable.Slot)
+
+	columns := make(map[int]int, len(timetable.Days))
+	for i, day := range timetable.Days {
+		columns[day] = i
+		x := pageMargin + timeColumn + columnWidth*float64(i)
+		name := pdf.Fit(pdf.HelveticaBold, 10, columnWidth-4, dayNames[day])
+		page.Text(x+(columnWidth-pdf.TextWidth(pdf.HelveticaBold, 10, name))/2, gridTop+13, pdf.HelveticaBold, 10, name)
+	}
+
+	// Rule every slot, labelling as many as fit
+	page.SetLineWidth(0.5)
+	page.SetStrokeGray(0.8)
+	every := 1
+	for rowHeight*float64(every) < minLabelHeight {
+		every++
+	}
+	for row := 0; row <= rows; row++ {
+		y := top + rowHeight*float64(row)
+		page.Line(pageMargin, y, pageMargin+width, y)
+		if row < rows && row%every == 0 {
+			page.Text(pageMargin+2, y+9, pdf.Helvetica, 8, clock(timetable.StartTime+row*timetable.Slot))
+		}
+	}
+	for i := 0; i <= len(timetable.Days); i++ {
+		x := pageMargin + timeColumn + columnWidth*float64(i)
+		page.Line(x, gridTop, x, bottom)
+	}
+	page.SetStrokeGray(0.3)
+	page.StrokeRect(pageMargin, gridTop, width, bottom-gridTop)
+
+	for _, entry := range timetable.Entries {
+		column, ok := columns[entry.Day]
+		if !ok {
+			continue
+		}
+		laneWidth := columnWidth / float64(max(entry.Lanes, 1))
+		x := pageMargin + timeColumn + columnWidth*float64(column) + laneWidth*float64(entry.Lane) + 1
+		y := top + perMinute*float64(entry.StartTime-timetable.StartTime) + 1
+		w, h := laneWidth-2, perMinute*float64(entry.EndTime-entry.StartTime)-2
+
+		page.SetFillGray(0.9)
+		page.FillRect(x, y, w, h)
+		page.StrokeRect(x, y, w, h)
+
+		// As many lines as fit, the first in bold, then the times
+		page.SetFillGray(0)
+		lines := append(append([]string{}, entry.Lines...), clock(entry.StartTime)+"-"+clock(entry.EndTime))
+		for i, line := range lines {
+			font, size := pdf.Helvetica, 7.0
+			if i == 0 {
+				font, size = pdf.HelveticaBold, 8.0
+			}
+			baseline := y + entryPadding + 8 + 9*float64(i)
+			if baseline > y+h-entryPadding+2 {
+				break
+			}
+			page.Text(x+entryPadding, baseline, font, size, pdf.Fit(font, size, w-2*entryPadding, line))
+		}
+	}
+}
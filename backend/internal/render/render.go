@@ -0,0 +1,12 @@
+// Package render writes timetables laid out by the timetable service as files to download.
+package render
+
+import "fmt"
+
+// dayNames names the days of the week, from Monday
+var dayNames = [7]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// clock formats minutes from midnight as HH:MM
+func clock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
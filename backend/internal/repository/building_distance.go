@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ BuildingDistanceRepositoryInterface = (*BuildingDistanceRepository)(nil)
+
+type BuildingDistanceRepositoryInterface interface {
+	Set(ctx context.Context, distance *models.BuildingDistance) (*models.BuildingDistance, error)
+	GetByBuildingID(ctx context.Context, buildingID uuid.UUID) ([]*models.BuildingDistance, error)
+	List(ctx context.Context) ([]*models.BuildingDistance, error)
+	Delete(ctx context.Context, fromBuildingID, toBuildingID uuid.UUID) error
+}
+
+type BuildingDistanceRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewBuildingDistanceRepository(db *sql.DB, logger *zap.Logger) *BuildingDistanceRepository {
+	return &BuildingDistanceRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Set creates or replaces the walking time between two buildings, in both directions
+func (r *BuildingDistanceRepository) Set(ctx context.Context, distance *models.BuildingDistance) (*models.BuildingDistance, error) {
+	if distance == nil {
+		return nil, errors.New("building distance cannot be nil")
+	}
+
+	if err := distance.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	reverse := models.NewBuildingDistance(distance.ToBuildingID, distance.FromBuildingID, distance.Minutes, nil, nil)
+
+	upsertStmt := table.BuildingDistances.
+		INSERT(
+			table.BuildingDistances.FromBuildingID,
+			table.BuildingDistances.ToBuildingID,
+			table.BuildingDistances.Minutes,
+		).
+		MODEL(distance).
+		MODEL(reverse).
+		ON_CONFLICT(table.BuildingDistances.FromBuildingID, table.BuildingDistances.ToBuildingID).
+		DO_UPDATE(SET(
+			table.BuildingDistances.Minutes.SET(table.BuildingDistances.EXCLUDED.Minutes),
+		)).
+		RETURNING(table.BuildingDistances.AllColumns)
+
+	var dest []model.BuildingDistances
+	if err := upsertStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to set building distance", zap.Error(err))
+		return nil, fmt.Errorf("failed to set building distance: %w", err)
+	}
+
+	for i := range dest {
+		if dest[i].FromBuildingID == distance.FromBuildingID {
+			return destToBuildingDistance(&dest[i]), nil
+		}
+	}
+
+	return nil, errors.New("failed to set building distance: no row returned")
+}
+
+// GetByBuildingID lists the walking times from a building to every other building that has one
+func (r *BuildingDistanceRepository) GetByBuildingID(ctx context.Context, buildingID uuid.UUID) ([]*models.BuildingDistance, error) {
+	stmt := table.BuildingDistances.
+		SELECT(table.BuildingDistances.AllColumns).
+		WHERE(table.BuildingDistances.FromBuildingID.EQ(UUID(buildingID))).
+		ORDER_BY(table.BuildingDistances.Minutes.ASC(), table.BuildingDistances.ToBuildingID.ASC())
+
+	var dest []model.BuildingDistances
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get building distances by building id", zap.Error(err), zap.String("building_id", buildingID.String()))
+		return nil, fmt.Errorf("failed to get building distances: %w", err)
+	}
+
+	return destToBuildingDistances(dest), nil
+}
+
+func (r *BuildingDistanceRepository) List(ctx context.Context) ([]*models.BuildingDistance, error) {
+	stmt := table.BuildingDistances.
+		SELECT(table.BuildingDistances.AllColumns).
+		ORDER_BY(table.BuildingDistances.FromBuildingID.ASC(), table.BuildingDistances.ToBuildingID.ASC())
+
+	var dest []model.BuildingDistances
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list building distances", zap.Error(err))
+		return nil, fmt.Errorf("failed to list building distances: %w", err)
+	}
+
+	return destToBuildingDistances(dest), nil
+}
+
+// Delete removes the walking time between two buildings, in both directions
+func (r *BuildingDistanceRepository) Delete(ctx context.Context, fromBuildingID, toBuildingID uuid.UUID) error {
+	deleteStmt := table.BuildingDistances.
+		DELETE().
+		WHERE(
+			table.BuildingDistances.FromBuildingID.EQ(UUID(fromBuildingID)).AND(table.BuildingDistances.ToBuildingID.EQ(UUID(toBuildingID))).
+				OR(table.BuildingDistances.FromBuildingID.EQ(UUID(toBuildingID)).AND(table.BuildingDistances.ToBuildingID.EQ(UUID(fromBuildingID)))),
+		)
+
+	result, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	if err != nil {
+		r.logger.Error("failed to delete building distance", zap.Error(err))
+		return fmt.Errorf("failed to delete building distance: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete building distance: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// destToBuildingDistance converts a database model to a domain model
+func destToBuildingDistance(dest *model.BuildingDistances) *models.BuildingDistance {
+	return models.NewBuildingDistance(
+		dest.FromBuildingID,
+		dest.ToBuildingID,
+		dest.Minutes,
+		dest.CreatedAt,
+		dest.UpdatedAt,
+	)
+}
+
+func destToBuildingDistances(dest []model.BuildingDistances) []*models.BuildingDistance {
+	distances := make([]*models.BuildingDistance, len(dest))
+	for i := range dest {
+		distances[i] = destToBuildingDistance(&dest[i])
+	}
+
+	return distances
+}
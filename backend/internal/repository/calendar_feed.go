@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ CalendarFeedRepositoryInterface = (*CalendarFeedRepository)(nil)
+
+type CalendarFeedRepositoryInterface interface {
+	Create(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.CalendarFeed, error)
+	GetByToken(ctx context.Context, token string) (*models.CalendarFeed, error)
+	GetOwner(ctx context.Context, token string) (uuid.UUID, error)
+	List(ctx context.Context) ([]*models.CalendarFeed, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type CalendarFeedRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewCalendarFeedRepository(db *sql.DB, logger *zap.Logger) *CalendarFeedRepository {
+	return &CalendarFeedRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *CalendarFeedRepository) Create(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+	if feed == nil {
+		return nil, errors.New("calendar feed cannot be nil")
+	}
+
+	if err := feed.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.CalendarFeeds.
+		INSERT(
+			table.CalendarFeeds.ID,
+			table.CalendarFeeds.Name,
+			table.CalendarFeeds.Token,
+			table.CalendarFeeds.TermID,
+			table.CalendarFeeds.CourseID,
+			table.CalendarFeeds.RoomID,
+			table.CalendarFeeds.BuildingID,
+		).
+		MODEL(feed).
+		RETURNING(table.CalendarFeeds.AllColumns)
+
+	var dest model.CalendarFeeds
+	if err := insertStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to create calendar feed", zap.Error(err))
+		return nil, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	return destToCalendarFeed(&dest), nil
+}
+
+func (r *CalendarFeedRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CalendarFeed, error) {
+	return r.get(ctx, table.CalendarFeeds.ID.EQ(UUID(id)))
+}
+
+func (r *CalendarFeedRepository) GetByToken(ctx context.Context, token string) (*models.CalendarFeed, error) {
+	return r.get(ctx, table.CalendarFeeds.Token.EQ(String(token)))
+}
+
+// GetOwner returns the user who created the feed with the token. Feed requests carry no user, so
+// this looks past row-level security; it is the only query that may run without a user.
+func (r *CalendarFeedRepository) GetOwner(ctx context.Context, token string) (uuid.UUID, error) {
+	rows, err := database.GetExecutor(ctx, r.db).QueryContext(ctx, "SELECT scheduler.calendar_feed_owner($1)", token)
+	if err != nil {
+		r.logger.Error("failed to get calendar feed owner", zap.Error(err))
+		return uuid.Nil, fmt.Errorf("failed to get calendar feed owner: %w", err)
+	}
+	defer rows.Close()
+
+	var owner uuid.NullUUID
+	if rows.Next() {
+		if err := rows.Scan(&owner); err != nil {
+			r.logger.Error("failed to scan calendar feed owner", zap.Error(err))
+			return uuid.Nil, fmt.Errorf("failed to get calendar feed owner: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("failed to get calendar feed owner", zap.Error(err))
+		return uuid.Nil, fmt.Errorf("failed to get calendar feed owner: %w", err)
+	}
+
+	if !owner.Valid {
+		return uuid.Nil, ErrNotFound
+	}
+
+	return owner.UUID, nil
+}
+
+func (r *CalendarFeedRepository) List(ctx context.Context) ([]*models.CalendarFeed, error) {
+	stmt := table.CalendarFeeds.
+		SELECT(table.CalendarFeeds.AllColumns).
+		ORDER_BY(table.CalendarFeeds.Name.ASC())
+
+	var dest []model.CalendarFeeds
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list calendar feeds", zap.Error(err))
+		return nil, fmt.Errorf("failed to list calendar feeds: %w", err)
+	}
+
+	feeds := make([]*models.CalendarFeed, len(dest))
+	for i := range dest {
+		feeds[i] = destToCalendarFeed(&dest[i])
+	}
+
+	return feeds, nil
+}
+
+func (r *CalendarFeedRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.CalendarFeeds.
+		DELETE().
+		WHERE(table.CalendarFeeds.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	if err != nil {
+		r.logger.Error("failed to delete calendar feed", zap.Error(err))
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// get returns the one feed matching the condition
+func (r *CalendarFeedRepository) get(ctx context.Context, condition BoolExpression) (*models.CalendarFeed, error) {
+	stmt := table.CalendarFeeds.
+		SELECT(table.CalendarFeeds.AllColumns).
+		WHERE(condition)
+
+	var dest model.CalendarFeeds
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get calendar feed", zap.Error(err))
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	return destToCalendarFeed(&dest), nil
+}
+
+// destToCalendarFeed converts a database model to a domain model
+func destToCalendarFeed(dest *model.CalendarFeeds) *models.CalendarFeed {
+	return models.NewCalendarFeed(
+		dest.ID,
+		dest.Name,
+		dest.Token,
+		dest.TermID,
+		dest.CourseID,
+		dest.RoomID,
+		dest.BuildingID,
+		dest.CreatedAt,
+		dest.UpdatedAt,
+	)
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ CohortRepositoryInterface = (*CohortRepository)(nil)
+
+type CohortRepositoryInterface interface {
+	Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error)
+	List(ctx context.Context) ([]*models.Cohort, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error)
+}
+
+type CohortRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewCohortRepository(db *sql.DB, logger *zap.Logger) *CohortRepository {
+	return &CohortRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *CohortRepository) Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error) {
+	if cohort == nil {
+		return nil, errors.New("cohort cannot be nil")
+	}
+
+	if err := cohort.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.Cohorts.
+		INSERT(
+			table.Cohorts.ID,
+			table.Cohorts.Programme,
+			table.Cohorts.Year,
+		).
+		MODEL(cohort).
+		RETURNING(table.Cohorts.AllColumns)
+
+	var dest model.Cohorts
+	if err := insertStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to create cohort", zap.Error(err))
+		return nil, fmt.Errorf("failed to create cohort: %w", err)
+	}
+
+	if err := r.insertCourses(ctx, dest.ID, cohort.CourseIDs); err != nil {
+		return nil, err
+	}
+
+	return destToCohort(&dest, cohort.CourseIDs), nil
+}
+
+func (r *CohortRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error) {
+	stmt := table.Cohorts.
+		SELECT(table.Cohorts.AllColumns).
+		WHERE(table.Cohorts.ID.EQ(UUID(id)))
+
+	var dest model.Cohorts
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get cohort", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get cohort: %w", err)
+	}
+
+	courseIDs, err := r.listCourses(ctx, table.CohortCourses.CohortID.EQ(UUID(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return destToCohort(&dest, courseIDs[dest.ID]), nil
+}
+
+func (r *CohortRepository) List(ctx context.Context) ([]*models.Cohort, error) {
+	stmt := table.Cohorts.
+		SELECT(table.Cohorts.AllColumns).
+		ORDER_BY(table.Cohorts.Programme.ASC(), table.Cohorts.Year.ASC())
+
+	var dest []model.Cohorts
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list cohorts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list cohorts: %w", err)
+	}
+
+	courseIDs, err := r.listCourses(ctx, Bool(true))
+	if err != nil {
+		return nil, err
+	}
+
+	cohorts := make([]*models.Cohort, len(dest))
+	for i := range dest {
+		cohorts[i] = destToCohort(&dest[i], courseIDs[dest[i].ID])
+	}
+
+	return cohorts, nil
+}
+
+func (r *CohortRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.Cohorts.
+		DELETE().
+		WHERE(table.Cohorts.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	if err != nil {
+		r.logger.Error("failed to delete cohort", zap.Error(err))
+		return fmt.Errorf("failed to delete cohort: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete cohort: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *CohortRepository) Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.Programme != nil {
+		columns = append(columns, table.Cohorts.Programme)
+	}
+	if updates.Year != nil {
+		columns = append(columns, table.Cohorts.Year)
+	}
+
+	if len(columns) == 0 && updates.CourseIDs == nil {
+		return nil, errors.New("no fields to update")
+	}
+
+	// Only the member courses may be changing, in which case the cohort row itself is left untouched
+	if len(columns) > 0 {
+		updateStmt := table.Cohorts.
+			UPDATE(columns).
+			MODEL(updates).
+			WHERE(table.Cohorts.ID.EQ(UUID(id))).
+			RETURNING(table.Cohorts.AllColumns)
+
+		var dest model.Cohorts
+		if err := updateStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+			if errors.Is(err, qrm.ErrNoRows) {
+				return nil, ErrNotFound
+			}
+			r.logger.Error("failed to update cohort", zap.Error(err), zap.String("id", id.String()))
+			return nil, fmt.Errorf("failed to update cohort: %w", err)
+		}
+	} else if _, err := r.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if updates.CourseIDs != nil {
+		deleteStmt := table.CohortCourses.
+			DELETE().
+			WHERE(table.CohortCourses.CohortID.EQ(UUID(id)))
+
+		if _, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db)); err != nil {
+			r.logger.Error("failed to clear cohort courses", zap.Error(err), zap.String("id", id.String()))
+			return nil, fmt.Errorf("failed to update cohort courses: %w", err)
+		}
+
+		if err := r.insertCourses(ctx, id, updates.CourseIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// insertCourses adds member courses to a cohort
+func (r *CohortRepository) insertCourses(ctx context.Context, cohortID uuid.UUID, courseIDs []uuid.UUID) error {
+	if len(courseIDs) == 0 {
+		return nil
+	}
+
+	members := make([]model.CohortCourses, len(courseIDs))
+	for i, courseID := range courseIDs {
+		members[i] = model.CohortCourses{CohortID: cohortID, CourseID: courseID}
+	}
+
+	insertStmt := table.CohortCourses.
+		INSERT(table.CohortCourses.CohortID, table.CohortCourses.CourseID).
+		MODELS(members)
+
+	if _, err := insertStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db)); err != nil {
+		r.logger.Error("failed to add cohort courses", zap.Error(err), zap.String("cohort_id", cohortID.String()))
+		return fmt.Errorf("failed to add cohort courses: %w", err)
+	}
+
+	return nil
+}
+
+// listCourses returns member course IDs grouped by cohort ID
+func (r *CohortRepository) listCourses(ctx context.Context, condition BoolExpression) (map[uuid.UUID][]uuid.UUID, error) {
+	stmt := table.CohortCourses.
+		SELECT(table.CohortCourses.AllColumns).
+		WHERE(condition)
+
+	var dest []model.CohortCourses
+	if err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to list cohort courses", zap.Error(err))
+		return nil, fmt.Errorf("failed to list cohort courses: %w", err)
+	}
+
+	courseIDs := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range dest {
+		courseIDs[d.CohortID] = append(courseIDs[d.CohortID], d.CourseID)
+	}
+
+	return courseIDs, nil
+}
+
+// destToCohort converts a database model to a domain model
+func destToCohort(dest *model.Cohorts, courseIDs []uuid.UUID) *models.Cohort {
+	if courseIDs == nil {
+		courseIDs = []uuid.UUID{}
+	}
+
+	return models.NewCohort(dest.ID, dest.Programme, dest.Year, courseIDs, dest.CreatedAt, dest.UpdatedAt)
+}
@@ -52,6 +52,7 @@ func (c *CourseRepository) Create(ctx context.Context, course *models.Course) (*
 		INSERT(
 			table.Courses.ID,
 			table.Courses.Name,
+			table.Courses.ExpectedEnrollment,
 		).
 		MODEL(course).
 		RETURNING(table.Courses.AllColumns)
@@ -64,7 +65,7 @@ func (c *CourseRepository) Create(ctx context.Context, course *models.Course) (*
 		return nil, fmt.Errorf("failed to create course: %w", err)
 	}
 
-	return models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	return destToCourse(&dest), nil
 }
 
 func (c *CourseRepository) Delete(ctx context.Context, id uuid.UUID) error {
@@ -118,6 +119,7 @@ func (c *CourseRepository) CreateBatch(ctx context.Context, courses []*models.Co
 			INSERT(
 				table.Courses.ID,
 				table.Courses.Name,
+				table.Courses.ExpectedEnrollment,
 			).
 			MODEL(course).
 			RETURNING(table.Courses.AllColumns)
@@ -128,7 +130,7 @@ func (c *CourseRepository) CreateBatch(ctx context.Context, courses []*models.Co
 			return nil, fmt.Errorf("failed to create batch courses: %w", err)
 		}
 
-		newCourses = append(newCourses, models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt))
+		newCourses = append(newCourses, destToCourse(&dest))
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -155,7 +157,7 @@ func (c *CourseRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.C
 		return nil, fmt.Errorf("failed to get course by id: %w", err)
 	}
 
-	return models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	return destToCourse(&dest), nil
 }
 
 func (c *CourseRepository) List(ctx context.Context) ([]models.Course, error) {
@@ -172,13 +174,8 @@ func (c *CourseRepository) List(ctx context.Context) ([]models.Course, error) {
 	}
 
 	courses := make([]models.Course, len(dest))
-	for i, d := range dest {
-		courses[i] = models.Course{
-			ID:        d.ID,
-			Name:      d.Name,
-			CreatedAt: d.CreatedAt,
-			UpdatedAt: d.UpdatedAt,
-		}
+	for i := range dest {
+		courses[i] = *destToCourse(&dest[i])
 	}
 
 	return courses, nil
@@ -197,11 +194,15 @@ func (c *CourseRepository) Update(ctx context.Context, id uuid.UUID, updates *mo
 	if updates.Name != nil {
 		columns = append(columns, table.Courses.Name)
 	}
+	if updates.ExpectedEnrollment != nil || updates.ClearExpectedEnrollment {
+		columns = append(columns, table.Courses.ExpectedEnrollment)
+	}
 
 	if len(columns) == 0 {
 		return nil, errors.New("no fields to update")
 	}
 
+	// A cleared enrollment is nil in the model, so it's set to NULL
 	updateStmt := table.Courses.
 		UPDATE(columns).
 		MODEL(updates).
@@ -218,6 +219,14 @@ func (c *CourseRepository) Update(ctx context.Context, id uuid.UUID, updates *mo
 		return nil, fmt.Errorf("failed to update courses: %w", err)
 	}
 
-	return models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt), nil
+	return destToCourse(&dest), nil
+
+}
+
+// destToCourse converts a database model to a domain model
+func destToCourse(dest *model.Courses) *models.Course {
+	course := models.NewCourse(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt)
+	course.ExpectedEnrollment = dest.ExpectedEnrollment
 
+	return course
 }
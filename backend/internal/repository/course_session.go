@@ -58,6 +58,11 @@ func (r *CourseSessionRepository) Create(ctx context.Context, session *models.Co
 			table.CourseSessions.Type,
 			table.CourseSessions.Duration,
 			table.CourseSessions.NumberOfSessions,
+			table.CourseSessions.InstructorID,
+			table.CourseSessions.ExpectedEnrollment,
+			table.CourseSessions.Blocks,
+			table.CourseSessions.ParallelSections,
+			table.CourseSessions.TermID,
 		).
 		MODEL(session).
 		RETURNING(table.CourseSessions.AllColumns)
@@ -68,16 +73,7 @@ func (r *CourseSessionRepository) Create(ctx context.Context, session *models.Co
 		return nil, fmt.Errorf("failed to create course session: %w", err)
 	}
 
-	return models.NewCourseSession(
-		dest.ID,
-		dest.CourseID,
-		dest.RequiredRoom,
-		string(dest.Type),
-		dest.Duration,
-		dest.NumberOfSessions,
-		dest.CreatedAt,
-		dest.UpdatedAt,
-	), nil
+	return destToCourseSession(&dest), nil
 }
 
 func (r *CourseSessionRepository) CreateBatch(ctx context.Context, sessions []*models.CourseSession) ([]*models.CourseSession, error) {
@@ -111,6 +107,11 @@ func (r *CourseSessionRepository) CreateBatch(ctx context.Context, sessions []*m
 				table.CourseSessions.Type,
 				table.CourseSessions.Duration,
 				table.CourseSessions.NumberOfSessions,
+				table.CourseSessions.InstructorID,
+				table.CourseSessions.ExpectedEnrollment,
+				table.CourseSessions.Blocks,
+				table.CourseSessions.ParallelSections,
+				table.CourseSessions.TermID,
 			).
 			MODEL(session).
 			RETURNING(table.CourseSessions.AllColumns)
@@ -121,16 +122,7 @@ func (r *CourseSessionRepository) CreateBatch(ctx context.Context, sessions []*m
 			return nil, fmt.Errorf("failed to create course session: %w", err)
 		}
 
-		newSessions = append(newSessions, models.NewCourseSession(
-			dest.ID,
-			dest.CourseID,
-			dest.RequiredRoom,
-			string(dest.Type),
-			dest.Duration,
-			dest.NumberOfSessions,
-			dest.CreatedAt,
-			dest.UpdatedAt,
-		))
+		newSessions = append(newSessions, destToCourseSession(&dest))
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -157,16 +149,7 @@ func (r *CourseSessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*m
 		return nil, fmt.Errorf("failed to get course session: %w", err)
 	}
 
-	return models.NewCourseSession(
-		dest.ID,
-		dest.CourseID,
-		dest.RequiredRoom,
-		string(dest.Type),
-		dest.Duration,
-		dest.NumberOfSessions,
-		dest.CreatedAt,
-		dest.UpdatedAt,
-	), nil
+	return destToCourseSession(&dest), nil
 }
 
 func (r *CourseSessionRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.CourseSession, error) {
@@ -184,17 +167,8 @@ func (r *CourseSessionRepository) GetByCourseID(ctx context.Context, courseID uu
 	}
 
 	sessions := make([]*models.CourseSession, len(dest))
-	for i, d := range dest {
-		sessions[i] = models.NewCourseSession(
-			d.ID,
-			d.CourseID,
-			d.RequiredRoom,
-			string(d.Type),
-			d.Duration,
-			d.NumberOfSessions,
-			d.CreatedAt,
-			d.UpdatedAt,
-		)
+	for i := range dest {
+		sessions[i] = destToCourseSession(&dest[i])
 	}
 
 	return sessions, nil
@@ -214,17 +188,8 @@ func (r *CourseSessionRepository) List(ctx context.Context) ([]*models.CourseSes
 	}
 
 	sessions := make([]*models.CourseSession, len(dest))
-	for i, d := range dest {
-		sessions[i] = models.NewCourseSession(
-			d.ID,
-			d.CourseID,
-			d.RequiredRoom,
-			string(d.Type),
-			d.Duration,
-			d.NumberOfSessions,
-			d.CreatedAt,
-			d.UpdatedAt,
-		)
+	for i := range dest {
+		sessions[i] = destToCourseSession(&dest[i])
 	}
 
 	return sessions, nil
@@ -276,11 +241,27 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 	if updates.NumberOfSessions != nil {
 		columns = append(columns, table.CourseSessions.NumberOfSessions)
 	}
+	if updates.InstructorID != nil || updates.ClearInstructor {
+		columns = append(columns, table.CourseSessions.InstructorID)
+	}
+	if updates.ExpectedEnrollment != nil || updates.ClearExpectedEnrollment {
+		columns = append(columns, table.CourseSessions.ExpectedEnrollment)
+	}
+	if updates.Blocks != nil {
+		columns = append(columns, table.CourseSessions.Blocks)
+	}
+	if updates.ParallelSections != nil {
+		columns = append(columns, table.CourseSessions.ParallelSections)
+	}
+	if updates.TermID != nil || updates.ClearTerm {
+		columns = append(columns, table.CourseSessions.TermID)
+	}
 
 	if len(columns) == 0 {
 		return nil, errors.New("no fields to update")
 	}
 
+	// A cleared instructor, term or enrollment is nil in the model, so it's set to NULL
 	updateStmt := table.CourseSessions.
 		UPDATE(columns).
 		MODEL(updates).
@@ -298,7 +279,12 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 		return nil, fmt.Errorf("failed to update course session: %w", err)
 	}
 
-	return models.NewCourseSession(
+	return destToCourseSession(&dest), nil
+}
+
+// destToCourseSession converts a database model to a domain model
+func destToCourseSession(dest *model.CourseSessions) *models.CourseSession {
+	session := models.NewCourseSession(
 		dest.ID,
 		dest.CourseID,
 		dest.RequiredRoom,
@@ -307,5 +293,12 @@ func (r *CourseSessionRepository) Update(ctx context.Context, id uuid.UUID, upda
 		dest.NumberOfSessions,
 		dest.CreatedAt,
 		dest.UpdatedAt,
-	), nil
+	)
+	session.InstructorID = dest.InstructorID
+	session.ExpectedEnrollment = dest.ExpectedEnrollment
+	session.Blocks = dest.Blocks
+	session.ParallelSections = dest.ParallelSections
+	session.TermID = dest.TermID
+
+	return session
 }
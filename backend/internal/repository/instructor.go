@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ InstructorRepositoryInterface = (*InstructorRepository)(nil)
+
+type InstructorRepositoryInterface interface {
+	Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error)
+	CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error)
+	List(ctx context.Context) ([]*models.Instructor, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error)
+}
+
+type InstructorRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewInstructorRepository(db *sql.DB, logger *zap.Logger) *InstructorRepository {
+	return &InstructorRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *InstructorRepository) Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error) {
+	if instructor == nil {
+		return nil, errors.New("instructor cannot be nil")
+	}
+
+	if err := instructor.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.Instructors.
+		INSERT(
+			table.Instructors.ID,
+			table.Instructors.Name,
+			table.Instructors.PreferredStart,
+			table.Instructors.PreferredEnd,
+		).
+		MODEL(instructor).
+		RETURNING(table.Instructors.AllColumns)
+
+	var dest model.Instructors
+	if err := insertStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to create instructor", zap.Error(err))
+		return nil, fmt.Errorf("failed to create instructor: %w", err)
+	}
+
+	return destToInstructor(&dest), nil
+}
+
+func (r *InstructorRepository) CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error) {
+	if len(instructors) < 1 {
+		return nil, errors.New("at least one instructor is required")
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: false})
+	if err != nil {
+		r.logger.Error("failed to begin transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer tx.Rollback()
+
+	var newInstructors []*models.Instructor
+	for _, instructor := range instructors {
+		if instructor == nil {
+			return nil, errors.New("instructor cannot be nil")
+		}
+
+		if err := instructor.Validate(); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+
+		insertStmt := table.Instructors.
+			INSERT(
+				table.Instructors.ID,
+				table.Instructors.Name,
+				table.Instructors.PreferredStart,
+				table.Instructors.PreferredEnd,
+			).
+			MODEL(instructor).
+			RETURNING(table.Instructors.AllColumns)
+
+		var dest model.Instructors
+		if err := insertStmt.QueryContext(ctx, tx, &dest); err != nil {
+			r.logger.Error("failed to create instructor", zap.Error(err))
+			return nil, fmt.Errorf("failed to create instructor: %w", err)
+		}
+
+		newInstructors = append(newInstructors, destToInstructor(&dest))
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return newInstructors, nil
+}
+
+func (r *InstructorRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error) {
+	stmt := table.Instructors.
+		SELECT(table.Instructors.AllColumns).
+		WHERE(table.Instructors.ID.EQ(UUID(id)))
+
+	var dest model.Instructors
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get instructor", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get instructor: %w", err)
+	}
+
+	return destToInstructor(&dest), nil
+}
+
+func (r *InstructorRepository) List(ctx context.Context) ([]*models.Instructor, error) {
+	stmt := table.Instructors.
+		SELECT(table.Instructors.AllColumns).
+		ORDER_BY(table.Instructors.Name.ASC())
+
+	var dest []model.Instructors
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list instructors", zap.Error(err))
+		return nil, fmt.Errorf("failed to list instructors: %w", err)
+	}
+
+	instructors := make([]*models.Instructor, len(dest))
+	for i := range dest {
+		instructors[i] = destToInstructor(&dest[i])
+	}
+
+	return instructors, nil
+}
+
+func (r *InstructorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.Instructors.
+		DELETE().
+		WHERE(table.Instructors.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	if err != nil {
+		r.logger.Error("failed to delete instructor", zap.Error(err))
+		return fmt.Errorf("failed to delete instructor: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete instructor: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *InstructorRepository) Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	if updates.Name != nil {
+		columns = append(columns, table.Instructors.Name)
+	}
+	if updates.PreferredStart != nil || updates.ClearPreferredHours {
+		columns = append(columns, table.Instructors.PreferredStart)
+	}
+	if updates.PreferredEnd != nil || updates.ClearPreferredHours {
+		columns = append(columns, table.Instructors.PreferredEnd)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	// Cleared preferred hours are nil in the model, so both are set to NULL
+	updateStmt := table.Instructors.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(table.Instructors.ID.EQ(UUID(id))).
+		RETURNING(table.Instructors.AllColumns)
+
+	var dest model.Instructors
+	err := updateStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update instructor", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update instructor: %w", err)
+	}
+
+	return destToInstructor(&dest), nil
+}
+
+// destToInstructor converts a database model to a domain model
+func destToInstructor(dest *model.Instructors) *models.Instructor {
+	instructor := models.NewInstructor(dest.ID, dest.Name, dest.CreatedAt, dest.UpdatedAt)
+	instructor.PreferredStart = dest.PreferredStart
+	instructor.PreferredEnd = dest.PreferredEnd
+
+	return instructor
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ RoomBlackoutRepositoryInterface = (*RoomBlackoutRepository)(nil)
+
+type RoomBlackoutRepositoryInterface interface {
+	Create(ctx context.Context, blackout *models.RoomBlackout) (*models.RoomBlackout, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.RoomBlackout, error)
+	GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomBlackout, error)
+	List(ctx context.Context) ([]*models.RoomBlackout, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.RoomBlackoutUpdate) (*models.RoomBlackout, error)
+}
+
+type RoomBlackoutRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewRoomBlackoutRepository(db *sql.DB, logger *zap.Logger) *RoomBlackoutRepository {
+	return &RoomBlackoutRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *RoomBlackoutRepository) Create(ctx context.Context, blackout *models.RoomBlackout) (*models.RoomBlackout, error) {
+	if blackout == nil {
+		return nil, errors.New("room blackout cannot be nil")
+	}
+
+	if err := blackout.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.RoomBlackouts.
+		INSERT(
+			table.RoomBlackouts.ID,
+			table.RoomBlackouts.RoomID,
+			table.RoomBlackouts.Day,
+			table.RoomBlackouts.StartTime,
+			table.RoomBlackouts.EndTime,
+			table.RoomBlackouts.Recurrence,
+			table.RoomBlackouts.Date,
+			table.RoomBlackouts.Reason,
+		).
+		MODEL(blackout).
+		RETURNING(table.RoomBlackouts.AllColumns)
+
+	var dest model.RoomBlackouts
+	if err := insertStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to create room blackout", zap.Error(err))
+		return nil, fmt.Errorf("failed to create room blackout: %w", err)
+	}
+
+	return destToRoomBlackout(&dest), nil
+}
+
+func (r *RoomBlackoutRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RoomBlackout, error) {
+	stmt := table.RoomBlackouts.
+		SELECT(table.RoomBlackouts.AllColumns).
+		WHERE(table.RoomBlackouts.ID.EQ(UUID(id)))
+
+	var dest model.RoomBlackouts
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get room blackout", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get room blackout: %w", err)
+	}
+
+	return destToRoomBlackout(&dest), nil
+}
+
+func (r *RoomBlackoutRepository) GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomBlackout, error) {
+	stmt := table.RoomBlackouts.
+		SELECT(table.RoomBlackouts.AllColumns).
+		WHERE(table.RoomBlackouts.RoomID.EQ(UUID(roomID))).
+		ORDER_BY(table.RoomBlackouts.Day.ASC(), table.RoomBlackouts.StartTime.ASC())
+
+	var dest []model.RoomBlackouts
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get room blackouts by room id", zap.Error(err), zap.String("room_id", roomID.String()))
+		return nil, fmt.Errorf("failed to get room blackouts: %w", err)
+	}
+
+	return destToRoomBlackouts(dest), nil
+}
+
+func (r *RoomBlackoutRepository) List(ctx context.Context) ([]*models.RoomBlackout, error) {
+	stmt := table.RoomBlackouts.
+		SELECT(table.RoomBlackouts.AllColumns).
+		ORDER_BY(table.RoomBlackouts.RoomID.ASC(), table.RoomBlackouts.Day.ASC(), table.RoomBlackouts.StartTime.ASC())
+
+	var dest []model.RoomBlackouts
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list room blackouts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list room blackouts: %w", err)
+	}
+
+	return destToRoomBlackouts(dest), nil
+}
+
+func (r *RoomBlackoutRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.RoomBlackouts.
+		DELETE().
+		WHERE(table.RoomBlackouts.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	if err != nil {
+		r.logger.Error("failed to delete room blackout", zap.Error(err))
+		return fmt.Errorf("failed to delete room blackout: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete room blackout: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *RoomBlackoutRepository) Update(ctx context.Context, id uuid.UUID, updates *models.RoomBlackoutUpdate) (*models.RoomBlackout, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: validation failed: %w", ErrInvalidInput, err)
+	}
+
+	// The fields being changed must still agree with the ones that aren't, e.g. a new day with a
+	// one-off blackout's date
+	existing, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyRoomBlackoutUpdate(existing, updates).Validate(); err != nil {
+		return nil, fmt.Errorf("%w: validation failed: %w", ErrInvalidInput, err)
+	}
+
+	var columns ColumnList
+	if updates.Day != nil {
+		columns = append(columns, table.RoomBlackouts.Day)
+	}
+	if updates.StartTime != nil {
+		columns = append(columns, table.RoomBlackouts.StartTime)
+	}
+	if updates.EndTime != nil {
+		columns = append(columns, table.RoomBlackouts.EndTime)
+	}
+	if updates.Reason != nil {
+		columns = append(columns, table.RoomBlackouts.Reason)
+	}
+
+	if len(columns) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+
+	updateStmt := table.RoomBlackouts.
+		UPDATE(columns).
+		MODEL(updates).
+		WHERE(table.RoomBlackouts.ID.EQ(UUID(id))).
+		RETURNING(table.RoomBlackouts.AllColumns)
+
+	var dest model.RoomBlackouts
+	if err := updateStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to update room blackout", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to update room blackout: %w", err)
+	}
+
+	return destToRoomBlackout(&dest), nil
+}
+
+// applyRoomBlackoutUpdate returns a copy of the blackout with the updates applied
+func applyRoomBlackoutUpdate(blackout *models.RoomBlackout, updates *models.RoomBlackoutUpdate) *models.RoomBlackout {
+	updated := *blackout
+	if updates.Day != nil {
+		updated.Day = *updates.Day
+	}
+	if updates.StartTime != nil {
+		updated.StartTime = *updates.StartTime
+	}
+	if updates.EndTime != nil {
+		updated.EndTime = *updates.EndTime
+	}
+	if updates.Reason != nil {
+		updated.Reason = updates.Reason
+	}
+	return &updated
+}
+
+// destToRoomBlackout converts a database model to a domain model
+func destToRoomBlackout(dest *model.RoomBlackouts) *models.RoomBlackout {
+	var date *string
+	if dest.Date != nil {
+		formatted := dest.Date.Format(time.DateOnly)
+		date = &formatted
+	}
+
+	return models.NewRoomBlackout(
+		dest.ID,
+		dest.RoomID,
+		dest.Day,
+		dest.StartTime,
+		dest.EndTime,
+		models.Recurrence(dest.Recurrence),
+		date,
+		dest.Reason,
+		dest.CreatedAt,
+		dest.UpdatedAt,
+	)
+}
+
+func destToRoomBlackouts(dest []model.RoomBlackouts) []*models.RoomBlackout {
+	blackouts := make([]*models.RoomBlackout, len(dest))
+	for i := range dest {
+		blackouts[i] = destToRoomBlackout(&dest[i])
+	}
+
+	return blackouts
+}
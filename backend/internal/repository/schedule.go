@@ -23,7 +23,9 @@ type ScheduleRepositoryInterface interface {
 	Create(ctx context.Context, schedule *models.Schedule) (*models.Schedule, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Schedule, error)
 	GetByName(ctx context.Context, name string) (*models.Schedule, error)
+	GetActive(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error)
 	List(ctx context.Context) ([]*models.Schedule, error)
+	ListByTerm(ctx context.Context, termID uuid.UUID) ([]*models.Schedule, error)
 	ListArchived(ctx context.Context) ([]*models.Schedule, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Update(ctx context.Context, id uuid.UUID, updates *models.ScheduleUpdate) (*models.Schedule, error)
@@ -49,6 +51,8 @@ type scheduleDBModel struct {
 	ID        uuid.UUID `sql:"primary_key"`
 	Name      string
 	Sessions  string // JSONB as string
+	Pins      string // JSONB as string
+	TermID    *uuid.UUID
 }
 
 func (r *ScheduleRepository) Create(ctx context.Context, schedule *models.Schedule) (*models.Schedule, error) {
@@ -68,14 +72,22 @@ func (r *ScheduleRepository) Create(ctx context.Context, schedule *models.Schedu
 		return nil, fmt.Errorf("failed to marshal sessions: %w", err)
 	}
 
+	pinsJSON, err := marshalPins(schedule.Pins)
+	if err != nil {
+		r.logger.Error("failed to marshal pins", zap.Error(err))
+		return nil, fmt.Errorf("failed to marshal pins: %w", err)
+	}
+
 	dbModel := scheduleDBModel{
 		ID:       schedule.ID,
 		Name:     schedule.Name,
 		Sessions: string(sessionsJSON),
+		Pins:     pinsJSON,
+		TermID:   schedule.TermID,
 	}
 
 	insertStmt := table.Schedules.
-		INSERT(table.Schedules.ID, table.Schedules.Name, table.Schedules.Sessions).
+		INSERT(table.Schedules.ID, table.Schedules.Name, table.Schedules.Sessions, table.Schedules.Pins, table.Schedules.TermID).
 		MODEL(dbModel).
 		RETURNING(table.Schedules.AllColumns)
 
@@ -126,6 +138,27 @@ func (r *ScheduleRepository) GetByName(ctx context.Context, name string) (*model
 	return r.destToSchedule(&dest)
 }
 
+// GetActive returns the schedule currently marked active for the term, or among schedules
+// without a term if termID is nil
+func (r *ScheduleRepository) GetActive(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error) {
+	stmt := table.Schedules.
+		SELECT(table.Schedules.AllColumns).
+		WHERE(table.Schedules.IsActive.EQ(Bool(true)).AND(scheduleTermIs(termID)))
+
+	var dest model.Schedules
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get active schedule", zap.Error(err))
+		return nil, fmt.Errorf("failed to get active schedule: %w", err)
+	}
+
+	return r.destToSchedule(&dest)
+}
+
 func (r *ScheduleRepository) List(ctx context.Context) ([]*models.Schedule, error) {
 	stmt := table.Schedules.
 		SELECT(table.Schedules.AllColumns).
@@ -152,6 +185,33 @@ func (r *ScheduleRepository) List(ctx context.Context) ([]*models.Schedule, erro
 	return schedules, nil
 }
 
+// ListByTerm returns the term's unarchived schedules
+func (r *ScheduleRepository) ListByTerm(ctx context.Context, termID uuid.UUID) ([]*models.Schedule, error) {
+	stmt := table.Schedules.
+		SELECT(table.Schedules.AllColumns).
+		WHERE(table.Schedules.IsArchived.EQ(Bool(false)).AND(table.Schedules.TermID.EQ(UUID(termID)))).
+		ORDER_BY(table.Schedules.IsActive.DESC(), table.Schedules.Name.ASC())
+
+	var dest []model.Schedules
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list schedules by term", zap.Error(err), zap.String("term_id", termID.String()))
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	schedules := make([]*models.Schedule, len(dest))
+	for i := range dest {
+		schedule, err := r.destToSchedule(&dest[i])
+		if err != nil {
+			return nil, err
+		}
+		schedules[i] = schedule
+	}
+
+	return schedules, nil
+}
+
 func (r *ScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	deleteStmt := table.Schedules.
 		DELETE().
@@ -189,6 +249,7 @@ func (r *ScheduleRepository) Update(ctx context.Context, id uuid.UUID, updates *
 	updateModel := struct {
 		Name       *string `json:"name,omitempty"`
 		Sessions   *string `json:"sessions,omitempty"`
+		Pins       *string `json:"pins,omitempty"`
 		IsActive   *bool   `json:"is_active,omitempty"`
 		IsArchived *bool   `json:"is_archived,omitempty"`
 	}{}
@@ -207,6 +268,15 @@ func (r *ScheduleRepository) Update(ctx context.Context, id uuid.UUID, updates *
 		sessionsStr := string(sessionsJSON)
 		updateModel.Sessions = &sessionsStr
 	}
+	if updates.Pins != nil {
+		columns = append(columns, table.Schedules.Pins)
+		pinsJSON, err := marshalPins(updates.Pins)
+		if err != nil {
+			r.logger.Error("failed to marshal pins", zap.Error(err))
+			return nil, fmt.Errorf("failed to marshal pins: %w", err)
+		}
+		updateModel.Pins = &pinsJSON
+	}
 	if updates.IsActive != nil {
 		columns = append(columns, table.Schedules.IsActive)
 		updateModel.IsActive = updates.IsActive
@@ -248,12 +318,24 @@ func (r *ScheduleRepository) destToSchedule(dest *model.Schedules) (*models.Sche
 		return nil, fmt.Errorf("failed to unmarshal sessions: %w", err)
 	}
 
+	var pins []models.SessionPin
+	if dest.Pins != "" {
+		if err := json.Unmarshal([]byte(dest.Pins), &pins); err != nil {
+			r.logger.Error("failed to unmarshal pins", zap.Error(err))
+			return nil, fmt.Errorf("failed to unmarshal pins: %w", err)
+		}
+	}
+
 	name := ""
 	if dest.Name != nil {
 		name = *dest.Name
 	}
 
 	schedule := models.NewSchedule(dest.ID, name, sessions, dest.CreatedAt)
+	schedule.TermID = dest.TermID
+	if len(pins) > 0 {
+		schedule.Pins = pins
+	}
 
 	// Set is_active and is_archived from database
 	if dest.IsActive != nil {
@@ -293,15 +375,20 @@ func (r *ScheduleRepository) ListArchived(ctx context.Context) ([]*models.Schedu
 	return schedules, nil
 }
 
-// SetActive sets a schedule as active and deactivates all other schedules
+// SetActive sets a schedule as active and deactivates all other schedules of the same term
 func (r *ScheduleRepository) SetActive(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
-	// First, deactivate all schedules
+	schedule, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// First, deactivate the term's schedules
 	deactivateStmt := table.Schedules.
 		UPDATE(table.Schedules.IsActive).
 		SET(table.Schedules.IsActive.SET(Bool(false))).
-		WHERE(table.Schedules.IsActive.EQ(Bool(true)))
+		WHERE(table.Schedules.IsActive.EQ(Bool(true)).AND(scheduleTermIs(schedule.TermID)))
 
-	_, err := deactivateStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	_, err = deactivateStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
 	if err != nil {
 		r.logger.Error("failed to deactivate schedules", zap.Error(err))
 		return nil, fmt.Errorf("failed to deactivate schedules: %w", err)
@@ -374,3 +461,25 @@ func (r *ScheduleRepository) Unarchive(ctx context.Context, id uuid.UUID) (*mode
 
 	return r.destToSchedule(&dest)
 }
+
+// scheduleTermIs matches schedules of the term, or schedules without a term if termID is nil
+func scheduleTermIs(termID *uuid.UUID) BoolExpression {
+	if termID == nil {
+		return table.Schedules.TermID.IS_NULL()
+	}
+	return table.Schedules.TermID.EQ(UUID(*termID))
+}
+
+// marshalPins serializes pins for the JSONB column, storing an empty array rather than null
+func marshalPins(pins []models.SessionPin) (string, error) {
+	if pins == nil {
+		pins = []models.SessionPin{}
+	}
+
+	pinsJSON, err := json.Marshal(pins)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pinsJSON), nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ SessionConstraintRepositoryInterface = (*SessionConstraintRepository)(nil)
+
+type SessionConstraintRepositoryInterface interface {
+	Create(ctx context.Context, constraint *models.SessionConstraint) (*models.SessionConstraint, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.SessionConstraint, error)
+	GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.SessionConstraint, error)
+	List(ctx context.Context) ([]*models.SessionConstraint, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type SessionConstraintRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewSessionConstraintRepository(db *sql.DB, logger *zap.Logger) *SessionConstraintRepository {
+	return &SessionConstraintRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *SessionConstraintRepository) Create(ctx context.Context, constraint *models.SessionConstraint) (*models.SessionConstraint, error) {
+	if constraint == nil {
+		return nil, errors.New("session constraint cannot be nil")
+	}
+
+	if err := constraint.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	insertStmt := table.SessionConstraints.
+		INSERT(
+			table.SessionConstraints.ID,
+			table.SessionConstraints.CourseID,
+			table.SessionConstraints.Kind,
+			table.SessionConstraints.FirstType,
+			table.SessionConstraints.SecondType,
+			table.SessionConstraints.MinDays,
+		).
+		MODEL(constraint).
+		RETURNING(table.SessionConstraints.AllColumns)
+
+	var dest model.SessionConstraints
+	if err := insertStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to create session constraint", zap.Error(err))
+		return nil, fmt.Errorf("failed to create session constraint: %w", err)
+	}
+
+	return destToSessionConstraint(&dest), nil
+}
+
+func (r *SessionConstraintRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SessionConstraint, error) {
+	stmt := table.SessionConstraints.
+		SELECT(table.SessionConstraints.AllColumns).
+		WHERE(table.SessionConstraints.ID.EQ(UUID(id)))
+
+	var dest model.SessionConstraints
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get session constraint", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get session constraint: %w", err)
+	}
+
+	return destToSessionConstraint(&dest), nil
+}
+
+func (r *SessionConstraintRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.SessionConstraint, error) {
+	stmt := table.SessionConstraints.
+		SELECT(table.SessionConstraints.AllColumns).
+		WHERE(table.SessionConstraints.CourseID.EQ(UUID(courseID))).
+		ORDER_BY(table.SessionConstraints.Kind.ASC(), table.SessionConstraints.FirstType.ASC(), table.SessionConstraints.SecondType.ASC())
+
+	var dest []model.SessionConstraints
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to get session constraints by course id", zap.Error(err), zap.String("course_id", courseID.String()))
+		return nil, fmt.Errorf("failed to get session constraints: %w", err)
+	}
+
+	return destToSessionConstraints(dest), nil
+}
+
+func (r *SessionConstraintRepository) List(ctx context.Context) ([]*models.SessionConstraint, error) {
+	stmt := table.SessionConstraints.
+		SELECT(table.SessionConstraints.AllColumns).
+		ORDER_BY(
+			table.SessionConstraints.CourseID.ASC(),
+			table.SessionConstraints.Kind.ASC(),
+			table.SessionConstraints.FirstType.ASC(),
+			table.SessionConstraints.SecondType.ASC(),
+		)
+
+	var dest []model.SessionConstraints
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list session constraints", zap.Error(err))
+		return nil, fmt.Errorf("failed to list session constraints: %w", err)
+	}
+
+	return destToSessionConstraints(dest), nil
+}
+
+func (r *SessionConstraintRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.SessionConstraints.
+		DELETE().
+		WHERE(table.SessionConstraints.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	if err != nil {
+		r.logger.Error("failed to delete session constraint", zap.Error(err))
+		return fmt.Errorf("failed to delete session constraint: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete session constraint: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// destToSessionConstraint converts a database model to a domain model
+func destToSessionConstraint(dest *model.SessionConstraints) *models.SessionConstraint {
+	return models.NewSessionConstraint(
+		dest.ID,
+		dest.CourseID,
+		models.SessionConstraintKind(dest.Kind),
+		string(dest.FirstType),
+		string(dest.SecondType),
+		dest.MinDays,
+		dest.CreatedAt,
+		dest.UpdatedAt,
+	)
+}
+
+func destToSessionConstraints(dest []model.SessionConstraints) []*models.SessionConstraint {
+	constraints := make([]*models.SessionConstraint, len(dest))
+	for i := range dest {
+		constraints[i] = destToSessionConstraint(&dest[i])
+	}
+
+	return constraints
+}
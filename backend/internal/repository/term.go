@@ -0,0 +1,381 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/database"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/model"
+	"github.com/TerrenceMurray/course-scheduler/internal/database/postgres/scheduler/table"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ TermRepositoryInterface = (*TermRepository)(nil)
+
+type TermRepositoryInterface interface {
+	Create(ctx context.Context, term *models.Term) (*models.Term, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Term, error)
+	List(ctx context.Context) ([]*models.Term, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.TermUpdate) (*models.Term, error)
+}
+
+type TermRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+func NewTermRepository(db *sql.DB, logger *zap.Logger) *TermRepository {
+	return &TermRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// termDBModel is used for inserting with JSONB holidays
+type termDBModel struct {
+	ID            uuid.UUID `sql:"primary_key"`
+	Name          string
+	StartDate     string
+	EndDate       string
+	TeachingWeeks int32
+	Holidays      string // JSONB as string
+}
+
+func (r *TermRepository) Create(ctx context.Context, term *models.Term) (*models.Term, error) {
+	if term == nil {
+		return nil, errors.New("term cannot be nil")
+	}
+
+	if err := term.Validate(); err != nil {
+		r.logger.Error("validation failed", zap.Error(err))
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	holidaysJSON, err := marshalHolidays(term.Holidays)
+	if err != nil {
+		r.logger.Error("failed to marshal holidays", zap.Error(err))
+		return nil, fmt.Errorf("failed to marshal holidays: %w", err)
+	}
+
+	dbModel := termDBModel{
+		ID:            term.ID,
+		Name:          term.Name,
+		StartDate:     term.StartDate,
+		EndDate:       term.EndDate,
+		TeachingWeeks: term.TeachingWeeks,
+		Holidays:      holidaysJSON,
+	}
+
+	insertStmt := table.Terms.
+		INSERT(
+			table.Terms.ID,
+			table.Terms.Name,
+			table.Terms.StartDate,
+			table.Terms.EndDate,
+			table.Terms.TeachingWeeks,
+			table.Terms.Holidays,
+		).
+		MODEL(dbModel).
+		RETURNING(table.Terms.AllColumns)
+
+	var dest model.Terms
+	if err := insertStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to create term", zap.Error(err))
+		return nil, fmt.Errorf("failed to create term: %w", err)
+	}
+
+	if err := r.insertCourses(ctx, dest.ID, term.CourseIDs); err != nil {
+		return nil, err
+	}
+
+	return r.destToTerm(&dest, term.CourseIDs)
+}
+
+func (r *TermRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Term, error) {
+	stmt := table.Terms.
+		SELECT(table.Terms.AllColumns).
+		WHERE(table.Terms.ID.EQ(UUID(id)))
+
+	var dest model.Terms
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		if errors.Is(err, qrm.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		r.logger.Error("failed to get term", zap.Error(err), zap.String("id", id.String()))
+		return nil, fmt.Errorf("failed to get term: %w", err)
+	}
+
+	courseIDs, err := r.listCourses(ctx, table.TermCourses.TermID.EQ(UUID(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.destToTerm(&dest, courseIDs[dest.ID])
+}
+
+func (r *TermRepository) List(ctx context.Context) ([]*models.Term, error) {
+	stmt := table.Terms.
+		SELECT(table.Terms.AllColumns).
+		ORDER_BY(table.Terms.StartDate.ASC(), table.Terms.Name.ASC())
+
+	var dest []model.Terms
+	err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest)
+
+	if err != nil {
+		r.logger.Error("failed to list terms", zap.Error(err))
+		return nil, fmt.Errorf("failed to list terms: %w", err)
+	}
+
+	courseIDs, err := r.listCourses(ctx, Bool(true))
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([]*models.Term, len(dest))
+	for i := range dest {
+		term, err := r.destToTerm(&dest[i], courseIDs[dest[i].ID])
+		if err != nil {
+			return nil, err
+		}
+		terms[i] = term
+	}
+
+	return terms, nil
+}
+
+func (r *TermRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deleteStmt := table.Terms.
+		DELETE().
+		WHERE(table.Terms.ID.EQ(UUID(id)))
+
+	result, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db))
+	if err != nil {
+		r.logger.Error("failed to delete term", zap.Error(err))
+		return fmt.Errorf("failed to delete term: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.Error("failed to get rows affected", zap.Error(err))
+		return fmt.Errorf("failed to delete term: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *TermRepository) Update(ctx context.Context, id uuid.UUID, updates *models.TermUpdate) (*models.Term, error) {
+	if updates == nil {
+		return nil, errors.New("updates cannot be nil")
+	}
+
+	if err := updates.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// The fields being changed must still agree with the ones that aren't, e.g. a new end_date
+	// with the term's holidays
+	existing, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyTermUpdate(existing, updates).Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var columns ColumnList
+	updateModel := struct {
+		Name          *string
+		StartDate     *string
+		EndDate       *string
+		TeachingWeeks *int32
+		Holidays      *string
+	}{}
+
+	if updates.Name != nil {
+		columns = append(columns, table.Terms.Name)
+		updateModel.Name = updates.Name
+	}
+	if updates.StartDate != nil {
+		columns = append(columns, table.Terms.StartDate)
+		updateModel.StartDate = updates.StartDate
+	}
+	if updates.EndDate != nil {
+		columns = append(columns, table.Terms.EndDate)
+		updateModel.EndDate = updates.EndDate
+	}
+	if updates.TeachingWeeks != nil {
+		columns = append(columns, table.Terms.TeachingWeeks)
+		updateModel.TeachingWeeks = updates.TeachingWeeks
+	}
+	if updates.Holidays != nil {
+		columns = append(columns, table.Terms.Holidays)
+		holidaysJSON, err := marshalHolidays(updates.Holidays)
+		if err != nil {
+			r.logger.Error("failed to marshal holidays", zap.Error(err))
+			return nil, fmt.Errorf("failed to marshal holidays: %w", err)
+		}
+		updateModel.Holidays = &holidaysJSON
+	}
+
+	if len(columns) == 0 && updates.CourseIDs == nil {
+		return nil, errors.New("no fields to update")
+	}
+
+	// Only the offered courses may be changing, in which case the term row itself is left untouched
+	if len(columns) > 0 {
+		updateStmt := table.Terms.
+			UPDATE(columns).
+			MODEL(updateModel).
+			WHERE(table.Terms.ID.EQ(UUID(id))).
+			RETURNING(table.Terms.AllColumns)
+
+		var dest model.Terms
+		if err := updateStmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+			if errors.Is(err, qrm.ErrNoRows) {
+				return nil, ErrNotFound
+			}
+			r.logger.Error("failed to update term", zap.Error(err), zap.String("id", id.String()))
+			return nil, fmt.Errorf("failed to update term: %w", err)
+		}
+	}
+
+	if updates.CourseIDs != nil {
+		deleteStmt := table.TermCourses.
+			DELETE().
+			WHERE(table.TermCourses.TermID.EQ(UUID(id)))
+
+		if _, err := deleteStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db)); err != nil {
+			r.logger.Error("failed to clear term courses", zap.Error(err), zap.String("id", id.String()))
+			return nil, fmt.Errorf("failed to update term courses: %w", err)
+		}
+
+		if err := r.insertCourses(ctx, id, updates.CourseIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.GetByID(ctx, id)
+}
+
+// applyTermUpdate returns a copy of the term with the updates applied
+func applyTermUpdate(term *models.Term, updates *models.TermUpdate) *models.Term {
+	updated := *term
+	if updates.Name != nil {
+		updated.Name = *updates.Name
+	}
+	if updates.StartDate != nil {
+		updated.StartDate = *updates.StartDate
+	}
+	if updates.EndDate != nil {
+		updated.EndDate = *updates.EndDate
+	}
+	if updates.TeachingWeeks != nil {
+		updated.TeachingWeeks = *updates.TeachingWeeks
+	}
+	if updates.Holidays != nil {
+		updated.Holidays = updates.Holidays
+	}
+	if updates.CourseIDs != nil {
+		updated.CourseIDs = updates.CourseIDs
+	}
+	return &updated
+}
+
+// insertCourses adds offered courses to a term
+func (r *TermRepository) insertCourses(ctx context.Context, termID uuid.UUID, courseIDs []uuid.UUID) error {
+	if len(courseIDs) == 0 {
+		return nil
+	}
+
+	offered := make([]model.TermCourses, len(courseIDs))
+	for i, courseID := range courseIDs {
+		offered[i] = model.TermCourses{TermID: termID, CourseID: courseID}
+	}
+
+	insertStmt := table.TermCourses.
+		INSERT(table.TermCourses.TermID, table.TermCourses.CourseID).
+		MODELS(offered)
+
+	if _, err := insertStmt.ExecContext(ctx, database.GetExecutor(ctx, r.db)); err != nil {
+		r.logger.Error("failed to add term courses", zap.Error(err), zap.String("term_id", termID.String()))
+		return fmt.Errorf("failed to add term courses: %w", err)
+	}
+
+	return nil
+}
+
+// listCourses returns offered course IDs grouped by term ID
+func (r *TermRepository) listCourses(ctx context.Context, condition BoolExpression) (map[uuid.UUID][]uuid.UUID, error) {
+	stmt := table.TermCourses.
+		SELECT(table.TermCourses.AllColumns).
+		WHERE(condition)
+
+	var dest []model.TermCourses
+	if err := stmt.QueryContext(ctx, database.GetExecutor(ctx, r.db), &dest); err != nil {
+		r.logger.Error("failed to list term courses", zap.Error(err))
+		return nil, fmt.Errorf("failed to list term courses: %w", err)
+	}
+
+	courseIDs := make(map[uuid.UUID][]uuid.UUID)
+	for _, d := range dest {
+		courseIDs[d.TermID] = append(courseIDs[d.TermID], d.CourseID)
+	}
+
+	return courseIDs, nil
+}
+
+// destToTerm converts a database model to a domain model
+func (r *TermRepository) destToTerm(dest *model.Terms, courseIDs []uuid.UUID) (*models.Term, error) {
+	holidays := []models.TermHoliday{}
+	if dest.Holidays != "" {
+		if err := json.Unmarshal([]byte(dest.Holidays), &holidays); err != nil {
+			r.logger.Error("failed to unmarshal holidays", zap.Error(err))
+			return nil, fmt.Errorf("failed to unmarshal holidays: %w", err)
+		}
+	}
+
+	if courseIDs == nil {
+		courseIDs = []uuid.UUID{}
+	}
+
+	return models.NewTerm(
+		dest.ID,
+		dest.Name,
+		dest.StartDate.Format(time.DateOnly),
+		dest.EndDate.Format(time.DateOnly),
+		dest.TeachingWeeks,
+		holidays,
+		courseIDs,
+		dest.CreatedAt,
+		dest.UpdatedAt,
+	), nil
+}
+
+// marshalHolidays serializes holidays for the JSONB column, storing an empty array rather than null
+func marshalHolidays(holidays []models.TermHoliday) (string, error) {
+	if holidays == nil {
+		holidays = []models.TermHoliday{}
+	}
+
+	holidaysJSON, err := json.Marshal(holidays)
+	if err != nil {
+		return "", err
+	}
+
+	return string(holidaysJSON), nil
+}
@@ -0,0 +1,306 @@
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// ConsecutiveSlack is how long after MinBreakBetweenSessions a session may start and still count as
+// straight after the one before it (in minutes), so slot alignment doesn't break consecutive pairs
+const ConsecutiveSlack = 15
+
+// ConstraintViolation is a session constraint that a schedule doesn't meet
+type ConstraintViolation struct {
+	ConstraintID uuid.UUID                    `json:"constraint_id"`
+	CourseID     uuid.UUID                    `json:"course_id"`
+	Kind         models.SessionConstraintKind `json:"kind"`
+	Reason       string                       `json:"reason"`
+}
+
+// PlacedSession is where one occurrence of a course session of the given type was placed
+type PlacedSession struct {
+	Type      string
+	Day       int
+	StartTime int
+	EndTime   int
+}
+
+// SessionOrdering looks up the session constraints of each course while scheduling
+type SessionOrdering struct {
+	config   *Config
+	byCourse map[uuid.UUID][]*models.SessionConstraint
+	types    map[uuid.UUID][]string // session types each course has
+}
+
+func NewSessionOrdering(constraints []*models.SessionConstraint, sessions []*models.CourseSession, config *Config) *SessionOrdering {
+	o := &SessionOrdering{
+		config:   config,
+		byCourse: make(map[uuid.UUID][]*models.SessionConstraint),
+		types:    make(map[uuid.UUID][]string),
+	}
+
+	for _, c := range constraints {
+		if c != nil {
+			o.byCourse[c.CourseID] = append(o.byCourse[c.CourseID], c)
+		}
+	}
+
+	for _, session := range sessions {
+		if session != nil && !slices.Contains(o.types[session.CourseID], session.Type) {
+			o.types[session.CourseID] = append(o.types[session.CourseID], session.Type)
+		}
+	}
+
+	return o
+}
+
+// Constrained reports whether the course has any session constraints
+func (o *SessionOrdering) Constrained(courseID uuid.UUID) bool {
+	return len(o.byCourse[courseID]) > 0
+}
+
+// Rank orders a course's session types so that types others must follow come first. Placing sessions
+// in ascending rank means the session a constraint depends on is always placed before the one it constrains.
+func (o *SessionOrdering) Rank(courseID uuid.UUID, sessionType string) int {
+	constraints := o.byCourse[courseID]
+	rank := make(map[string]int)
+
+	// Longest chain of "after" and "consecutive" constraints leading to each type; cycles stop growing
+	// after one pass per constraint
+	for range constraints {
+		for _, c := range constraints {
+			if c.Kind != models.SessionConstraintMinDaysApart && rank[c.SecondType] <= rank[c.FirstType] {
+				rank[c.SecondType] = rank[c.FirstType] + 1
+			}
+		}
+	}
+
+	return rank[sessionType]
+}
+
+// Allowed returns the parts of the operating hours on day in which a session of the given type and
+// duration may be placed, given the course's sessions placed so far. It is empty if none of the day is.
+func (o *SessionOrdering) Allowed(courseID uuid.UUID, sessionType string, day, duration int, placed []PlacedSession) []TimeRange {
+	allowed := []TimeRange{o.config.OperatingHours}
+
+	for _, c := range o.byCourse[courseID] {
+		switch c.Kind {
+		case models.SessionConstraintAfter:
+			if c.SecondType != sessionType || !slices.Contains(o.types[courseID], c.FirstType) {
+				continue
+			}
+
+			first, ok := earliest(placed, c.FirstType)
+			if !ok || day < first.Day {
+				return nil
+			}
+			if day == first.Day {
+				allowed = SubtractRange(allowed, 0, first.EndTime)
+			}
+
+		case models.SessionConstraintConsecutive:
+			if c.SecondType != sessionType || !slices.Contains(o.types[courseID], c.FirstType) {
+				continue
+			}
+
+			var windows []TimeRange
+			for _, p := range placed {
+				if p.Type == c.FirstType && p.Day == day {
+					windows = append(windows, TimeRange{Start: p.EndTime, End: p.EndTime + o.consecutiveGap() + duration})
+				}
+			}
+			allowed = intersect(allowed, windows)
+
+		case models.SessionConstraintMinDaysApart:
+			for _, p := range placed {
+				related := (c.SecondType == sessionType && p.Type == c.FirstType) || (c.FirstType == sessionType && p.Type == c.SecondType)
+				if related && abs(p.Day-day) < int(*c.MinDays) {
+					return nil
+				}
+			}
+		}
+
+		if len(allowed) == 0 {
+			return nil
+		}
+	}
+
+	return allowed
+}
+
+// Violations checks the placed sessions of a course against its constraints, reporting each broken
+// constraint once. If complete is false, sessions missing from placed may still be placed later, so a
+// constraint with nothing placed on the side it depends on isn't reported.
+func (o *SessionOrdering) Violations(courseID uuid.UUID, placed []PlacedSession, complete bool) []ConstraintViolation {
+	var violations []ConstraintViolation
+
+	for _, c := range o.byCourse[courseID] {
+		if reason := o.violation(c, placed, complete); reason != "" {
+			violations = append(violations, ConstraintViolation{
+				ConstraintID: c.ID,
+				CourseID:     c.CourseID,
+				Kind:         c.Kind,
+				Reason:       reason,
+			})
+		}
+	}
+
+	return violations
+}
+
+// violation explains how placed breaks c, or returns "" if it doesn't
+func (o *SessionOrdering) violation(c *models.SessionConstraint, placed []PlacedSession, complete bool) string {
+	var firsts, seconds []PlacedSession
+	for _, p := range placed {
+		if p.Type == c.FirstType {
+			firsts = append(firsts, p)
+		}
+		if p.Type == c.SecondType {
+			seconds = append(seconds, p)
+		}
+	}
+
+	switch c.Kind {
+	case models.SessionConstraintAfter, models.SessionConstraintConsecutive:
+		if len(seconds) == 0 {
+			return ""
+		}
+		if len(firsts) == 0 {
+			if complete && slices.Contains(o.types[c.CourseID], c.FirstType) {
+				return fmt.Sprintf("no %s is scheduled for the %s to follow", c.FirstType, c.SecondType)
+			}
+			return ""
+		}
+
+		if c.Kind == models.SessionConstraintAfter {
+			first, _ := earliest(firsts, c.FirstType)
+			for _, s := range seconds {
+				if weekMinute(s.Day, s.StartTime) < weekMinute(first.Day, first.EndTime) {
+					return fmt.Sprintf("a %s starts before the first %s ends", c.SecondType, c.FirstType)
+				}
+			}
+			return ""
+		}
+
+		for _, s := range seconds {
+			follows := slices.ContainsFunc(firsts, func(f PlacedSession) bool {
+				return f.Day == s.Day && s.StartTime >= f.EndTime && s.StartTime <= f.EndTime+o.consecutiveGap()
+			})
+			if !follows {
+				return fmt.Sprintf("a %s does not start straight after a %s on the same day", c.SecondType, c.FirstType)
+			}
+		}
+
+	case models.SessionConstraintMinDaysApart:
+		minDays := int(*c.MinDays)
+		for i, a := range firsts {
+			for j, b := range seconds {
+				// With one type on both sides, compare each pair of its sessions once
+				if c.FirstType == c.SecondType && j <= i {
+					continue
+				}
+				if abs(a.Day-b.Day) < minDays {
+					if c.FirstType == c.SecondType {
+						return fmt.Sprintf("two %s sessions are less than %d days apart", c.FirstType, minDays)
+					}
+					return fmt.Sprintf("a %s and a %s are less than %d days apart", c.FirstType, c.SecondType, minDays)
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+func (o *SessionOrdering) consecutiveGap() int {
+	return o.config.MinBreakBetweenSessions + ConsecutiveSlack
+}
+
+// CheckSessionConstraints reports every session constraint the scheduled sessions break
+func CheckSessionConstraints(input *Input, config *Config, sessions []*models.ScheduledSession) []ConstraintViolation {
+	if len(input.SessionConstraints) == 0 {
+		return nil
+	}
+
+	ordering := NewSessionOrdering(input.SessionConstraints, input.CourseSessions, config)
+
+	types := make(map[uuid.UUID]string, len(input.CourseSessions))
+	for _, session := range input.CourseSessions {
+		if session != nil {
+			types[session.ID] = session.Type
+		}
+	}
+
+	var courses []uuid.UUID
+	placed := make(map[uuid.UUID][]PlacedSession)
+	for _, s := range sessions {
+		if s.CourseSessionID == nil {
+			continue
+		}
+		sessionType, ok := types[*s.CourseSessionID]
+		if !ok {
+			continue
+		}
+
+		if _, seen := placed[s.CourseID]; !seen {
+			courses = append(courses, s.CourseID)
+		}
+		placed[s.CourseID] = append(placed[s.CourseID], PlacedSession{Type: sessionType, Day: s.Day, StartTime: s.StartTime, EndTime: s.EndTime})
+	}
+
+	// Courses with constraints but nothing placed can't break any
+	var violations []ConstraintViolation
+	for _, courseID := range courses {
+		violations = append(violations, ordering.Violations(courseID, placed[courseID], true)...)
+	}
+
+	return violations
+}
+
+// earliest returns the placed session of the given type that ends first in the week
+func earliest(placed []PlacedSession, sessionType string) (PlacedSession, bool) {
+	var first PlacedSession
+	found := false
+
+	for _, p := range placed {
+		if p.Type == sessionType && (!found || weekMinute(p.Day, p.EndTime) < weekMinute(first.Day, first.EndTime)) {
+			first, found = p, true
+		}
+	}
+
+	return first, found
+}
+
+func weekMinute(day, minute int) int {
+	return day*24*60 + minute
+}
+
+// intersect returns the time ranges that lie in both a and b
+func intersect(a, b []TimeRange) []TimeRange {
+	var result []TimeRange
+
+	for _, ra := range a {
+		for _, rb := range b {
+			if start, end := max(ra.Start, rb.Start), min(ra.End, rb.End); start < end {
+				result = append(result, TimeRange{Start: start, End: end})
+			}
+		}
+	}
+
+	slices.SortFunc(result, func(x, y TimeRange) int {
+		return x.Start - y.Start
+	})
+
+	return result
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,254 @@
+package csp
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+const (
+	// DefaultTimeLimit is used when Config.SearchTimeLimit is unset
+	DefaultTimeLimit = 5 * time.Second
+
+	// DefaultNodeLimit is used when Config.SearchNodeLimit is unset
+	DefaultNodeLimit = 200_000
+
+	// defaultSlotStep is the gap between candidate start times (in minutes) when PreferredSlotDuration is unset
+	defaultSlotStep = 15
+)
+
+var _ scheduler.Scheduler = (*CSPScheduler)(nil)
+
+// CSPScheduler places sessions by backtracking search with forward checking.
+// Unlike the greedy scheduler it can undo an early choice that blocks a later session.
+// If the search budget runs out before a full solution is found, it returns the best
+// partial assignment, completed first-fit where possible; if that happens because ctx's
+// deadline or Config.MaxDuration passed, the output is flagged incomplete.
+type CSPScheduler struct{}
+
+func NewCSPScheduler() scheduler.Scheduler {
+	return &CSPScheduler{}
+}
+
+func (c *CSPScheduler) Generate(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+	// Use default config if not provided
+	config := input.Config
+	if config == nil {
+		config = scheduler.DefaultConfig()
+	}
+
+	ctx, cancel := scheduler.WithMaxDuration(ctx, config)
+	defer cancel()
+
+	pinned, err := scheduler.ResolvePins(input, config)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newSearch(ctx, input, config, pinned)
+
+	solved := s.solve()
+	stopped, err := scheduler.Interrupted(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !solved && s.best != nil {
+		// Budget ran out or no full solution exists: fall back to the deepest assignment seen
+		copy(s.assigned, s.best)
+		s.recountLoad()
+	}
+	s.complete()
+	s.dropPartialGroups()
+
+	output := s.output(input.Rooms)
+	output.Incomplete = stopped && !solved
+	output.Violations = scheduler.CheckSessionConstraints(input, config, output.ScheduledSessions)
+
+	placed := 0
+	for _, ss := range output.ScheduledSessions {
+		if scheduler.Lead(ss) {
+			placed++
+		}
+	}
+	input.ReportProgress(scheduler.Progress{
+		SessionsPlaced: placed,
+		SessionsFailed: s.groups - placed,
+		SessionsTotal:  s.groups,
+	})
+
+	return output, nil
+}
+
+// solve runs the backtracking search, reporting whether every searchable variable was assigned
+func (s *search) solve() bool {
+	if s.exhausted() {
+		return false
+	}
+
+	i := s.selectVariable()
+	if i < 0 {
+		return true
+	}
+
+	for _, v := range s.orderValues(i) {
+		s.nodes++
+		if s.exhausted() {
+			return false
+		}
+		if s.overLimit(i, v) != "" || s.breaksOrdering(i, v) {
+			continue
+		}
+
+		s.assign(i, v)
+		pruned, ok := s.forwardCheck(i)
+		if ok && s.solve() {
+			return true
+		}
+
+		s.restore(pruned)
+		s.unassign(i)
+	}
+
+	return false
+}
+
+// complete tries to place any variables left unassigned after search, first fit against the current assignment
+func (s *search) complete() {
+	for i, v := range s.vars {
+		if s.assigned[i] != nil {
+			continue
+		}
+
+		for _, val := range v.initial {
+			if s.consistent(i, val) && s.overLimit(i, val) == "" && !s.breaksOrdering(i, val) {
+				s.assigned[i] = &val
+				s.addLoad(i, val)
+				break
+			}
+		}
+	}
+}
+
+// output converts the final assignment into scheduler output
+func (s *search) output(rooms []*models.Room) *scheduler.Output {
+	var scheduledSessions []*models.ScheduledSession
+	var failedSessions []*scheduler.FailedSession
+	var seatUsage scheduler.SeatUsage
+	cohortClashes := make(map[uuid.UUID]int)
+	failed := make(map[uuid.UUID]bool)
+
+	// Rooms of every section, by occurrence; dropPartialGroups leaves only whole occurrences assigned
+	sectionRooms := make(map[int][]uuid.UUID)
+	for i, v := range s.vars {
+		if val := s.assigned[i]; val != nil {
+			sectionRooms[v.group] = append(sectionRooms[v.group], val.room.ID)
+		}
+	}
+	roomsByID := make(map[uuid.UUID]*models.Room, len(rooms))
+	for _, room := range rooms {
+		if room != nil {
+			roomsByID[room.ID] = room
+		}
+	}
+
+	for i, v := range s.vars {
+		val := s.assigned[i]
+		if val != nil {
+			if v.section > 0 {
+				continue
+			}
+
+			parts := scheduler.Occurrence(v.session, sectionRooms[v.group], val.day, val.start)
+			scheduledSessions = append(scheduledSessions, parts...)
+
+			if v.enrollment > 0 {
+				for _, part := range parts {
+					seatUsage.OfferedSeats += int(roomsByID[part.RoomID].Capacity)
+					seatUsage.FilledSeats += v.enrollment
+				}
+			}
+			continue
+		}
+
+		// Report each course session once, like the greedy scheduler
+		if failed[v.session.ID] {
+			continue
+		}
+		failed[v.session.ID] = true
+
+		reason, cohortID := s.failureReason(i, rooms)
+		if cohortID != nil {
+			cohortClashes[*cohortID]++
+		}
+
+		failedSessions = append(failedSessions, &scheduler.FailedSession{
+			CourseSession: v.session,
+			Reason:        reason,
+		})
+	}
+
+	seatUsage.WastedSeats = seatUsage.OfferedSeats - seatUsage.FilledSeats
+
+	return &scheduler.Output{
+		ScheduledSessions: scheduledSessions,
+		Failures:          failedSessions,
+		CohortClashes:     cohortClashes,
+		SeatUsage:         seatUsage,
+	}
+}
+
+// failureReason explains why variable i could not be placed given the final assignment.
+// If a cohort is to blame, its ID is returned so the clash can be counted.
+func (s *search) failureReason(i int, rooms []*models.Room) (string, *uuid.UUID) {
+	v := s.vars[i]
+
+	if len(v.initial) == 0 {
+		// Distinguish rooms of the right type that are all too small from no usable room at all
+		hasType, fits := false, false
+		for _, room := range rooms {
+			if room != nil && room.Type == v.session.RequiredRoom {
+				hasType = true
+				fits = fits || int(room.Capacity) >= v.enrollment
+			}
+		}
+		if hasType && !fits {
+			return scheduler.ReasonInsufficientCapacity, nil
+		}
+		return scheduler.ReasonNoAvailableSlot, nil
+	}
+
+	// Blame the first resource that rules out a value whose room is otherwise free
+	for _, val := range v.initial {
+		if blocker := s.blockingResource(i, val); blocker != nil {
+			return blocker.reason(), blocker.cohortID()
+		}
+	}
+
+	// Otherwise blame a daily limit that rules out an otherwise free value
+	for _, val := range v.initial {
+		if !s.consistent(i, val) {
+			continue
+		}
+		if reason := s.overLimit(i, val); reason != "" {
+			return reason, nil
+		}
+	}
+
+	// Or the course's session constraints
+	for _, val := range v.initial {
+		if s.consistent(i, val) && s.breaksOrdering(i, val) {
+			return scheduler.ReasonOrderingConstraint, nil
+		}
+	}
+
+	// Or, for parallel sections, too few rooms free at once
+	if scheduler.Sections(v.session) > 1 {
+		return scheduler.ReasonSectionRooms, nil
+	}
+
+	return scheduler.ReasonNoAvailableSlot, nil
+}
@@ -0,0 +1,82 @@
+package csp
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+type resourceKind int
+
+const (
+	instructorResource resourceKind = iota
+	cohortResource
+	sessionResource // occurrences of the same course session are attended by the same students
+)
+
+// resource is a non-room participant of a session that cannot be double-booked
+type resource struct {
+	kind resourceKind
+	id   uuid.UUID
+}
+
+// reason is the failure reason reported when this resource blocks a session from being placed
+func (r resource) reason() string {
+	switch r.kind {
+	case cohortResource:
+		return scheduler.ReasonCohortClash
+	case instructorResource:
+		return scheduler.ReasonInstructorUnavailable
+	default:
+		return scheduler.ReasonNoAvailableSlot
+	}
+}
+
+// cohortID returns the cohort ID for cohort resources, nil otherwise
+func (r resource) cohortID() *uuid.UUID {
+	if r.kind != cohortResource {
+		return nil
+	}
+	return &r.id
+}
+
+// sessionResources lists the resources that attend every occurrence of a session
+func sessionResources(session *models.CourseSession, courseCohorts map[uuid.UUID][]uuid.UUID) []resource {
+	resources := []resource{{kind: sessionResource, id: session.ID}}
+
+	if session.InstructorID != nil {
+		resources = append(resources, resource{kind: instructorResource, id: *session.InstructorID})
+	}
+
+	for _, cohortID := range courseCohorts[session.CourseID] {
+		resources = append(resources, resource{kind: cohortResource, id: cohortID})
+	}
+
+	return resources
+}
+
+// sharesResource reports whether two variables have any resource in common
+func sharesResource(a, b *variable) bool {
+	return a.sharedResource(b) != nil
+}
+
+// sharedResource returns the first of v's resources that other also uses, preferring
+// instructors and cohorts over the session itself so failure reasons are specific
+func (v *variable) sharedResource(other *variable) *resource {
+	var fallback *resource
+
+	for i, res := range v.resources {
+		for _, otherRes := range other.resources {
+			if res != otherRes {
+				continue
+			}
+			if res.kind != sessionResource {
+				return &v.resources[i]
+			}
+			fallback = &v.resources[i]
+		}
+	}
+
+	return fallback
+}
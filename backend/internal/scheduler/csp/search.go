@@ -0,0 +1,505 @@
+package csp
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// variable is a single occurrence of a course session that needs a room, day and start time. A linked
+// occurrence with parallel sections has a variable per section, tied together by their group.
+type variable struct {
+	session    *models.CourseSession
+	duration   int
+	enrollment int
+	resources  []resource // instructor, cohorts and the session itself: none may overlap
+	initial    []value    // every placement allowed by room type, capacity and operating hours
+	domain     []value    // placements still consistent with the current assignment
+	pinned     bool       // placed by a pin before search; never reassigned
+	blocked    bool       // every placement clashes with a pin; skipped by search
+	cohortIDs  []uuid.UUID
+	group      int // index of the occurrence; sections of one occurrence share it
+	section    int
+}
+
+// value is a candidate placement for a variable
+type value struct {
+	room  *models.Room
+	day   int
+	start int
+}
+
+// search holds the state of one backtracking run
+type search struct {
+	ctx       context.Context
+	config    *scheduler.Config
+	input     *scheduler.Input
+	travel    scheduler.TravelTimes
+	load      *scheduler.DailyLoad // daily limits span many variables, so they're checked on assignment rather than pruned
+	ordering  *scheduler.SessionOrdering
+	vars      []*variable
+	neighbors [][]int  // variables that compete for a room or share a resource
+	shared    [][]bool // whether two variables share a resource
+	assigned  []*value
+	groups    int // number of occurrences
+
+	// best is the assignment with the most variables placed so far, used when search gives up
+	best      []*value
+	bestCount int
+	count     int
+
+	nodes     int
+	nodeLimit int
+	deadline  time.Time
+}
+
+func newSearch(ctx context.Context, input *scheduler.Input, config *scheduler.Config, pinned []*models.ScheduledSession) *search {
+	s := &search{
+		ctx:       ctx,
+		config:    config,
+		input:     input,
+		travel:    scheduler.NewTravelTimes(input.BuildingDistances),
+		load:      scheduler.NewDailyLoad(config),
+		ordering:  scheduler.NewSessionOrdering(input.SessionConstraints, input.CourseSessions, config),
+		nodeLimit: DefaultNodeLimit,
+		deadline:  time.Now().Add(DefaultTimeLimit),
+	}
+	if config.SearchNodeLimit > 0 {
+		s.nodeLimit = config.SearchNodeLimit
+	}
+	if config.SearchTimeLimit > 0 {
+		s.deadline = time.Now().Add(time.Duration(config.SearchTimeLimit) * time.Millisecond)
+	}
+
+	s.vars, s.groups = buildVariables(input, config)
+	fixPins(s.vars, pinned, input.Rooms)
+	s.assigned = make([]*value, len(s.vars))
+
+	s.shared = make([][]bool, len(s.vars))
+	s.neighbors = make([][]int, len(s.vars))
+	for i := range s.vars {
+		s.shared[i] = make([]bool, len(s.vars))
+	}
+	for i, a := range s.vars {
+		for j := i + 1; j < len(s.vars); j++ {
+			b := s.vars[j]
+			shared := sharesResource(a, b)
+			// Pinned rooms may not match the session's room type, so pins compete with everything
+			if shared || a.session.RequiredRoom == b.session.RequiredRoom || a.pinned || b.pinned {
+				s.shared[i][j], s.shared[j][i] = shared, shared
+				s.neighbors[i] = append(s.neighbors[i], j)
+				s.neighbors[j] = append(s.neighbors[j], i)
+			}
+		}
+	}
+
+	s.placePins()
+
+	return s
+}
+
+// fixPins narrows the first variables of each pinned session to their pinned placement. The sections of
+// a pinned linked occurrence are fixed in the same group as its first section.
+func fixPins(vars []*variable, pinned []*models.ScheduledSession, rooms []*models.Room) {
+	roomsByID := make(map[uuid.UUID]*models.Room, len(rooms))
+	for _, room := range rooms {
+		if room != nil {
+			roomsByID[room.ID] = room
+		}
+	}
+
+	group := -1
+	for _, ps := range pinned {
+		// Later blocks follow from the first one
+		if ps.Block > 0 {
+			continue
+		}
+
+		for _, v := range vars {
+			if v.pinned || v.session.ID != *ps.CourseSessionID || v.section != ps.Section || (ps.Section > 0 && v.group != group) {
+				continue
+			}
+
+			fixed := []value{{room: roomsByID[ps.RoomID], day: ps.Day, start: ps.StartTime}}
+			v.initial, v.domain, v.pinned = fixed, slices.Clone(fixed), true
+			group = v.group
+			break
+		}
+	}
+}
+
+// placePins assigns every pinned variable and prunes clashing values from the rest for good.
+// Variables left with nothing are marked blocked rather than failing the whole search.
+func (s *search) placePins() {
+	for i, v := range s.vars {
+		if !v.pinned {
+			continue
+		}
+
+		s.assign(i, v.domain[0])
+		for _, j := range s.neighbors[i] {
+			if s.assigned[j] != nil {
+				continue
+			}
+
+			var kept []value
+			for _, val := range s.vars[j].domain {
+				if !s.conflicts(i, v.domain[0], j, val) {
+					kept = append(kept, val)
+				}
+			}
+			s.vars[j].domain = kept
+		}
+	}
+
+	for _, v := range s.vars {
+		if !v.pinned && len(v.initial) > 0 && len(v.domain) == 0 {
+			v.blocked = true
+		}
+	}
+}
+
+// buildVariables expands each course session into one variable per weekly occurrence and parallel
+// section, returning them with the number of occurrences
+func buildVariables(input *scheduler.Input, config *scheduler.Config) ([]*variable, int) {
+	coursesByID := make(map[string]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID.String()] = course
+		}
+	}
+	courseCohorts := scheduler.CohortsByCourse(input.Cohorts)
+	roomAvailability := scheduler.RoomAvailability(input.Rooms, input.RoomBlackouts, config)
+
+	var vars []*variable
+	groups := 0
+	for _, session := range input.CourseSessions {
+		if session == nil || session.Duration == nil || session.NumberOfSessions == nil {
+			continue
+		}
+
+		duration := scheduler.OccurrenceLength(session)
+		enrollment := scheduler.ExpectedEnrollment(session, coursesByID[session.CourseID.String()])
+		resources := sessionResources(session, courseCohorts)
+		initial := buildDomain(input.Rooms, roomAvailability, session.RequiredRoom, enrollment, duration, config)
+
+		for range *session.NumberOfSessions {
+			for section := range scheduler.Sections(session) {
+				// Later sections meet with the first, which already holds the instructor and cohorts
+				sectionResources := resources
+				if section > 0 {
+					sectionResources = resources[:1]
+				}
+
+				vars = append(vars, &variable{
+					session:    session,
+					duration:   duration,
+					enrollment: enrollment,
+					resources:  sectionResources,
+					cohortIDs:  courseCohorts[session.CourseID],
+					initial:    initial,
+					domain:     slices.Clone(initial),
+					group:      groups,
+					section:    section,
+				})
+			}
+			groups++
+		}
+	}
+
+	return vars, groups
+}
+
+// buildDomain lists every placement in a room that fits the session and isn't blacked out, ordered by
+// day, start time and then room capacity so the tightest fitting room is tried first
+func buildDomain(rooms []*models.Room, roomAvailability scheduler.Availability, roomType string, enrollment, duration int, config *scheduler.Config) []value {
+	var fitting []*models.Room
+	for _, room := range rooms {
+		if room != nil && room.Type == roomType && int(room.Capacity) >= enrollment {
+			fitting = append(fitting, room)
+		}
+	}
+	slices.SortStableFunc(fitting, func(a, b *models.Room) int {
+		return int(a.Capacity) - int(b.Capacity)
+	})
+
+	step := config.PreferredSlotDuration
+	if step <= 0 {
+		step = defaultSlotStep
+	}
+
+	var domain []value
+	for _, day := range config.OperatingDays {
+		for start := config.OperatingHours.Start; start+duration <= config.OperatingHours.End; start += step {
+			for _, room := range fitting {
+				if roomAvailability.Free(room.ID.String(), int(day), start, start+duration) {
+					domain = append(domain, value{room: room, day: int(day), start: start})
+				}
+			}
+		}
+	}
+
+	return domain
+}
+
+// exhausted reports whether the node or time budget has run out, or the search's context has ended
+func (s *search) exhausted() bool {
+	return s.nodes >= s.nodeLimit || time.Now().After(s.deadline) || s.ctx.Err() != nil
+}
+
+// selectVariable picks the unassigned variable with the fewest remaining values (MRV),
+// breaking ties by the most unassigned neighbours (degree). Returns -1 when none are left.
+// Variables that never had a value are skipped; they are reported as failures.
+func (s *search) selectVariable() int {
+	selected, selectedDegree := -1, 0
+
+	for i, v := range s.vars {
+		if s.assigned[i] != nil || len(v.initial) == 0 || v.blocked {
+			continue
+		}
+
+		degree := 0
+		for _, j := range s.neighbors[i] {
+			if s.assigned[j] == nil {
+				degree++
+			}
+		}
+
+		if selected < 0 ||
+			len(v.domain) < len(s.vars[selected].domain) ||
+			(len(v.domain) == len(s.vars[selected].domain) && degree > selectedDegree) {
+			selected, selectedDegree = i, degree
+		}
+	}
+
+	return selected
+}
+
+// orderValues returns the remaining values of variable i, preferring days on which
+// the course has fewer sessions so a course's sessions are spread across the week
+func (s *search) orderValues(i int) []value {
+	courseID := s.vars[i].session.CourseID
+	perDay := make(map[int]int)
+	for j, val := range s.assigned {
+		if val != nil && s.vars[j].session.CourseID == courseID {
+			perDay[val.day]++
+		}
+	}
+
+	ordered := slices.Clone(s.vars[i].domain)
+	slices.SortStableFunc(ordered, func(a, b value) int {
+		return perDay[a.day] - perDay[b.day]
+	})
+
+	return ordered
+}
+
+func (s *search) assign(i int, val value) {
+	s.assigned[i] = &val
+	s.count++
+	s.addLoad(i, val)
+
+	if s.count > s.bestCount {
+		s.bestCount = s.count
+		s.best = slices.Clone(s.assigned)
+		s.input.ReportProgress(scheduler.Progress{SessionsPlaced: s.placedGroups(), SessionsTotal: s.groups})
+	}
+}
+
+func (s *search) unassign(i int) {
+	v := s.vars[i]
+	if v.section == 0 {
+		s.load.Remove(v.session.CourseID, v.session.InstructorID, v.cohortIDs, s.assigned[i].day, v.duration)
+	}
+	s.assigned[i] = nil
+	s.count--
+}
+
+// addLoad counts variable i towards the daily limits; an occurrence counts once, through its first section
+func (s *search) addLoad(i int, val value) {
+	v := s.vars[i]
+	if v.section == 0 {
+		s.load.Add(v.session.CourseID, v.session.InstructorID, v.cohortIDs, val.day, v.duration)
+	}
+}
+
+// dropPartialGroups unassigns the sections of every occurrence that isn't fully placed, since a linked
+// occurrence is placed whole or not at all
+func (s *search) dropPartialGroups() {
+	placed, size := s.groupCounts()
+	for i, v := range s.vars {
+		if s.assigned[i] != nil && placed[v.group] < size[v.group] {
+			s.unassign(i)
+		}
+	}
+}
+
+// placedGroups counts the occurrences whose sections are all placed
+func (s *search) placedGroups() int {
+	placed, size := s.groupCounts()
+	count := 0
+	for g := range placed {
+		if placed[g] == size[g] {
+			count++
+		}
+	}
+	return count
+}
+
+// groupCounts counts the placed sections and all the sections of each occurrence
+func (s *search) groupCounts() (placed, size []int) {
+	placed = make([]int, s.groups)
+	size = make([]int, s.groups)
+	for i, v := range s.vars {
+		size[v.group]++
+		if s.assigned[i] != nil {
+			placed[v.group]++
+		}
+	}
+	return placed, size
+}
+
+// recountLoad rebuilds the daily load from the current assignment
+func (s *search) recountLoad() {
+	s.load = scheduler.NewDailyLoad(s.config)
+	for i, val := range s.assigned {
+		if val != nil {
+			s.addLoad(i, *val)
+		}
+	}
+}
+
+// breaksOrdering reports whether variable i taking val breaks one of its course's session constraints
+// among the variables assigned so far
+func (s *search) breaksOrdering(i int, val value) bool {
+	courseID := s.vars[i].session.CourseID
+	if !s.ordering.Constrained(courseID) {
+		return false
+	}
+
+	placed := []scheduler.PlacedSession{s.vars[i].placedAt(val)}
+	for j, other := range s.assigned {
+		if j != i && other != nil && s.vars[j].session.CourseID == courseID {
+			placed = append(placed, s.vars[j].placedAt(*other))
+		}
+	}
+
+	return len(s.ordering.Violations(courseID, placed, false)) > 0
+}
+
+func (v *variable) placedAt(val value) scheduler.PlacedSession {
+	return scheduler.PlacedSession{Type: v.session.Type, Day: val.day, StartTime: val.start, EndTime: val.start + v.duration}
+}
+
+// overLimit returns the failure reason for the daily limit variable i would break by taking val, or ""
+func (s *search) overLimit(i int, val value) string {
+	v := s.vars[i]
+	if v.section > 0 {
+		return ""
+	}
+	return s.load.Exceeded(v.session.CourseID, v.session.InstructorID, v.cohortIDs, val.day, v.duration)
+}
+
+// forwardCheck removes values that conflict with variable i's assignment from its unassigned
+// neighbours. It returns the domains before pruning, and false if any domain was wiped out.
+func (s *search) forwardCheck(i int) (map[int][]value, bool) {
+	pruned := make(map[int][]value)
+	assignedVal := *s.assigned[i]
+
+	for _, j := range s.neighbors[i] {
+		if s.assigned[j] != nil || len(s.vars[j].initial) == 0 {
+			continue
+		}
+
+		domain := s.vars[j].domain
+		var kept []value
+		for _, val := range domain {
+			if !s.conflicts(i, assignedVal, j, val) {
+				kept = append(kept, val)
+			}
+		}
+
+		if len(kept) == len(domain) {
+			continue
+		}
+
+		pruned[j] = domain
+		s.vars[j].domain = kept
+		if len(kept) == 0 {
+			return pruned, false
+		}
+	}
+
+	return pruned, true
+}
+
+// restore undoes a forward check
+func (s *search) restore(pruned map[int][]value) {
+	for j, domain := range pruned {
+		s.vars[j].domain = domain
+	}
+}
+
+// conflicts reports whether two placements clash: they overlap in time (including the
+// minimum break) and either use the same room or share an instructor, cohort or session.
+// Placements sharing a resource in different buildings also need the walk between them.
+// Sections of one occurrence clash unless they start together in different rooms.
+func (s *search) conflicts(i int, a value, j int, b value) bool {
+	if s.vars[i].group == s.vars[j].group {
+		return a.day != b.day || a.start != b.start || a.room.ID == b.room.ID
+	}
+
+	if a.day != b.day {
+		return false
+	}
+
+	gap := s.config.MinBreakBetweenSessions
+	if s.shared[i][j] {
+		gap = s.travel.Gap(s.config, a.room.Building, b.room.Building)
+	}
+	aEnd := a.start + s.vars[i].duration
+	bEnd := b.start + s.vars[j].duration
+	if a.start >= bEnd+gap || b.start >= aEnd+gap {
+		return false
+	}
+
+	return a.room.ID == b.room.ID || s.shared[i][j]
+}
+
+// consistent reports whether variable i can take val without clashing with any assigned variable
+func (s *search) consistent(i int, val value) bool {
+	for _, j := range s.neighbors[i] {
+		if s.assigned[j] != nil && s.conflicts(i, val, j, *s.assigned[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+// blockingResource returns the resource of variable i that rules out val, or nil if val
+// is ruled out by its room (or not at all)
+func (s *search) blockingResource(i int, val value) *resource {
+	var blocker *resource
+
+	for _, j := range s.neighbors[i] {
+		other := s.assigned[j]
+		if other == nil || !s.conflicts(i, val, j, *other) {
+			continue
+		}
+
+		if other.room.ID == val.room.ID {
+			return nil
+		}
+
+		if blocker == nil {
+			blocker = s.vars[i].sharedResource(s.vars[j])
+		}
+	}
+
+	return blocker
+}
@@ -1,8 +1,11 @@
 package greedy
 
 import (
+	"context"
 	"slices"
 
+	"github.com/google/uuid"
+
 	"github.com/TerrenceMurray/course-scheduler/internal/models"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
@@ -20,33 +23,142 @@ func NewGreedyScheduler(weightStrategy weight.WeightStrategyInterface) scheduler
 	}
 }
 
-func (g *GreedyScheduler) Generate(input *scheduler.Input) (*scheduler.Output, error) {
+func (g *GreedyScheduler) Generate(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 	// Use default config if not provided
 	config := input.Config
 	if config == nil {
 		config = scheduler.DefaultConfig()
 	}
 
-	// Initialize availability for all rooms based on config
-	availability := g.initAvailability(input.Rooms, config)
+	ctx, cancel := scheduler.WithMaxDuration(ctx, config)
+	defer cancel()
+
+	// Initialize availability for all rooms based on config, leaving out blacked-out time
+	availability := scheduler.RoomAvailability(input.Rooms, input.RoomBlackouts, config)
+
+	// Instructors and cohorts are shared resources too: track their free time so they're never double-booked
+	courseCohorts := scheduler.CohortsByCourse(input.Cohorts)
+	resourceAvailability := g.initResourceAvailability(input.CourseSessions, courseCohorts, config)
+
+	// Sessions each resource attends, so it gets time to walk between buildings
+	travel := scheduler.NewTravelTimes(input.BuildingDistances)
+	bookings := make(map[string][]booking)
+
+	// Sessions and minutes per course, instructor and cohort on each day, checked against the config's daily limits
+	load := scheduler.NewDailyLoad(config)
 
 	// Calculate and sort course weights (descending)
 	courseWeights := g.calculateWeights(input.Courses, input.CourseSessions)
 	g.sortWeightsByDescending(courseWeights)
 
-	// Get sessions ordered by course weight
-	orderedSessions := g.getSessionsByWeightedCourses(courseWeights, input.CourseSessions)
+	// Get sessions ordered by course weight, and within a course so the sessions others must follow come first
+	ordering := scheduler.NewSessionOrdering(input.SessionConstraints, input.CourseSessions, config)
+	orderedSessions := g.getSessionsByWeightedCourses(courseWeights, input.CourseSessions, ordering)
+
+	// Where each course's sessions have been placed, for its session constraints
+	coursePlaced := make(map[uuid.UUID][]scheduler.PlacedSession)
+
+	coursesByID := make(map[uuid.UUID]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID] = course
+		}
+	}
 
 	// Track days used per course (to spread sessions across days)
 	courseDaysUsed := make(map[string][]int)
 
 	var scheduledSessions []*models.ScheduledSession
 	var failedSessions []*scheduler.FailedSession
+	cohortClashes := make(map[uuid.UUID]int)
+	var seatUsage scheduler.SeatUsage
 
-	// Schedule each session
+	// Pinned sessions take their slots before anything else is placed
+	pinned, err := scheduler.ResolvePins(input, config)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsByID := make(map[uuid.UUID]*models.CourseSession, len(input.CourseSessions))
+	for _, session := range input.CourseSessions {
+		if session != nil {
+			sessionsByID[session.ID] = session
+		}
+	}
+	roomsByID := make(map[uuid.UUID]*models.Room, len(input.Rooms))
+	for _, room := range input.Rooms {
+		if room != nil {
+			roomsByID[room.ID] = room
+		}
+	}
+
+	pinnedCount := make(map[uuid.UUID]int)
+	for _, ps := range pinned {
+		session := sessionsByID[*ps.CourseSessionID]
+		consumeEnd := ps.EndTime + config.MinBreakBetweenSessions
+
+		availability[ps.RoomID.String()][ps.Day] = g.consumeSlot(availability[ps.RoomID.String()][ps.Day], ps.StartTime, consumeEnd)
+		if enrollment := scheduler.ExpectedEnrollment(session, coursesByID[session.CourseID]); enrollment > 0 {
+			seatUsage.OfferedSeats += int(roomsByID[ps.RoomID].Capacity)
+			seatUsage.FilledSeats += enrollment
+		}
+		scheduledSessions = append(scheduledSessions, ps)
+
+		// Parallel sections share the first section's instructor and cohorts, so they're booked once
+		if ps.Section == 0 {
+			for _, res := range g.sessionResources(session, courseCohorts) {
+				resourceAvailability[res.key()][ps.Day] = g.consumeSlot(resourceAvailability[res.key()][ps.Day], ps.StartTime, consumeEnd)
+				bookings[res.key()] = append(bookings[res.key()], booking{day: ps.Day, start: ps.StartTime, end: ps.EndTime, building: roomsByID[ps.RoomID].Building})
+			}
+		}
+
+		// The rest is counted once per occurrence
+		if !scheduler.Lead(ps) {
+			continue
+		}
+		end := ps.StartTime + scheduler.OccurrenceLength(session)
+		load.Add(ps.CourseID, session.InstructorID, courseCohorts[session.CourseID], ps.Day, end-ps.StartTime)
+		courseDaysUsed[ps.CourseID.String()] = append(courseDaysUsed[ps.CourseID.String()], ps.Day)
+		coursePlaced[ps.CourseID] = append(coursePlaced[ps.CourseID], scheduler.PlacedSession{Type: session.Type, Day: ps.Day, StartTime: ps.StartTime, EndTime: end})
+		pinnedCount[session.ID]++
+	}
+
+	var progress scheduler.Progress
 	for _, session := range orderedSessions {
-		sessionsToPlace := int(*session.NumberOfSessions)
+		progress.SessionsPlaced += pinnedCount[session.ID]
+		progress.SessionsTotal += int(*session.NumberOfSessions)
+	}
+
+	// Schedule each session
+	incomplete := false
+	for i, session := range orderedSessions {
+		// Out of time: report what's left as not attempted and keep what has been placed
+		stopped, err := scheduler.Interrupted(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if stopped {
+			incomplete = true
+			for _, rest := range orderedSessions[i:] {
+				if int(*rest.NumberOfSessions) > pinnedCount[rest.ID] {
+					failedSessions = append(failedSessions, &scheduler.FailedSession{
+						CourseSession: rest,
+						Reason:        scheduler.ReasonTimeLimit,
+					})
+				}
+			}
+			break
+		}
+
+		sessionsToPlace := int(*session.NumberOfSessions) - pinnedCount[session.ID]
 		courseKey := session.CourseID.String()
+		resources := g.sessionResources(session, courseCohorts)
+		enrollment := scheduler.ExpectedEnrollment(session, coursesByID[session.CourseID])
+		rooms := g.roomsByFit(input.Rooms, session.RequiredRoom, enrollment)
+
+		// A linked occurrence takes all of its blocks and sections at once
+		duration := scheduler.OccurrenceLength(session)
+		sections := scheduler.Sections(session)
 
 		// Initialize days used for this course if not exists
 		if _, exists := courseDaysUsed[courseKey]; !exists {
@@ -54,41 +166,88 @@ func (g *GreedyScheduler) Generate(input *scheduler.Input) (*scheduler.Output, e
 		}
 
 		for sessionsToPlace > 0 {
-			// Sort days by availability for the required room type
-			candidateDays := g.sortDaysByAvailability(availability, input.Rooms, session.RequiredRoom, config)
+			// Sort days by availability for the required room type, then move days the course
+			// already uses to the back so its sessions are spread across the week
+			candidateDays := g.sortDaysByAvailability(availability, rooms, config)
+			g.preferUnusedDays(candidateDays, courseDaysUsed[courseKey])
 			sessionPlaced := false
+			var blocker *resource
+			limitReason := ""
+			orderingReason := ""
+			sectionsShort := false
 
 			for _, day := range candidateDays {
 				if sessionPlaced {
 					break
 				}
 
-				// Try to spread sessions across different days for the same course
-				// Skip this day if already used and there are enough remaining days
-				if slices.Contains(courseDaysUsed[courseKey], day) && sessionsToPlace <= (5-len(courseDaysUsed[courseKey])) {
+				// Skip days on which the course, instructor or a cohort has reached its daily limit
+				if reason := load.Exceeded(session.CourseID, session.InstructorID, courseCohorts[session.CourseID], day, duration); reason != "" {
+					if limitReason == "" {
+						limitReason = reason
+					}
 					continue
 				}
 
-				// Try each room of the required type
-				for _, room := range g.roomsByType(input.Rooms, session.RequiredRoom) {
-					start, found := g.findFirstAvailableSlot(availability[room.ID.String()][day], int(*session.Duration), config)
+				// Keep to the times the course's session constraints leave on this day
+				allowed := ordering.Allowed(session.CourseID, session.Type, day, duration, coursePlaced[session.CourseID])
+				if len(allowed) == 0 {
+					orderingReason = scheduler.ReasonOrderingConstraint
+					continue
+				}
+
+				// Try each room that fits, smallest first, as the first section's room
+				for _, room := range rooms {
+					roomRanges := g.intersectRanges(availability[room.ID.String()][day], allowed)
+
+					var sectionRooms []uuid.UUID
+					start, found := 0, false
+					for {
+						var blockedBy *resource
+						start, found, blockedBy = g.findSlotWithResources(roomRanges, resources, resourceAvailability, bookings, travel, room.Building, day, duration, config)
+
+						if blockedBy != nil && blocker == nil {
+							blocker = blockedBy
+						}
+						if !found {
+							break
+						}
+
+						// The other sections need rooms free at the same time; if they aren't, try a later start
+						if sectionRooms = g.sectionRooms(rooms, room, availability, day, start, start+duration, sections); sectionRooms != nil {
+							break
+						}
+						sectionsShort = true
+						found = false
+						roomRanges = g.consumeSlot(roomRanges, start, start+g.slotStep(config))
+					}
 
 					if found {
-						end := start + int(*session.Duration)
+						end := start + duration
+
+						// Consume the slot (including break time after) in every section's room
+						parts := scheduler.Occurrence(session, sectionRooms, day, start)
+						for _, part := range parts {
+							consumeEnd := part.EndTime + config.MinBreakBetweenSessions
+							availability[part.RoomID.String()][day] = g.consumeSlot(availability[part.RoomID.String()][day], part.StartTime, consumeEnd)
+
+							if enrollment > 0 {
+								seatUsage.OfferedSeats += int(roomsByID[part.RoomID].Capacity)
+								seatUsage.FilledSeats += enrollment
+							}
+						}
 
-						// Consume the slot (including break time after)
 						consumeEnd := end + config.MinBreakBetweenSessions
-						availability[room.ID.String()][day] = g.consumeSlot(availability[room.ID.String()][day], start, consumeEnd)
+						for _, res := range resources {
+							resourceAvailability[res.key()][day] = g.consumeSlot(resourceAvailability[res.key()][day], start, consumeEnd)
+							bookings[res.key()] = append(bookings[res.key()], booking{day: day, start: start, end: end, building: room.Building})
+						}
+						load.Add(session.CourseID, session.InstructorID, courseCohorts[session.CourseID], day, duration)
 						courseDaysUsed[courseKey] = append(courseDaysUsed[courseKey], day)
+						coursePlaced[session.CourseID] = append(coursePlaced[session.CourseID], scheduler.PlacedSession{Type: session.Type, Day: day, StartTime: start, EndTime: end})
 
 						// Add to scheduled sessions
-						scheduledSessions = append(scheduledSessions, &models.ScheduledSession{
-							CourseID:  session.CourseID,
-							RoomID:    room.ID,
-							Day:       day,
-							StartTime: start,
-							EndTime:   end,
-						})
+						scheduledSessions = append(scheduledSessions, parts...)
 
 						sessionsToPlace--
 						sessionPlaced = true
@@ -99,34 +258,130 @@ func (g *GreedyScheduler) Generate(input *scheduler.Input) (*scheduler.Output, e
 
 			// If we tried all days and couldn't place the session, mark as failed
 			if !sessionPlaced {
+				reason := scheduler.ReasonNoAvailableSlot
+				if len(rooms) == 0 && len(g.roomsByType(input.Rooms, session.RequiredRoom)) > 0 {
+					reason = scheduler.ReasonInsufficientCapacity
+				} else if len(rooms) > 0 && len(rooms) < sections {
+					reason = scheduler.ReasonSectionRooms
+				} else if blocker != nil {
+					reason = blocker.reason()
+					if blocker.kind == cohortResource {
+						cohortClashes[blocker.id]++
+					}
+				} else if limitReason != "" {
+					reason = limitReason
+				} else if orderingReason != "" {
+					reason = orderingReason
+				} else if sectionsShort {
+					reason = scheduler.ReasonSectionRooms
+				}
+
 				failedSessions = append(failedSessions, &scheduler.FailedSession{
 					CourseSession: session,
-					Reason:        "no available time slot found",
+					Reason:        reason,
 				})
+				progress.SessionsFailed += sessionsToPlace
 				break
 			}
+
+			progress.SessionsPlaced++
 		}
+
+		input.ReportProgress(progress)
 	}
 
+	seatUsage.WastedSeats = seatUsage.OfferedSeats - seatUsage.FilledSeats
+
 	return &scheduler.Output{
 		ScheduledSessions: scheduledSessions,
 		Failures:          failedSessions,
+		CohortClashes:     cohortClashes,
+		SeatUsage:         seatUsage,
+		Incomplete:        incomplete,
+		Violations:        scheduler.CheckSessionConstraints(input, config, scheduledSessions),
 	}, nil
 }
 
-// initAvailability creates initial availability slots for all rooms based on config
-func (g *GreedyScheduler) initAvailability(rooms []*models.Room, config *scheduler.Config) scheduler.Availability {
-	availability := make(scheduler.Availability)
+// sessionResources lists the instructor and cohorts that attend a session
+func (g *GreedyScheduler) sessionResources(session *models.CourseSession, courseCohorts map[uuid.UUID][]uuid.UUID) []resource {
+	var resources []resource
+
+	if session.InstructorID != nil {
+		resources = append(resources, resource{kind: instructorResource, id: *session.InstructorID})
+	}
+
+	for _, cohortID := range courseCohorts[session.CourseID] {
+		resources = append(resources, resource{kind: cohortResource, id: cohortID})
+	}
+
+	return resources
+}
+
+// sectionRooms returns the rooms for every section of an occurrence from start to end, with lead as the
+// first section's room and the others taken from rooms in order. It returns nil if too few are free.
+func (g *GreedyScheduler) sectionRooms(rooms []*models.Room, lead *models.Room, availability scheduler.Availability, day, start, end, sections int) []uuid.UUID {
+	result := []uuid.UUID{lead.ID}
 
 	for _, room := range rooms {
-		if room == nil {
+		if len(result) == sections {
+			break
+		}
+		if room.ID != lead.ID && availability.Free(room.ID.String(), day, start, end) {
+			result = append(result, room.ID)
+		}
+	}
+
+	if len(result) < sections {
+		return nil
+	}
+	return result
+}
+
+// slotStep is how far to move a start time that didn't work out along to the next candidate
+func (g *GreedyScheduler) slotStep(config *scheduler.Config) int {
+	if config.PreferredSlotDuration > 0 {
+		return config.PreferredSlotDuration
+	}
+	return 15
+}
+
+// preferUnusedDays orders days by how many sessions the course already has on each, keeping the
+// existing order among days used equally often
+func (g *GreedyScheduler) preferUnusedDays(days []int, daysUsed []int) {
+	slices.SortStableFunc(days, func(a, b int) int {
+		return g.timesUsed(daysUsed, a) - g.timesUsed(daysUsed, b)
+	})
+}
+
+func (g *GreedyScheduler) timesUsed(daysUsed []int, day int) int {
+	n := 0
+	for _, d := range daysUsed {
+		if d == day {
+			n++
+		}
+	}
+	return n
+}
+
+// initResourceAvailability creates initial availability slots for every instructor and cohort attending a session
+func (g *GreedyScheduler) initResourceAvailability(sessions []*models.CourseSession, courseCohorts map[uuid.UUID][]uuid.UUID, config *scheduler.Config) scheduler.Availability {
+	availability := make(scheduler.Availability)
+
+	for _, session := range sessions {
+		if session == nil {
 			continue
 		}
 
-		availability[room.ID.String()] = make(map[int][]scheduler.TimeRange)
+		for _, res := range g.sessionResources(session, courseCohorts) {
+			if _, exists := availability[res.key()]; exists {
+				continue
+			}
+
+			availability[res.key()] = make(map[int][]scheduler.TimeRange)
 
-		for _, day := range config.OperatingDays {
-			availability[room.ID.String()][int(day)] = []scheduler.TimeRange{config.OperatingHours}
+			for _, day := range config.OperatingDays {
+				availability[res.key()][int(day)] = []scheduler.TimeRange{config.OperatingHours}
+			}
 		}
 	}
 
@@ -166,25 +421,31 @@ func (g *GreedyScheduler) sortWeightsByDescending(weights []*weight.CourseWeight
 	})
 }
 
-// getSessionsByWeightedCourses returns sessions ordered by their course's weight
-func (g *GreedyScheduler) getSessionsByWeightedCourses(weights []*weight.CourseWeight, sessions []*models.CourseSession) []*models.CourseSession {
+// getSessionsByWeightedCourses returns sessions ordered by their course's weight, and within a course
+// by the rank of their type in the course's session constraints
+func (g *GreedyScheduler) getSessionsByWeightedCourses(weights []*weight.CourseWeight, sessions []*models.CourseSession, ordering *scheduler.SessionOrdering) []*models.CourseSession {
 	ordered := make([]*models.CourseSession, 0, len(sessions))
 
 	for _, cw := range weights {
+		courseStart := len(ordered)
 		for _, session := range sessions {
 			if session != nil && session.CourseID == cw.Course.ID {
 				ordered = append(ordered, session)
 			}
 		}
+
+		if ordering.Constrained(cw.Course.ID) {
+			slices.SortStableFunc(ordered[courseStart:], func(a, b *models.CourseSession) int {
+				return ordering.Rank(a.CourseID, a.Type) - ordering.Rank(b.CourseID, b.Type)
+			})
+		}
 	}
 
 	return ordered
 }
 
-// sortDaysByAvailability returns days sorted by total availability for a room type (descending)
-func (g *GreedyScheduler) sortDaysByAvailability(availability scheduler.Availability, rooms []*models.Room, roomType string, config *scheduler.Config) []int {
-	roomsOfType := g.roomsByType(rooms, roomType)
-
+// sortDaysByAvailability returns days sorted by total availability across the given rooms (descending)
+func (g *GreedyScheduler) sortDaysByAvailability(availability scheduler.Availability, rooms []*models.Room, config *scheduler.Config) []int {
 	// Convert operating days to int slice
 	days := make([]int, len(config.OperatingDays))
 	for i, day := range config.OperatingDays {
@@ -192,8 +453,8 @@ func (g *GreedyScheduler) sortDaysByAvailability(availability scheduler.Availabi
 	}
 
 	slices.SortFunc(days, func(a, b int) int {
-		availA := g.getTotalAvailability(availability, roomsOfType, a)
-		availB := g.getTotalAvailability(availability, roomsOfType, b)
+		availA := g.getTotalAvailability(availability, rooms, a)
+		availB := g.getTotalAvailability(availability, rooms, b)
 		// Sort descending (most availability first)
 		return availB - availA
 	})
@@ -214,6 +475,24 @@ func (g *GreedyScheduler) roomsByType(rooms []*models.Room, roomType string) []*
 	return result
 }
 
+// roomsByFit returns rooms of the given type that can seat the expected enrollment,
+// ordered by capacity (ascending) so the tightest fit is tried first and big rooms stay free for big classes
+func (g *GreedyScheduler) roomsByFit(rooms []*models.Room, roomType string, enrollment int) []*models.Room {
+	result := make([]*models.Room, 0)
+
+	for _, room := range g.roomsByType(rooms, roomType) {
+		if int(room.Capacity) >= enrollment {
+			result = append(result, room)
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b *models.Room) int {
+		return int(a.Capacity) - int(b.Capacity)
+	})
+
+	return result
+}
+
 // getTotalAvailability calculates total available minutes for rooms on a given day
 func (g *GreedyScheduler) getTotalAvailability(availability scheduler.Availability, rooms []*models.Room, day int) int {
 	total := 0
@@ -271,22 +550,75 @@ func (g *GreedyScheduler) findFirstAvailableSlot(ranges []scheduler.TimeRange, d
 
 // consumeSlot removes a time slot from availability, splitting ranges as needed
 func (g *GreedyScheduler) consumeSlot(ranges []scheduler.TimeRange, start, end int) []scheduler.TimeRange {
+	return scheduler.SubtractRange(ranges, start, end)
+}
+
+// findSlotWithResources finds the first slot that is free in the room and for every resource, leaving
+// each resource time to walk from and to its sessions in other buildings.
+// If none exists, blockedBy is the first resource that ruled out an otherwise free room slot.
+func (g *GreedyScheduler) findSlotWithResources(
+	roomRanges []scheduler.TimeRange,
+	resources []resource,
+	resourceAvailability scheduler.Availability,
+	bookings map[string][]booking,
+	travel scheduler.TravelTimes,
+	building uuid.UUID,
+	day int,
+	duration int,
+	config *scheduler.Config,
+) (start int, found bool, blockedBy *resource) {
+	candidateRanges := roomRanges
+	if _, roomFree := g.findFirstAvailableSlot(candidateRanges, duration, config); !roomFree {
+		return 0, false, nil
+	}
+
+	for i, res := range resources {
+		narrowed := g.intersectRanges(candidateRanges, resourceAvailability[res.key()][day])
+		narrowed = g.leaveTravelTime(narrowed, bookings[res.key()], travel, building, day)
+		if _, free := g.findFirstAvailableSlot(narrowed, duration, config); !free {
+			return 0, false, &resources[i]
+		}
+		candidateRanges = narrowed
+	}
+
+	start, found = g.findFirstAvailableSlot(candidateRanges, duration, config)
+	return start, found, nil
+}
+
+// leaveTravelTime removes the time around a resource's sessions in other buildings that it needs
+// to walk to or from a session in building
+func (g *GreedyScheduler) leaveTravelTime(ranges []scheduler.TimeRange, bookings []booking, travel scheduler.TravelTimes, building uuid.UUID, day int) []scheduler.TimeRange {
+	for _, b := range bookings {
+		if b.day != day {
+			continue
+		}
+
+		if walk := travel.Between(b.building, building); walk > 0 {
+			ranges = scheduler.SubtractRange(ranges, b.start-walk, b.end+walk)
+		}
+	}
+
+	return ranges
+}
+
+// intersectRanges returns the time ranges that are free in both a and b
+func (g *GreedyScheduler) intersectRanges(a, b []scheduler.TimeRange) []scheduler.TimeRange {
 	result := make([]scheduler.TimeRange, 0)
 
-	for _, r := range ranges {
-		// Range is completely before or after the consumed slot
-		if r.End <= start || r.Start >= end {
-			result = append(result, r)
-		} else {
-			// Range overlaps with consumed slot - split it
-			if r.Start < start {
-				result = append(result, scheduler.TimeRange{Start: r.Start, End: start})
-			}
-			if r.End > end {
-				result = append(result, scheduler.TimeRange{Start: end, End: r.End})
+	for _, ra := range a {
+		for _, rb := range b {
+			start := max(ra.Start, rb.Start)
+			end := min(ra.End, rb.End)
+
+			if start < end {
+				result = append(result, scheduler.TimeRange{Start: start, End: end})
 			}
 		}
 	}
 
+	slices.SortFunc(result, func(x, y scheduler.TimeRange) int {
+		return x.Start - y.Start
+	})
+
 	return result
 }
@@ -0,0 +1,43 @@
+package greedy
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+type resourceKind int
+
+const (
+	instructorResource resourceKind = iota
+	cohortResource
+)
+
+// resource is a non-room participant of a session (an instructor or a cohort) that cannot be double-booked
+type resource struct {
+	kind resourceKind
+	id   uuid.UUID
+}
+
+// key identifies the resource in the resource availability map
+func (r resource) key() string {
+	return fmt.Sprintf("%d:%s", r.kind, r.id)
+}
+
+// reason is the failure reason reported when this resource blocks a session from being placed
+func (r resource) reason() string {
+	if r.kind == cohortResource {
+		return scheduler.ReasonCohortClash
+	}
+	return scheduler.ReasonInstructorUnavailable
+}
+
+// booking is a session a resource attends, kept so later sessions leave time to walk between buildings
+type booking struct {
+	day      int
+	start    int
+	end      int
+	building uuid.UUID
+}
@@ -0,0 +1,375 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+const (
+	// DefaultWorkers is the number of jobs run at once when none is configured
+	DefaultWorkers = 2
+
+	// DefaultQueueSize is how many jobs may wait for a worker before Submit is refused
+	DefaultQueueSize = 64
+
+	// DefaultRetention is how long finished jobs stay listed
+	DefaultRetention = 24 * time.Hour
+)
+
+var (
+	ErrNotFound  = errors.New("job not found")
+	ErrQueueFull = errors.New("job queue is full")
+	ErrFinished  = errors.New("job has already finished")
+	ErrClosed    = errors.New("job manager is closed")
+)
+
+// Status is where a job is in its lifecycle
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+// Finished reports whether a job in this status will never change again
+func (s Status) Finished() bool {
+	return s == StatusSucceeded || s == StatusFailed || s == StatusCancelled
+}
+
+// RunFunc does a job's work. It should stop early when ctx is cancelled, and may report progress.
+type RunFunc func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error)
+
+// Job is a snapshot of a job's state
+type Job struct {
+	ID         uuid.UUID           `json:"id"`
+	UserID     string              `json:"-"`
+	Status     Status              `json:"status"`
+	Progress   float64             `json:"progress"`        // 0 to 1
+	Stats      *scheduler.Progress `json:"stats,omitempty"` // latest progress report
+	Result     *scheduler.Output   `json:"result,omitempty"`
+	Error      string              `json:"error,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+	StartedAt  *time.Time          `json:"started_at,omitempty"`
+	FinishedAt *time.Time          `json:"finished_at,omitempty"`
+}
+
+type entry struct {
+	job      Job
+	run      RunFunc
+	ctx      context.Context
+	cancel   context.CancelFunc
+	watchers []chan Job
+}
+
+// Manager queues jobs and runs them on a fixed pool of workers. Jobs are kept in memory and are
+// only visible to the user who submitted them; finished jobs stay listed for the retention period.
+type Manager struct {
+	mu        sync.Mutex
+	jobs      map[uuid.UUID]*entry
+	queue     chan *entry
+	retention time.Duration
+	closed    bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewManager starts workers that run submitted jobs. Non-positive arguments fall back to the defaults.
+func NewManager(workers, queueSize int, retention time.Duration) *Manager {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		jobs:      make(map[uuid.UUID]*entry),
+		queue:     make(chan *entry, queueSize),
+		retention: retention,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	for range workers {
+		m.wg.Add(1)
+		go m.work()
+	}
+
+	return m
+}
+
+// Close cancels every unfinished job and waits for the workers to stop
+func (m *Manager) Close() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	m.mu.Unlock()
+
+	m.cancel()
+	m.wg.Wait()
+}
+
+// Submit queues run on behalf of userID
+func (m *Manager) Submit(userID string, run RunFunc) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, ErrClosed
+	}
+	m.prune()
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	e := &entry{
+		job: Job{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Status:    StatusQueued,
+			CreatedAt: time.Now(),
+		},
+		run:    run,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	select {
+	case m.queue <- e:
+	default:
+		cancel()
+		return nil, ErrQueueFull
+	}
+
+	m.jobs[e.job.ID] = e
+	job := e.job
+	return &job, nil
+}
+
+// Get returns the user's job with the given ID
+func (m *Manager) Get(userID string, id uuid.UUID) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, err := m.lookup(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	job := e.job
+	return &job, nil
+}
+
+// List returns the user's jobs, newest first
+func (m *Manager) List(userID string) []*Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune()
+
+	var jobs []*Job
+	for _, e := range m.jobs {
+		if e.job.UserID == userID {
+			job := e.job
+			jobs = append(jobs, &job)
+		}
+	}
+
+	slices.SortFunc(jobs, func(a, b *Job) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return jobs
+}
+
+// Cancel stops the user's job. A queued job never runs; a running job's context is cancelled
+// and whatever it returns is discarded.
+func (m *Manager) Cancel(userID string, id uuid.UUID) (*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, err := m.lookup(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.job.Status.Finished() {
+		return nil, ErrFinished
+	}
+
+	e.cancel()
+	m.finish(e, StatusCancelled, nil, "")
+
+	job := e.job
+	return &job, nil
+}
+
+// Watch returns a channel that receives a snapshot of the user's job every time it changes, starting
+// with its current state. Only the latest snapshot is kept for a slow reader. The channel is closed
+// after the job finishes; stop releases it early.
+func (m *Manager) Watch(userID string, id uuid.UUID) (<-chan Job, func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, err := m.lookup(userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ch := make(chan Job, 1)
+	ch <- e.job
+	if e.job.Status.Finished() {
+		close(ch)
+		return ch, func() {}, nil
+	}
+
+	e.watchers = append(e.watchers, ch)
+	stop := func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if i := slices.Index(e.watchers, ch); i >= 0 {
+			e.watchers = slices.Delete(e.watchers, i, i+1)
+			close(ch)
+		}
+	}
+
+	return ch, stop, nil
+}
+
+// notify sends the job's state to its watchers, replacing any snapshot they haven't read yet,
+// and closes them once the job has finished. Callers must hold mu.
+func (m *Manager) notify(e *entry) {
+	for _, ch := range e.watchers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- e.job
+
+		if e.job.Status.Finished() {
+			close(ch)
+		}
+	}
+
+	if e.job.Status.Finished() {
+		e.watchers = nil
+	}
+}
+
+// lookup finds a job, hiding other users' jobs as not found. Callers must hold mu.
+func (m *Manager) lookup(userID string, id uuid.UUID) (*entry, error) {
+	e, ok := m.jobs[id]
+	if !ok || e.job.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return e, nil
+}
+
+// prune forgets jobs that finished longer ago than the retention period. Callers must hold mu.
+func (m *Manager) prune() {
+	cutoff := time.Now().Add(-m.retention)
+	for id, e := range m.jobs {
+		if e.job.FinishedAt != nil && e.job.FinishedAt.Before(cutoff) {
+			delete(m.jobs, id)
+		}
+	}
+}
+
+// finish records a job's outcome. Callers must hold mu.
+func (m *Manager) finish(e *entry, status Status, result *scheduler.Output, errMsg string) {
+	now := time.Now()
+	e.job.Status = status
+	e.job.Result = result
+	e.job.Error = errMsg
+	e.job.FinishedAt = &now
+	if status == StatusSucceeded {
+		e.job.Progress = 1
+	}
+	m.notify(e)
+}
+
+func (m *Manager) work() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			m.cancelQueued()
+			return
+		case e := <-m.queue:
+			m.runJob(e)
+		}
+	}
+}
+
+func (m *Manager) runJob(e *entry) {
+	m.mu.Lock()
+	if e.job.Status != StatusQueued {
+		// Cancelled while waiting
+		m.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	e.job.Status = StatusRunning
+	e.job.StartedAt = &now
+	m.notify(e)
+	m.mu.Unlock()
+
+	progress := func(p scheduler.Progress) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if e.job.Status == StatusRunning {
+			e.job.Progress = p.Fraction()
+			e.job.Stats = &p
+			m.notify(e)
+		}
+	}
+
+	output, err := e.run(e.ctx, progress)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	defer e.cancel()
+
+	switch {
+	case e.job.Status != StatusRunning:
+		// Cancelled while running; the outcome is discarded
+	case e.ctx.Err() != nil:
+		m.finish(e, StatusCancelled, nil, "")
+	case err != nil:
+		m.finish(e, StatusFailed, nil, err.Error())
+	default:
+		m.finish(e, StatusSucceeded, output, "")
+	}
+}
+
+// cancelQueued marks jobs still waiting for a worker as cancelled when the manager shuts down
+func (m *Manager) cancelQueued() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, e := range m.jobs {
+		if e.job.Status == StatusQueued {
+			e.cancel()
+			m.finish(e, StatusCancelled, nil, "")
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"github.com/google/uuid"
+)
+
+// dayKey identifies a course, instructor or cohort on one day of the week
+type dayKey struct {
+	id  uuid.UUID
+	day int
+}
+
+// DailyLoad tracks how much each course, instructor and cohort has on every day, so the config's
+// daily limits can be checked before a session is placed
+type DailyLoad struct {
+	config            *Config
+	courseSessions    map[dayKey]int
+	instructorMinutes map[dayKey]int
+	cohortMinutes     map[dayKey]int
+}
+
+func NewDailyLoad(config *Config) *DailyLoad {
+	return &DailyLoad{
+		config:            config,
+		courseSessions:    make(map[dayKey]int),
+		instructorMinutes: make(map[dayKey]int),
+		cohortMinutes:     make(map[dayKey]int),
+	}
+}
+
+// HasDailyLimits reports whether any of the config's daily limits is set
+func (c *Config) HasDailyLimits() bool {
+	return c.MaxCourseSessionsPerDay > 0 || c.MaxInstructorMinutesPerDay > 0 || c.MaxCohortMinutesPerDay > 0
+}
+
+// Add records a session of the given length on day
+func (l *DailyLoad) Add(courseID uuid.UUID, instructorID *uuid.UUID, cohortIDs []uuid.UUID, day, minutes int) {
+	l.change(courseID, instructorID, cohortIDs, day, 1, minutes)
+}
+
+// Remove takes back a session recorded with Add
+func (l *DailyLoad) Remove(courseID uuid.UUID, instructorID *uuid.UUID, cohortIDs []uuid.UUID, day, minutes int) {
+	l.change(courseID, instructorID, cohortIDs, day, -1, -minutes)
+}
+
+func (l *DailyLoad) change(courseID uuid.UUID, instructorID *uuid.UUID, cohortIDs []uuid.UUID, day, sessions, minutes int) {
+	l.courseSessions[dayKey{courseID, day}] += sessions
+	if instructorID != nil {
+		l.instructorMinutes[dayKey{*instructorID, day}] += minutes
+	}
+	for _, cohortID := range cohortIDs {
+		l.cohortMinutes[dayKey{cohortID, day}] += minutes
+	}
+}
+
+// Exceeded returns the failure reason for the first daily limit that one more session of the given
+// length on day would break, or "" if it fits within all of them
+func (l *DailyLoad) Exceeded(courseID uuid.UUID, instructorID *uuid.UUID, cohortIDs []uuid.UUID, day, minutes int) string {
+	if limit := l.config.MaxCourseSessionsPerDay; limit > 0 && l.courseSessions[dayKey{courseID, day}]+1 > limit {
+		return ReasonCourseDailyLimit
+	}
+
+	if limit := l.config.MaxInstructorMinutesPerDay; limit > 0 && instructorID != nil &&
+		l.instructorMinutes[dayKey{*instructorID, day}]+minutes > limit {
+		return ReasonInstructorDailyLimit
+	}
+
+	if limit := l.config.MaxCohortMinutesPerDay; limit > 0 {
+		for _, cohortID := range cohortIDs {
+			if l.cohortMinutes[dayKey{cohortID, day}]+minutes > limit {
+				return ReasonCohortDailyLimit
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// Blocks returns how many back-to-back blocks each occurrence of the session runs as
+func Blocks(session *models.CourseSession) int {
+	if session == nil || session.Blocks == nil || *session.Blocks < 1 {
+		return 1
+	}
+	return int(*session.Blocks)
+}
+
+// Sections returns how many parallel sections each occurrence of the session meets in
+func Sections(session *models.CourseSession) int {
+	if session == nil || session.ParallelSections == nil || *session.ParallelSections < 1 {
+		return 1
+	}
+	return int(*session.ParallelSections)
+}
+
+// Linked reports whether an occurrence of the session has more than one part. Every part of a linked
+// occurrence is placed together or not at all.
+func Linked(session *models.CourseSession) bool {
+	return Blocks(session) > 1 || Sections(session) > 1
+}
+
+// OccurrenceLength returns the minutes from the start of an occurrence's first block to the end of its last
+func OccurrenceLength(session *models.CourseSession) int {
+	return Blocks(session) * int(*session.Duration)
+}
+
+// Occurrence expands one occurrence of a session starting at start on day into a scheduled session per
+// section and block. Section k is held in rooms[k]; its blocks follow each other in that room without a
+// break. Only the first section gets the session's instructor, so they are never booked twice at once.
+func Occurrence(session *models.CourseSession, rooms []uuid.UUID, day, start int) []*models.ScheduledSession {
+	duration := int(*session.Duration)
+
+	var parts []*models.ScheduledSession
+	for section, roomID := range rooms {
+		instructorID := session.InstructorID
+		if section > 0 {
+			instructorID = nil
+		}
+
+		for block := range Blocks(session) {
+			blockStart := start + block*duration
+			parts = append(parts, &models.ScheduledSession{
+				CourseID:        session.CourseID,
+				CourseSessionID: &session.ID,
+				RoomID:          roomID,
+				InstructorID:    instructorID,
+				Day:             day,
+				StartTime:       blockStart,
+				EndTime:         blockStart + duration,
+				Block:           block,
+				Section:         section,
+			})
+		}
+	}
+
+	return parts
+}
+
+// Lead reports whether s is the first block of the first section of its occurrence. The lead stands for
+// the whole occurrence when occurrences are counted.
+func Lead(s *models.ScheduledSession) bool {
+	return s.Block == 0 && s.Section == 0
+}
@@ -0,0 +1,179 @@
+package optimize
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+const (
+	DefaultIterations         = 20_000
+	DefaultInitialTemperature = 10.0
+	DefaultCoolingRate        = 0.9995
+)
+
+// Objective measures the soft-constraint cost of a set of placements; lower is better
+type Objective func(sessions []*models.ScheduledSession) float64
+
+// Result is the outcome of an optimization run
+type Result struct {
+	Sessions    []*models.ScheduledSession
+	InitialCost float64
+	FinalCost   float64
+	Moves       int // accepted moves, including ones later undone by a better state
+
+	// Incomplete is set when the run stopped before its last iteration because time ran out
+	Incomplete bool
+}
+
+// Annealer improves a schedule by simulated annealing: it repeatedly moves a session to another
+// room/day/time or swaps two sessions, always keeping hard constraints (room type and capacity,
+// operating hours, no double-booking of rooms, instructors or cohorts) satisfied. Moves that
+// lower the cost are always kept; moves that raise it are kept with a probability that shrinks
+// as the temperature cools, which lets the search climb out of local minima.
+type Annealer struct {
+	Objective          Objective
+	Iterations         int
+	InitialTemperature float64
+	CoolingRate        float64 // temperature multiplier applied after every iteration
+	Seed               uint64  // fixed seed keeps runs reproducible
+
+	// Progress, if set, is sent the best cost so far every progressInterval iterations
+	Progress scheduler.ProgressFunc
+}
+
+const progressInterval = 1000
+
+func NewAnnealer(objective Objective) *Annealer {
+	return &Annealer{
+		Objective:          objective,
+		Iterations:         DefaultIterations,
+		InitialTemperature: DefaultInitialTemperature,
+		CoolingRate:        DefaultCoolingRate,
+		Seed:               1,
+	}
+}
+
+// Optimize returns an improved copy of sessions. The input describes the rooms, course sessions
+// and cohorts the schedule was built from; sessions that can't be matched to a course session,
+// and sessions sitting where one of the config's pins puts them, stay where they are but still
+// block others. If ctx's deadline or the config's MaxDuration passes, the best state found so far
+// is returned flagged incomplete; if ctx is cancelled, its error is returned.
+func (a *Annealer) Optimize(ctx context.Context, input *scheduler.Input, sessions []*models.ScheduledSession) (*Result, error) {
+	if input == nil {
+		return nil, errors.New("input cannot be nil")
+	}
+
+	if a.Objective == nil {
+		return nil, errors.New("objective cannot be nil")
+	}
+
+	config := input.Config
+	if config == nil {
+		config = scheduler.DefaultConfig()
+	}
+
+	ctx, cancel := scheduler.WithMaxDuration(ctx, config)
+	defer cancel()
+
+	st := newState(input, config, sessions)
+	rng := rand.New(rand.NewPCG(a.Seed, a.Seed))
+
+	cost := a.Objective(st.sessions)
+	result := &Result{InitialCost: cost}
+	best, bestCost := cloneSessions(st.sessions), cost
+
+	if len(st.movable) > 0 {
+		temperature := a.InitialTemperature
+		for i := range a.Iterations {
+			if i%progressInterval == 0 {
+				a.reportProgress(len(st.sessions), bestCost)
+			}
+
+			stopped, err := scheduler.Interrupted(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if stopped {
+				result.Incomplete = true
+				break
+			}
+
+			undo, ok := st.randomMove(rng)
+			if ok {
+				newCost := a.Objective(st.sessions)
+				delta := newCost - cost
+
+				if delta <= 0 || rng.Float64() < math.Exp(-delta/temperature) {
+					cost = newCost
+					result.Moves++
+					if cost < bestCost {
+						best, bestCost = cloneSessions(st.sessions), cost
+					}
+				} else {
+					undo()
+				}
+			}
+
+			temperature *= a.CoolingRate
+		}
+	}
+
+	result.Sessions = best
+	result.FinalCost = bestCost
+	a.reportProgress(len(best), bestCost)
+
+	return result, nil
+}
+
+func (a *Annealer) reportProgress(sessions int, bestCost float64) {
+	if a.Progress != nil {
+		a.Progress(scheduler.Progress{SessionsPlaced: sessions, SessionsTotal: sessions, BestScore: &bestCost})
+	}
+}
+
+func cloneSessions(sessions []*models.ScheduledSession) []*models.ScheduledSession {
+	cloned := make([]*models.ScheduledSession, len(sessions))
+	for i, s := range sessions {
+		c := *s
+		cloned[i] = &c
+	}
+	return cloned
+}
+
+// SpreadObjective penalises the lumpiness first-fit scheduling produces: a course meeting more than
+// once on the same day, and sessions piling into the same day or the same hour of the week
+func SpreadObjective(sessions []*models.ScheduledSession) float64 {
+	type courseDay struct {
+		course string
+		day    int
+	}
+
+	perCourseDay := make(map[courseDay]int)
+	perDay := make(map[int]int)
+	perHour := make(map[int]int)
+
+	for _, s := range sessions {
+		perCourseDay[courseDay{s.CourseID.String(), s.Day}]++
+		perDay[s.Day]++
+		perHour[s.Day*24+s.StartTime/60]++
+	}
+
+	cost := 0.0
+	for _, n := range perCourseDay {
+		cost += 10 * float64(n-1)
+	}
+	// Squared loads are smallest when sessions are spread evenly
+	for _, n := range perDay {
+		cost += float64(n*n) / 10
+	}
+	for _, n := range perHour {
+		cost += float64(n * n)
+	}
+
+	return cost
+}
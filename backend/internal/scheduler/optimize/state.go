@@ -0,0 +1,321 @@
+package optimize
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// placement is a candidate room, day and start time for a session
+type placement struct {
+	room  uuid.UUID
+	day   int
+	start int
+}
+
+// state is the schedule being optimized along with what each session may be moved to
+type state struct {
+	config    *scheduler.Config
+	sessions  []*models.ScheduledSession
+	domains   [][]placement           // allowed placements per session; empty for sessions that stay put
+	resources [][]string              // instructor, cohort and course session keys per session
+	movable   []int                   // indices of sessions with a non-empty domain
+	buildings map[uuid.UUID]uuid.UUID // building of each room
+	travel    scheduler.TravelTimes
+	cohorts   map[uuid.UUID][]uuid.UUID // cohorts taking each course, for the daily limits
+	types     []string                  // session type per session; empty if unmatched
+	lengths   []int                     // minutes counted towards the daily limits; 0 for later parts of a linked occurrence
+	ordering  *scheduler.SessionOrdering
+}
+
+func newState(input *scheduler.Input, config *scheduler.Config, sessions []*models.ScheduledSession) *state {
+	st := &state{
+		config:    config,
+		sessions:  cloneSessions(sessions),
+		domains:   make([][]placement, len(sessions)),
+		resources: make([][]string, len(sessions)),
+		buildings: make(map[uuid.UUID]uuid.UUID, len(input.Rooms)),
+		travel:    scheduler.NewTravelTimes(input.BuildingDistances),
+		types:     make([]string, len(sessions)),
+		lengths:   make([]int, len(sessions)),
+		ordering:  scheduler.NewSessionOrdering(input.SessionConstraints, input.CourseSessions, config),
+	}
+
+	roomsByID := make(map[uuid.UUID]*models.Room, len(input.Rooms))
+	for _, room := range input.Rooms {
+		if room != nil {
+			roomsByID[room.ID] = room
+			st.buildings[room.ID] = room.Building
+		}
+	}
+	coursesByID := make(map[uuid.UUID]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID] = course
+		}
+	}
+	courseCohorts := scheduler.CohortsByCourse(input.Cohorts)
+	st.cohorts = courseCohorts
+	roomAvailability := scheduler.RoomAvailability(input.Rooms, input.RoomBlackouts, config)
+	pinUsed := make([]bool, len(config.Pins))
+
+	for i, s := range st.sessions {
+		session := matchCourseSession(s, input.CourseSessions, roomsByID)
+
+		st.resources[i] = sessionResources(s, session, courseCohorts)
+		if session != nil {
+			st.types[i] = session.Type
+		}
+
+		// A linked occurrence counts once, through its first part
+		st.lengths[i] = s.EndTime - s.StartTime
+		if session != nil && session.Duration != nil && scheduler.Linked(session) {
+			st.lengths[i] = 0
+			if scheduler.Lead(s) {
+				st.lengths[i] = scheduler.OccurrenceLength(session)
+			}
+		}
+
+		// Parts of a linked occurrence only move together, so they stay put
+		if session == nil || scheduler.Linked(session) || isPinned(s, config.Pins, pinUsed) || session.Duration == nil || int(*session.Duration) != s.EndTime-s.StartTime {
+			continue
+		}
+
+		enrollment := scheduler.ExpectedEnrollment(session, coursesByID[session.CourseID])
+		st.domains[i] = buildDomain(input.Rooms, roomAvailability, session.RequiredRoom, enrollment, s.EndTime-s.StartTime, config)
+		if len(st.domains[i]) > 0 {
+			st.movable = append(st.movable, i)
+		}
+	}
+
+	return st
+}
+
+// isPinned reports whether s sits where an unused pin puts it, marking that pin used so each pin holds one session
+func isPinned(s *models.ScheduledSession, pins []models.SessionPin, used []bool) bool {
+	if s.CourseSessionID == nil {
+		return false
+	}
+
+	for k, pin := range pins {
+		if used[k] || pin.CourseSessionID != *s.CourseSessionID || pin.Day != s.Day || pin.StartTime != s.StartTime {
+			continue
+		}
+		if pin.RoomID != nil && *pin.RoomID != s.RoomID {
+			continue
+		}
+
+		used[k] = true
+		return true
+	}
+
+	return false
+}
+
+// matchCourseSession finds the course session a scheduled session was placed for. Schedules saved
+// before sessions were tracked are matched on course, duration and the type of the room used.
+func matchCourseSession(s *models.ScheduledSession, sessions []*models.CourseSession, roomsByID map[uuid.UUID]*models.Room) *models.CourseSession {
+	for _, session := range sessions {
+		if session == nil {
+			continue
+		}
+
+		if s.CourseSessionID != nil {
+			if session.ID == *s.CourseSessionID {
+				return session
+			}
+			continue
+		}
+
+		room := roomsByID[s.RoomID]
+		if session.CourseID == s.CourseID && session.Duration != nil && int(*session.Duration) == s.EndTime-s.StartTime &&
+			room != nil && room.Type == session.RequiredRoom {
+			return session
+		}
+	}
+
+	return nil
+}
+
+// sessionResources returns keys for everything besides the room that must not be double-booked
+func sessionResources(s *models.ScheduledSession, session *models.CourseSession, courseCohorts map[uuid.UUID][]uuid.UUID) []string {
+	var resources []string
+
+	if s.InstructorID != nil {
+		resources = append(resources, "instructor:"+s.InstructorID.String())
+	}
+
+	for _, cohortID := range courseCohorts[s.CourseID] {
+		resources = append(resources, "cohort:"+cohortID.String())
+	}
+
+	// Occurrences of the same course session are attended by the same students
+	if session != nil {
+		resources = append(resources, "session:"+session.ID.String())
+	}
+
+	return resources
+}
+
+// buildDomain lists every placement in a room of the right type and size within the room's open hours
+func buildDomain(rooms []*models.Room, roomAvailability scheduler.Availability, roomType string, enrollment, duration int, config *scheduler.Config) []placement {
+	step := config.PreferredSlotDuration
+	if step <= 0 {
+		step = 15
+	}
+
+	var domain []placement
+	for _, room := range rooms {
+		if room == nil || room.Type != roomType || int(room.Capacity) < enrollment {
+			continue
+		}
+
+		for _, day := range config.OperatingDays {
+			for start := config.OperatingHours.Start; start+duration <= config.OperatingHours.End; start += step {
+				if roomAvailability.Free(room.ID.String(), int(day), start, start+duration) {
+					domain = append(domain, placement{room: room.ID, day: int(day), start: start})
+				}
+			}
+		}
+	}
+
+	return domain
+}
+
+// randomMove applies a random relocation or swap that keeps hard constraints satisfied.
+// It returns a function that reverts the move, and false if no valid move was found.
+func (st *state) randomMove(rng *rand.Rand) (func(), bool) {
+	i := st.movable[rng.IntN(len(st.movable))]
+
+	if len(st.movable) > 1 && rng.IntN(2) == 0 {
+		j := st.movable[rng.IntN(len(st.movable))]
+		return st.swap(i, j)
+	}
+
+	target := st.domains[i][rng.IntN(len(st.domains[i]))]
+	return st.relocate(i, target)
+}
+
+// relocate moves session i to target if it doesn't clash with any other session
+func (st *state) relocate(i int, target placement) (func(), bool) {
+	previous := st.placementOf(i)
+	if previous == target {
+		return nil, false
+	}
+
+	st.place(i, target)
+	if !st.feasible(i) {
+		st.place(i, previous)
+		return nil, false
+	}
+
+	return func() { st.place(i, previous) }, true
+}
+
+// swap exchanges the placements of sessions i and j if both are allowed in the other's slot
+func (st *state) swap(i, j int) (func(), bool) {
+	pi, pj := st.placementOf(i), st.placementOf(j)
+	if i == j || pi == pj || !slices.Contains(st.domains[i], pj) || !slices.Contains(st.domains[j], pi) {
+		return nil, false
+	}
+
+	st.place(i, pj)
+	st.place(j, pi)
+	if !st.feasible(i) || !st.feasible(j) {
+		st.place(i, pi)
+		st.place(j, pj)
+		return nil, false
+	}
+
+	return func() {
+		st.place(i, pi)
+		st.place(j, pj)
+	}, true
+}
+
+func (st *state) placementOf(i int) placement {
+	s := st.sessions[i]
+	return placement{room: s.RoomID, day: s.Day, start: s.StartTime}
+}
+
+func (st *state) place(i int, p placement) {
+	s := st.sessions[i]
+	duration := s.EndTime - s.StartTime
+	s.RoomID, s.Day, s.StartTime, s.EndTime = p.room, p.day, p.start, p.start+duration
+}
+
+// feasible reports whether session i is clear of every other session, leaving time to walk
+// between buildings for sessions that share a resource, keeps its day within the daily limits and
+// keeps its course's session constraints
+func (st *state) feasible(i int) bool {
+	a := st.sessions[i]
+	if st.config.HasDailyLimits() && !st.withinLimits(i) {
+		return false
+	}
+	if st.ordering.Constrained(a.CourseID) && !st.keepsOrdering(i) {
+		return false
+	}
+
+	for j, b := range st.sessions {
+		if j == i || a.Day != b.Day {
+			continue
+		}
+
+		shared := sharesResource(st.resources[i], st.resources[j])
+		gap := st.config.MinBreakBetweenSessions
+		if shared {
+			gap = st.travel.Gap(st.config, st.buildings[a.RoomID], st.buildings[b.RoomID])
+		}
+
+		if a.StartTime >= b.EndTime+gap || b.StartTime >= a.EndTime+gap {
+			continue
+		}
+
+		if a.RoomID == b.RoomID || shared {
+			return false
+		}
+	}
+
+	return true
+}
+
+// withinLimits reports whether session i's day stays within the config's daily limits
+func (st *state) withinLimits(i int) bool {
+	a := st.sessions[i]
+	load := scheduler.NewDailyLoad(st.config)
+
+	for j, b := range st.sessions {
+		if j != i && b.Day == a.Day && st.lengths[j] > 0 {
+			load.Add(b.CourseID, b.InstructorID, st.cohorts[b.CourseID], b.Day, st.lengths[j])
+		}
+	}
+
+	return load.Exceeded(a.CourseID, a.InstructorID, st.cohorts[a.CourseID], a.Day, a.EndTime-a.StartTime) == ""
+}
+
+// keepsOrdering reports whether session i's course meets all of its session constraints
+func (st *state) keepsOrdering(i int) bool {
+	courseID := st.sessions[i].CourseID
+
+	var placed []scheduler.PlacedSession
+	for j, s := range st.sessions {
+		if s.CourseID == courseID && st.types[j] != "" {
+			placed = append(placed, scheduler.PlacedSession{Type: st.types[j], Day: s.Day, StartTime: s.StartTime, EndTime: s.EndTime})
+		}
+	}
+
+	return len(st.ordering.Violations(courseID, placed, false)) == 0
+}
+
+func sharesResource(a, b []string) bool {
+	for _, res := range a {
+		if slices.Contains(b, res) {
+			return true
+		}
+	}
+	return false
+}
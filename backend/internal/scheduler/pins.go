@@ -0,0 +1,221 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+var (
+	// ErrInvalidPin is returned when a pin can't be placed as given
+	ErrInvalidPin = errors.New("invalid pin")
+
+	// ErrPinConflict is returned when two pins need the same room, instructor, cohort or session at the same time
+	ErrPinConflict = errors.New("pinned sessions conflict")
+)
+
+// ResolvePins places every pin in config.Pins before anything else is scheduled. Pins without a room
+// get the tightest-fitting free room of the session's required type; a pinned room is used as given.
+// Pins are checked against operating hours, room blackouts and each other, and never silently dropped:
+// any pin that can't be honoured fails the whole generation. A pin of a linked session holds its whole
+// occurrence: the pinned room is the first section's, and SectionRooms or the tightest fit the others'.
+func ResolvePins(input *Input, config *Config) ([]*models.ScheduledSession, error) {
+	if len(config.Pins) == 0 {
+		return nil, nil
+	}
+
+	sessionsByID := make(map[uuid.UUID]*models.CourseSession, len(input.CourseSessions))
+	for _, session := range input.CourseSessions {
+		if session != nil {
+			sessionsByID[session.ID] = session
+		}
+	}
+	roomsByID := make(map[uuid.UUID]*models.Room, len(input.Rooms))
+	for _, room := range input.Rooms {
+		if room != nil {
+			roomsByID[room.ID] = room
+		}
+	}
+	coursesByID := make(map[uuid.UUID]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID] = course
+		}
+	}
+	courseCohorts := CohortsByCourse(input.Cohorts)
+	roomAvailability := RoomAvailability(input.Rooms, input.RoomBlackouts, config)
+
+	pinCount := make(map[uuid.UUID]int)
+	var placed []*models.ScheduledSession
+	var placedResources [][]string
+
+	for _, pin := range config.Pins {
+		session := sessionsByID[pin.CourseSessionID]
+		if session == nil || session.Duration == nil || session.NumberOfSessions == nil {
+			return nil, fmt.Errorf("%w: course session %s not found", ErrInvalidPin, pin.CourseSessionID)
+		}
+
+		pinCount[session.ID]++
+		if pinCount[session.ID] > int(*session.NumberOfSessions) {
+			return nil, fmt.Errorf("%w: course session %s is pinned more often than its %d weekly sessions",
+				ErrInvalidPin, session.ID, *session.NumberOfSessions)
+		}
+
+		start, end := pin.StartTime, pin.StartTime+OccurrenceLength(session)
+		if !slices.Contains(config.OperatingDays, Day(pin.Day)) || start < config.OperatingHours.Start || end > config.OperatingHours.End {
+			return nil, fmt.Errorf("%w: course session %s on day %d at %s is outside operating hours",
+				ErrInvalidPin, session.ID, pin.Day, clock(start))
+		}
+
+		resources := pinResources(session, courseCohorts)
+		for i, other := range placed {
+			if overlaps(other, pin.Day, start, end, config.MinBreakBetweenSessions) && sharesKey(resources, placedResources[i]) {
+				return nil, fmt.Errorf("%w: course session %s on day %d at %s shares an instructor, cohort or session with course session %s",
+					ErrPinConflict, session.ID, pin.Day, clock(start), *other.CourseSessionID)
+			}
+		}
+
+		if len(pin.SectionRooms) >= Sections(session) {
+			return nil, fmt.Errorf("%w: course session %s has %d parallel sections but the pin names %d section rooms",
+				ErrInvalidPin, session.ID, Sections(session), len(pin.SectionRooms))
+		}
+
+		// Each parallel section needs its own room for the whole occurrence
+		enrollment := ExpectedEnrollment(session, coursesByID[session.CourseID])
+		taken := slices.Clone(placed)
+		var rooms []uuid.UUID
+		for k := range Sections(session) {
+			roomID := pin.RoomID
+			if k > 0 {
+				roomID = nil
+				if k-1 < len(pin.SectionRooms) {
+					roomID = &pin.SectionRooms[k-1]
+				}
+			}
+
+			if roomID != nil && slices.Contains(rooms, *roomID) {
+				return nil, fmt.Errorf("%w: course session %s is pinned to room %s for two sections", ErrInvalidPin, session.ID, *roomID)
+			}
+
+			room, err := pinRoom(pin.Day, roomID, session, enrollment, input.Rooms, roomsByID, roomAvailability, taken, start, end, config)
+			if err != nil {
+				return nil, err
+			}
+
+			rooms = append(rooms, room.ID)
+			taken = append(taken, &models.ScheduledSession{CourseSessionID: &session.ID, RoomID: room.ID, Day: pin.Day, StartTime: start, EndTime: end})
+		}
+
+		for _, part := range Occurrence(session, rooms, pin.Day, start) {
+			placed = append(placed, part)
+			placedResources = append(placedResources, resources)
+		}
+	}
+
+	return placed, nil
+}
+
+// pinRoom returns the pinned room if it's open and not taken by an earlier pin, or else the
+// tightest-fitting room that is
+func pinRoom(
+	day int,
+	roomID *uuid.UUID,
+	session *models.CourseSession,
+	enrollment int,
+	rooms []*models.Room,
+	roomsByID map[uuid.UUID]*models.Room,
+	roomAvailability Availability,
+	placed []*models.ScheduledSession,
+	start, end int,
+	config *Config,
+) (*models.Room, error) {
+	takenBy := func(room *models.Room) *models.ScheduledSession {
+		for _, other := range placed {
+			if other.RoomID == room.ID && overlaps(other, day, start, end, config.MinBreakBetweenSessions) {
+				return other
+			}
+		}
+		return nil
+	}
+
+	if roomID != nil {
+		room := roomsByID[*roomID]
+		if room == nil {
+			return nil, fmt.Errorf("%w: room %s not found", ErrInvalidPin, *roomID)
+		}
+		if !roomAvailability.Free(room.ID.String(), day, start, end) {
+			return nil, fmt.Errorf("%w: room %s is blacked out on day %d at %s", ErrInvalidPin, room.Name, day, clock(start))
+		}
+		if other := takenBy(room); other != nil {
+			return nil, fmt.Errorf("%w: course sessions %s and %s are both pinned to room %s on day %d at %s",
+				ErrPinConflict, *other.CourseSessionID, session.ID, room.Name, day, clock(start))
+		}
+		return room, nil
+	}
+
+	var fitting []*models.Room
+	for _, room := range rooms {
+		if room != nil && room.Type == session.RequiredRoom && int(room.Capacity) >= enrollment {
+			fitting = append(fitting, room)
+		}
+	}
+	slices.SortStableFunc(fitting, func(a, b *models.Room) int {
+		return int(a.Capacity) - int(b.Capacity)
+	})
+
+	takenByPin := false
+	for _, room := range fitting {
+		if !roomAvailability.Free(room.ID.String(), day, start, end) {
+			continue
+		}
+		if takenBy(room) != nil {
+			takenByPin = true
+			continue
+		}
+		return room, nil
+	}
+
+	if takenByPin {
+		return nil, fmt.Errorf("%w: every free room for course session %s on day %d at %s is taken by another pin",
+			ErrPinConflict, session.ID, day, clock(start))
+	}
+	return nil, fmt.Errorf("%w: no free room for course session %s on day %d at %s", ErrInvalidPin, session.ID, day, clock(start))
+}
+
+// pinResources returns keys for everything besides the room that a pinned session must not share
+func pinResources(session *models.CourseSession, courseCohorts map[uuid.UUID][]uuid.UUID) []string {
+	resources := []string{"session:" + session.ID.String()}
+
+	if session.InstructorID != nil {
+		resources = append(resources, "instructor:"+session.InstructorID.String())
+	}
+
+	for _, cohortID := range courseCohorts[session.CourseID] {
+		resources = append(resources, "cohort:"+cohortID.String())
+	}
+
+	return resources
+}
+
+// overlaps reports whether s overlaps [start, end) on day, keeping the minimum break between them
+func overlaps(s *models.ScheduledSession, day, start, end, minBreak int) bool {
+	return s.Day == day && s.StartTime < end+minBreak && start < s.EndTime+minBreak
+}
+
+func sharesKey(a, b []string) bool {
+	for _, key := range a {
+		if slices.Contains(b, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// clock formats minutes from midnight as HH:MM
+func clock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,364 @@
+package scheduler
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// RepairPlan splits an existing schedule into the sessions that are still valid against the current
+// data and the ones that have to be placed again. Kept sessions are handed to the scheduler as pins,
+// so whichever algorithm runs only fills in around them.
+type RepairPlan struct {
+	// Config is the request's config with a pin added for every kept session
+	Config *Config
+
+	// Pins are the pins the repair honours: the config's own, then the inherited ones that still
+	// resolve. RemovedPins are the inherited pins left out, e.g. because their room was deleted.
+	Pins        []models.SessionPin
+	RemovedPins []models.SessionPin
+
+	Kept        []*models.ScheduledSession
+	Invalidated []*models.ScheduledSession
+}
+
+// SessionMove is a session that a repair took out of the schedule and placed somewhere else
+type SessionMove struct {
+	CourseSessionID uuid.UUID               `json:"course_session_id"`
+	From            models.ScheduledSession `json:"from"`
+	To              models.ScheduledSession `json:"to"`
+}
+
+// RepairResult lists exactly what a repair changed; every session not listed stayed where it was
+type RepairResult struct {
+	Output *Output
+
+	Kept  int
+	Moved []SessionMove
+
+	// Added are occurrences placed that had no invalidated session to replace, e.g. new course sessions
+	Added []*models.ScheduledSession
+
+	// Removed are invalidated sessions that weren't placed again, because their course session is
+	// gone or no longer fits anywhere
+	Removed []*models.ScheduledSession
+
+	// RemovedPins are inherited pins that no longer resolve and were dropped; the sessions they held
+	// are checked like any other, so they appear in Moved or Removed if they had to go
+	RemovedPins []models.SessionPin
+}
+
+// PlanRepair checks every session of an existing schedule against the current rooms, course sessions,
+// blackouts, operating hours, walking times and config pins. A session is invalidated if its course
+// session or room is gone, its duration changed, its room no longer suits it, it falls outside operating
+// hours or in a blackout, its course session now has fewer weekly occurrences, it would break a daily
+// limit, or it clashes with a pin or an earlier kept session, including not leaving time to walk between
+// buildings. The parts of a linked occurrence are kept only if every one of them is. Sessions saved before
+// course sessions were tracked are always invalidated.
+//
+// Inherited pins, such as those the schedule was generated with, are added after the config's own.
+// The config's pins must all resolve, but an inherited pin that doesn't, because the data it refers
+// to has changed, is dropped rather than failing the repair.
+func PlanRepair(input *Input, config *Config, sessions []models.ScheduledSession, inherited []models.SessionPin) (*RepairPlan, error) {
+	config, removedPins, err := withInheritedPins(input, config, inherited)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned, err := ResolvePins(input, config)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsByID := make(map[uuid.UUID]*models.CourseSession, len(input.CourseSessions))
+	for _, session := range input.CourseSessions {
+		if session != nil {
+			sessionsByID[session.ID] = session
+		}
+	}
+	roomsByID := make(map[uuid.UUID]*models.Room, len(input.Rooms))
+	for _, room := range input.Rooms {
+		if room != nil {
+			roomsByID[room.ID] = room
+		}
+	}
+	coursesByID := make(map[uuid.UUID]*models.Course, len(input.Courses))
+	for _, course := range input.Courses {
+		if course != nil {
+			coursesByID[course.ID] = course
+		}
+	}
+	courseCohorts := CohortsByCourse(input.Cohorts)
+	roomAvailability := RoomAvailability(input.Rooms, input.RoomBlackouts, config)
+	travel := NewTravelTimes(input.BuildingDistances)
+	load := NewDailyLoad(config)
+
+	// Everything placed so far, starting with the config's pins
+	var placed []*models.ScheduledSession
+	var placedResources [][]string
+	pinUsed := make([]bool, len(pinned))
+	occurrences := make(map[uuid.UUID]int)
+	for _, ps := range pinned {
+		session := sessionsByID[*ps.CourseSessionID]
+		placed = append(placed, ps)
+		placedResources = append(placedResources, pinResources(session, courseCohorts))
+		if Lead(ps) {
+			occurrences[session.ID]++
+			load.Add(ps.CourseID, ps.InstructorID, courseCohorts[ps.CourseID], ps.Day, OccurrenceLength(session))
+		}
+	}
+
+	// fits reports whether s still suits its room and doesn't clash with anything placed so far
+	fits := func(s *models.ScheduledSession, session *models.CourseSession) bool {
+		room := roomsByID[s.RoomID]
+		if room == nil || room.Type != session.RequiredRoom ||
+			int(room.Capacity) < ExpectedEnrollment(session, coursesByID[session.CourseID]) ||
+			int(*session.Duration) != s.EndTime-s.StartTime ||
+			!roomAvailability.Free(room.ID.String(), s.Day, s.StartTime, s.EndTime) {
+			return false
+		}
+
+		resources := pinResources(session, courseCohorts)
+		for j, other := range placed {
+			shared := sharesKey(resources, placedResources[j])
+			gap := config.MinBreakBetweenSessions
+			if shared && roomsByID[other.RoomID] != nil {
+				gap = travel.Gap(config, roomsByID[other.RoomID].Building, room.Building)
+			}
+
+			if overlaps(other, s.Day, s.StartTime, s.EndTime, gap) && (other.RoomID == s.RoomID || shared) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	plan := &RepairPlan{Pins: config.Pins, RemovedPins: removedPins}
+	var keptPins []models.SessionPin
+	grouped := make([]bool, len(sessions))
+
+	for i := range sessions {
+		s := sessions[i]
+		if grouped[i] {
+			continue
+		}
+
+		// A session already where a config pin puts it stays, held by that pin
+		if k := matchingPin(&s, pinned, pinUsed); k >= 0 {
+			pinUsed[k] = true
+			plan.Kept = append(plan.Kept, &s)
+			continue
+		}
+
+		var session *models.CourseSession
+		if s.CourseSessionID != nil {
+			session = sessionsByID[*s.CourseSessionID]
+		}
+
+		// The parts of a linked occurrence are kept or placed again together
+		occurrence := []*models.ScheduledSession{&s}
+		if session != nil && session.Duration != nil && Linked(session) {
+			occurrence = linkedOccurrence(sessions, i, session, grouped)
+		}
+
+		valid := session != nil && session.Duration != nil && session.NumberOfSessions != nil &&
+			occurrences[session.ID] < int(*session.NumberOfSessions) &&
+			load.Exceeded(session.CourseID, session.InstructorID, courseCohorts[session.CourseID], s.Day, OccurrenceLength(session)) == "" &&
+			(!Linked(session) || wholeOccurrence(occurrence, session))
+
+		if valid {
+			for _, part := range occurrence {
+				if !fits(part, session) {
+					valid = false
+					break
+				}
+			}
+		}
+
+		if !valid {
+			plan.Invalidated = append(plan.Invalidated, occurrence...)
+			continue
+		}
+
+		var sectionRooms []uuid.UUID
+		for _, part := range occurrence {
+			// Keep the session in step with its course session, e.g. a changed instructor
+			part.CourseID, part.InstructorID = session.CourseID, session.InstructorID
+			if part.Section > 0 {
+				part.InstructorID = nil
+				if part.Block == 0 {
+					sectionRooms = append(sectionRooms, part.RoomID)
+				}
+			}
+
+			placed = append(placed, part)
+			placedResources = append(placedResources, pinResources(session, courseCohorts))
+			plan.Kept = append(plan.Kept, part)
+		}
+
+		lead := occurrence[0]
+		occurrences[session.ID]++
+		load.Add(session.CourseID, session.InstructorID, courseCohorts[session.CourseID], lead.Day, OccurrenceLength(session))
+		keptPins = append(keptPins, models.SessionPin{
+			CourseSessionID: session.ID,
+			Day:             lead.Day,
+			StartTime:       lead.StartTime,
+			RoomID:          &lead.RoomID,
+			SectionRooms:    sectionRooms,
+		})
+	}
+
+	repairConfig := *config
+	repairConfig.Pins = append(slices.Clone(config.Pins), keptPins...)
+	plan.Config = &repairConfig
+
+	return plan, nil
+}
+
+// Result compares the scheduler's output with the plan and reports what moved. Invalidated sessions
+// are paired in order with new placements of the same course session.
+func (p *RepairPlan) Result(output *Output) *RepairResult {
+	result := &RepairResult{Output: output, Kept: len(p.Kept), RemovedPins: p.RemovedPins}
+
+	kept := make([]bool, len(p.Kept))
+	var placedNew []*models.ScheduledSession
+	for _, s := range output.ScheduledSessions {
+		if k := matchingPin(s, p.Kept, kept); k >= 0 {
+			kept[k] = true
+			continue
+		}
+		placedNew = append(placedNew, s)
+	}
+
+	used := make([]bool, len(placedNew))
+	for _, old := range p.Invalidated {
+		match := -1
+		if old.CourseSessionID != nil {
+			for j, s := range placedNew {
+				if !used[j] && s.CourseSessionID != nil && *s.CourseSessionID == *old.CourseSessionID {
+					match = j
+					break
+				}
+			}
+		}
+
+		if match < 0 {
+			result.Removed = append(result.Removed, old)
+			continue
+		}
+
+		used[match] = true
+		result.Moved = append(result.Moved, SessionMove{
+			CourseSessionID: *old.CourseSessionID,
+			From:            *old,
+			To:              *placedNew[match],
+		})
+	}
+
+	for j, s := range placedNew {
+		if !used[j] {
+			result.Added = append(result.Added, s)
+		}
+	}
+
+	return result
+}
+
+// withInheritedPins returns config with the inherited pins added after its own, one at a time,
+// leaving out those that don't resolve alongside the pins before them
+func withInheritedPins(input *Input, config *Config, inherited []models.SessionPin) (*Config, []models.SessionPin, error) {
+	if _, err := ResolvePins(input, config); err != nil {
+		return nil, nil, err
+	}
+
+	withPins := *config
+	withPins.Pins = slices.Clone(config.Pins)
+	var removed []models.SessionPin
+	for _, pin := range inherited {
+		withPins.Pins = append(withPins.Pins, pin)
+		if _, err := ResolvePins(input, &withPins); err != nil {
+			if !errors.Is(err, ErrInvalidPin) && !errors.Is(err, ErrPinConflict) {
+				return nil, nil, err
+			}
+			withPins.Pins = withPins.Pins[:len(withPins.Pins)-1]
+			removed = append(removed, pin)
+		}
+	}
+
+	return &withPins, removed, nil
+}
+
+// matchingPin returns the index of an unused placement at exactly s's course session, room, day and
+// time, or -1
+func matchingPin(s *models.ScheduledSession, placed []*models.ScheduledSession, used []bool) int {
+	for k, ps := range placed {
+		if !used[k] && sameOccurrence(ps, s) {
+			return k
+		}
+	}
+	return -1
+}
+
+func sameOccurrence(a, b *models.ScheduledSession) bool {
+	return a.CourseSessionID != nil && b.CourseSessionID != nil && *a.CourseSessionID == *b.CourseSessionID &&
+		a.RoomID == b.RoomID && a.Day == b.Day && a.StartTime == b.StartTime
+}
+
+// linkedOccurrence gathers the parts of the linked occurrence that sessions[i] belongs to, from i on,
+// ordered by section and block, and marks them grouped
+func linkedOccurrence(sessions []models.ScheduledSession, i int, session *models.CourseSession, grouped []bool) []*models.ScheduledSession {
+	duration := int(*session.Duration)
+	start := sessions[i].StartTime - sessions[i].Block*duration
+
+	var parts []*models.ScheduledSession
+	for j := i; j < len(sessions); j++ {
+		other := sessions[j]
+		if grouped[j] || other.CourseSessionID == nil || *other.CourseSessionID != session.ID ||
+			other.Day != sessions[i].Day || other.StartTime-other.Block*duration != start {
+			continue
+		}
+
+		grouped[j] = true
+		parts = append(parts, &other)
+	}
+
+	slices.SortStableFunc(parts, func(a, b *models.ScheduledSession) int {
+		if a.Section != b.Section {
+			return a.Section - b.Section
+		}
+		return a.Block - b.Block
+	})
+
+	return parts
+}
+
+// wholeOccurrence reports whether parts are exactly one block per block and section of the session, with
+// each section in one room and no two sections sharing a room
+func wholeOccurrence(parts []*models.ScheduledSession, session *models.CourseSession) bool {
+	blocks, sections := Blocks(session), Sections(session)
+	if len(parts) != blocks*sections {
+		return false
+	}
+
+	var rooms []uuid.UUID
+	for k, part := range parts {
+		if part.Section != k/blocks || part.Block != k%blocks {
+			return false
+		}
+
+		if part.Block == 0 {
+			if slices.Contains(rooms, part.RoomID) {
+				return false
+			}
+			rooms = append(rooms, part.RoomID)
+		} else if part.RoomID != parts[k-1].RoomID {
+			return false
+		}
+	}
+
+	return true
+}
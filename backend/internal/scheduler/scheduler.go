@@ -1,6 +1,14 @@
 package scheduler
 
-import "github.com/TerrenceMurray/course-scheduler/internal/models"
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
 
 // Day represents a day of the week (0 = Monday, 6 = Sunday)
 type Day int
@@ -35,17 +43,85 @@ type Config struct {
 	OperatingDays []Day
 
 	// MinBreakBetweenSessions is the minimum gap between sessions (in minutes)
-	// Useful for travel time between buildings, etc.
+	// Sessions sharing a cohort or instructor in different buildings also get the walking time from
+	// Input.BuildingDistances, where that is longer
 	MinBreakBetweenSessions int
 
 	// PreferredSlotDuration helps align sessions to consistent start times (e.g., 60 = hourly slots)
 	// Set to 0 to disable
 	PreferredSlotDuration int
+
+	// Algorithm selects the scheduler implementation (default: greedy)
+	Algorithm Algorithm
+
+	// SearchTimeLimit caps how long search-based schedulers may run (in milliseconds)
+	// Set to 0 to use the scheduler's default
+	SearchTimeLimit int
+
+	// SearchNodeLimit caps how many assignments search-based schedulers may try
+	// Set to 0 to use the scheduler's default
+	SearchNodeLimit int
+
+	// Pins fix session occurrences to a day, start time and optionally a room; see ResolvePins
+	Pins []models.SessionPin
+
+	// MaxDuration caps how long any scheduler may run (in milliseconds). When it passes, the scheduler
+	// returns the best result found so far with Output.Incomplete set.
+	// Set to 0 for no cap
+	MaxDuration int
+
+	// MaxCourseSessionsPerDay caps how many sessions of the same course may fall on one day
+	// Set to 0 for no limit
+	MaxCourseSessionsPerDay int
+
+	// MaxInstructorMinutesPerDay caps how long an instructor may teach on one day (in minutes)
+	// Set to 0 for no limit
+	MaxInstructorMinutesPerDay int
+
+	// MaxCohortMinutesPerDay caps a cohort's contact time on one day (in minutes)
+	// Set to 0 for no limit
+	MaxCohortMinutesPerDay int
+
+	// TermID generates for one term: only courses offered in the term are scheduled, with their sessions
+	// for that term or for every term. Set to nil to schedule the sessions that aren't tied to a term
+	TermID *uuid.UUID
 }
 
-// Scheduler generates schedules from inputs
+// Algorithm names a Scheduler implementation that can be selected per request
+type Algorithm string
+
+const (
+	AlgorithmGreedy Algorithm = "greedy" // single pass, first fit
+	AlgorithmCSP    Algorithm = "csp"    // backtracking search with forward checking
+)
+
+// Scheduler generates schedules from inputs. Implementations report how far they have got
+// through Input.Progress, if set. They stop and return ctx's error if ctx is cancelled; if ctx's
+// deadline or Config.MaxDuration passes, they return what they have placed so far, flagged incomplete.
 type Scheduler interface {
-	Generate(input *Input) (*Output, error)
+	Generate(ctx context.Context, input *Input) (*Output, error)
+}
+
+// WithMaxDuration returns ctx bounded by config.MaxDuration, if set
+func WithMaxDuration(ctx context.Context, config *Config) (context.Context, context.CancelFunc) {
+	if config == nil || config.MaxDuration <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Duration(config.MaxDuration)*time.Millisecond)
+}
+
+// Interrupted reports whether a scheduler should stop. Running out of time is not an error: the
+// scheduler should return its partial result. Any other reason ctx ended, such as the caller
+// cancelling, is returned as the error.
+func Interrupted(ctx context.Context) (bool, error) {
+	err := ctx.Err()
+	if err == nil {
+		return false, nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true, nil
+	}
+	return true, err
 }
 
 // Input contains everything needed to generate a schedule
@@ -54,12 +130,114 @@ type Input struct {
 	Rooms          []*models.Room
 	Courses        []*models.Course
 	CourseSessions []*models.CourseSession
+
+	// Cohorts lists courses taken together; sessions of courses sharing a cohort never overlap
+	Cohorts []*models.Cohort
+
+	// Instructors carries teaching preferences used for scoring
+	Instructors []*models.Instructor
+
+	// RoomBlackouts are times rooms can't be used; see RoomAvailability
+	RoomBlackouts []*models.RoomBlackout
+
+	// BuildingDistances are walking times between buildings; see TravelTimes
+	BuildingDistances []*models.BuildingDistance
+
+	// SessionConstraints order and separate the session types of a course; see SessionOrdering
+	SessionConstraints []*models.SessionConstraint
+
+	// Progress, if set, is called as generation advances. It runs on the scheduler's goroutine,
+	// so it should return quickly.
+	Progress ProgressFunc
+}
+
+// ReportProgress calls the input's progress callback, if any
+func (in *Input) ReportProgress(p Progress) {
+	if in.Progress != nil {
+		in.Progress(p)
+	}
+}
+
+// Progress reports how far a generation has got. Sessions are counted per weekly occurrence.
+type Progress struct {
+	SessionsPlaced int `json:"sessions_placed"`
+	SessionsFailed int `json:"sessions_failed"`
+	SessionsTotal  int `json:"sessions_total"`
+
+	// BestScore is the cost of the best solution found so far, for schedulers that track one
+	BestScore *float64 `json:"best_score,omitempty"`
 }
 
+// Fraction returns the share of sessions dealt with so far, between 0 and 1
+func (p Progress) Fraction() float64 {
+	if p.SessionsTotal <= 0 {
+		return 0
+	}
+	return min(float64(p.SessionsPlaced+p.SessionsFailed)/float64(p.SessionsTotal), 1)
+}
+
+// ProgressFunc receives progress reports from a scheduler
+type ProgressFunc func(Progress)
+
 // Output contains the generated sessions
 type Output struct {
 	ScheduledSessions []*models.ScheduledSession
 	Failures          []*FailedSession
+
+	// CohortClashes counts, per cohort ID, the sessions that failed because the cohort was already busy
+	CohortClashes map[uuid.UUID]int
+
+	// SeatUsage summarises how well scheduled sessions fill their rooms
+	SeatUsage SeatUsage
+
+	// Score rates the schedule against soft constraints; set by the caller, nil if not scored
+	Score *models.ScheduleScore
+
+	// Incomplete is set when the scheduler ran out of time and returned the best result it had so far
+	Incomplete bool
+
+	// Violations lists session constraints the schedule breaks, e.g. because of a pin or a failed session
+	Violations []ConstraintViolation
+}
+
+// SeatUsage reports room capacity against expected enrollment.
+// Only sessions with a known enrollment are counted.
+type SeatUsage struct {
+	OfferedSeats int // total capacity of the rooms assigned
+	FilledSeats  int // total expected enrollment
+	WastedSeats  int // OfferedSeats - FilledSeats
+}
+
+// CohortsByCourse maps each course ID to the IDs of the cohorts that take it
+func CohortsByCourse(cohorts []*models.Cohort) map[uuid.UUID][]uuid.UUID {
+	courseCohorts := make(map[uuid.UUID][]uuid.UUID)
+
+	for _, cohort := range cohorts {
+		if cohort == nil {
+			continue
+		}
+
+		for _, courseID := range cohort.CourseIDs {
+			courseCohorts[courseID] = append(courseCohorts[courseID], cohort.ID)
+		}
+	}
+
+	return courseCohorts
+}
+
+// ExpectedEnrollment returns the number of students expected in one room at a session: the session's
+// own enrollment if set, otherwise the course's, split evenly across its parallel sections.
+// Returns 0 if unknown.
+func ExpectedEnrollment(session *models.CourseSession, course *models.Course) int {
+	enrollment := 0
+	if session != nil && session.ExpectedEnrollment != nil {
+		enrollment = int(*session.ExpectedEnrollment)
+	} else if course != nil && course.ExpectedEnrollment != nil {
+		enrollment = int(*course.ExpectedEnrollment)
+	}
+
+	sections := Sections(session)
+	return (enrollment + sections - 1) / sections
 }
 
 // FailedSession represents a session that couldn't be scheduled
@@ -68,6 +246,20 @@ type FailedSession struct {
 	Reason        string
 }
 
+// Failure reasons reported in FailedSession.Reason
+const (
+	ReasonNoAvailableSlot       = "no available time slot found"
+	ReasonInstructorUnavailable = "no available time slot found: instructor is already booked at every free room slot"
+	ReasonCohortClash           = "no available time slot found: cohort already has a session at every free room slot"
+	ReasonInsufficientCapacity  = "no room of the required type is large enough for the expected enrollment"
+	ReasonTimeLimit             = "not attempted: the scheduler ran out of time"
+	ReasonCourseDailyLimit      = "no available time slot found: course already has the maximum sessions per day on every day with a free room slot"
+	ReasonInstructorDailyLimit  = "no available time slot found: instructor would exceed the daily teaching limit on every day with a free room slot"
+	ReasonCohortDailyLimit      = "no available time slot found: cohort would exceed the daily contact limit on every day with a free room slot"
+	ReasonOrderingConstraint    = "no available time slot found: no free room slot meets the course's session constraints"
+	ReasonSectionRooms          = "no available time slot found: not enough rooms of the required type and size are free at once for every parallel section"
+)
+
 // TimeRange defines a time interval (in minutes from midnight)
 type TimeRange struct {
 	Start int // e.g., 480 = 8:00 AM
@@ -77,3 +269,71 @@ type TimeRange struct {
 // Availability defines the availability of a room for every day of the week
 // Usage: Availability[roomID][day] = []TimeRange{{Start: 480, End: 1260}, ...}
 type Availability map[string]map[int][]TimeRange
+
+// RoomAvailability gives every room the operating hours on every operating day, minus its blackouts.
+// The schedule repeats weekly, so one-off blackouts block their weekday too.
+func RoomAvailability(rooms []*models.Room, blackouts []*models.RoomBlackout, config *Config) Availability {
+	availability := make(Availability)
+
+	for _, room := range rooms {
+		if room == nil {
+			continue
+		}
+
+		availability[room.ID.String()] = make(map[int][]TimeRange)
+
+		for _, day := range config.OperatingDays {
+			availability[room.ID.String()][int(day)] = []TimeRange{config.OperatingHours}
+		}
+	}
+
+	for _, blackout := range blackouts {
+		if blackout == nil {
+			continue
+		}
+
+		roomAvail, exists := availability[blackout.RoomID.String()]
+		if !exists {
+			continue
+		}
+
+		day := int(blackout.Day)
+		if ranges, open := roomAvail[day]; open {
+			roomAvail[day] = SubtractRange(ranges, int(blackout.StartTime), int(blackout.EndTime))
+		}
+	}
+
+	return availability
+}
+
+// Free reports whether [start, end) lies entirely within one of the key's free ranges on the day
+func (a Availability) Free(key string, day, start, end int) bool {
+	for _, r := range a[key][day] {
+		if r.Start <= start && end <= r.End {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SubtractRange removes [start, end) from ranges, splitting any range it falls inside
+func SubtractRange(ranges []TimeRange, start, end int) []TimeRange {
+	result := make([]TimeRange, 0, len(ranges)+1)
+
+	for _, r := range ranges {
+		if r.End <= start || r.Start >= end {
+			result = append(result, r)
+			continue
+		}
+
+		if r.Start < start {
+			result = append(result, TimeRange{Start: r.Start, End: start})
+		}
+		if r.End > end {
+			result = append(result, TimeRange{Start: end, End: r.End})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,148 @@
+package scoring
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// DefaultLateEveningStart is when sessions start counting as late (6:00 PM)
+const DefaultLateEveningStart = 18 * 60
+
+// CohortGaps penalises idle time between a cohort's sessions on the same day, in hours.
+// Gaps no longer than the configured minimum break are not counted.
+type CohortGaps struct{}
+
+func (CohortGaps) Name() string { return "cohort_gaps" }
+
+func (CohortGaps) Penalty(data *Data, sessions []*models.ScheduledSession) float64 {
+	type cohortDay struct {
+		cohort uuid.UUID
+		day    int
+	}
+
+	byCohortDay := make(map[cohortDay][]*models.ScheduledSession)
+	for _, s := range sessions {
+		for _, cohortID := range data.CourseCohorts[s.CourseID] {
+			key := cohortDay{cohortID, s.Day}
+			byCohortDay[key] = append(byCohortDay[key], s)
+		}
+	}
+
+	gapMinutes := 0
+	for _, daySessions := range byCohortDay {
+		slices.SortFunc(daySessions, func(a, b *models.ScheduledSession) int {
+			return a.StartTime - b.StartTime
+		})
+
+		latestEnd := daySessions[0].EndTime
+		for _, s := range daySessions[1:] {
+			if gap := s.StartTime - latestEnd; gap > data.Config.MinBreakBetweenSessions {
+				gapMinutes += gap
+			}
+			latestEnd = max(latestEnd, s.EndTime)
+		}
+	}
+
+	return float64(gapMinutes) / 60
+}
+
+// LateEvening penalises time spent teaching after Start, in hours
+type LateEvening struct {
+	Start int // minutes from midnight
+}
+
+func (LateEvening) Name() string { return "late_evening" }
+
+func (l LateEvening) Penalty(_ *Data, sessions []*models.ScheduledSession) float64 {
+	lateMinutes := 0
+	for _, s := range sessions {
+		if s.EndTime > l.Start {
+			lateMinutes += s.EndTime - max(s.StartTime, l.Start)
+		}
+	}
+
+	return float64(lateMinutes) / 60
+}
+
+// SameDayRepeats penalises each extra session of a course on a day it already meets. The parts of a
+// linked occurrence count as one session.
+type SameDayRepeats struct{}
+
+func (SameDayRepeats) Name() string { return "same_day_repeats" }
+
+func (SameDayRepeats) Penalty(_ *Data, sessions []*models.ScheduledSession) float64 {
+	type courseDay struct {
+		course uuid.UUID
+		day    int
+	}
+
+	perCourseDay := make(map[courseDay]int)
+	repeats := 0
+	for _, s := range sessions {
+		if !scheduler.Lead(s) {
+			continue
+		}
+
+		key := courseDay{s.CourseID, s.Day}
+		if perCourseDay[key] > 0 {
+			repeats++
+		}
+		perCourseDay[key]++
+	}
+
+	return float64(repeats)
+}
+
+// RoomWaste penalises empty seats as a fraction of each room's capacity, summed over sessions.
+// Sessions with an unknown enrollment are not counted.
+type RoomWaste struct{}
+
+func (RoomWaste) Name() string { return "room_waste" }
+
+func (RoomWaste) Penalty(data *Data, sessions []*models.ScheduledSession) float64 {
+	waste := 0.0
+	for _, s := range sessions {
+		room := data.Rooms[s.RoomID]
+		enrollment := data.enrollment(s)
+		if room == nil || room.Capacity <= 0 || enrollment <= 0 || enrollment >= int(room.Capacity) {
+			continue
+		}
+
+		waste += float64(int(room.Capacity)-enrollment) / float64(room.Capacity)
+	}
+
+	return waste
+}
+
+// InstructorPreferences penalises time instructors teach outside their preferred hours, in hours
+type InstructorPreferences struct{}
+
+func (InstructorPreferences) Name() string { return "instructor_preferences" }
+
+func (InstructorPreferences) Penalty(data *Data, sessions []*models.ScheduledSession) float64 {
+	outsideMinutes := 0
+	for _, s := range sessions {
+		if s.InstructorID == nil {
+			continue
+		}
+
+		instructor := data.Instructors[*s.InstructorID]
+		if instructor == nil || instructor.PreferredStart == nil || instructor.PreferredEnd == nil {
+			continue
+		}
+
+		preferredStart, preferredEnd := int(*instructor.PreferredStart), int(*instructor.PreferredEnd)
+		if s.StartTime < preferredStart {
+			outsideMinutes += min(s.EndTime, preferredStart) - s.StartTime
+		}
+		if s.EndTime > preferredEnd {
+			outsideMinutes += s.EndTime - max(s.StartTime, preferredEnd)
+		}
+	}
+
+	return float64(outsideMinutes) / 60
+}
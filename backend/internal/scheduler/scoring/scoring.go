@@ -0,0 +1,129 @@
+package scoring
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// Constraint is a soft constraint. Penalty returns an unweighted, non-negative penalty for the
+// given sessions; zero means the constraint is fully satisfied.
+type Constraint interface {
+	Name() string
+	Penalty(data *Data, sessions []*models.ScheduledSession) float64
+}
+
+// WeightedConstraint pairs a constraint with how much it counts towards the total
+type WeightedConstraint struct {
+	Constraint Constraint
+	Weight     float64
+}
+
+// Model scores schedules against a set of weighted soft constraints
+type Model struct {
+	Constraints []WeightedConstraint
+}
+
+func NewModel(constraints ...WeightedConstraint) *Model {
+	return &Model{
+		Constraints: constraints,
+	}
+}
+
+// DefaultModel returns the built-in constraints with their default weights
+func DefaultModel() *Model {
+	return NewModel(
+		WeightedConstraint{Constraint: CohortGaps{}, Weight: 1},
+		WeightedConstraint{Constraint: LateEvening{Start: DefaultLateEveningStart}, Weight: 2},
+		WeightedConstraint{Constraint: SameDayRepeats{}, Weight: 5},
+		WeightedConstraint{Constraint: RoomWaste{}, Weight: 1},
+		WeightedConstraint{Constraint: InstructorPreferences{}, Weight: 3},
+	)
+}
+
+// Score evaluates sessions against every constraint
+func (m *Model) Score(input *scheduler.Input, sessions []*models.ScheduledSession) *models.ScheduleScore {
+	return m.score(NewData(input), sessions)
+}
+
+// Objective returns the total score as a cost function over sessions built from the same input,
+// suitable for the local-search optimizer
+func (m *Model) Objective(input *scheduler.Input) func(sessions []*models.ScheduledSession) float64 {
+	data := NewData(input)
+
+	return func(sessions []*models.ScheduledSession) float64 {
+		return m.score(data, sessions).Total
+	}
+}
+
+func (m *Model) score(data *Data, sessions []*models.ScheduledSession) *models.ScheduleScore {
+	score := &models.ScheduleScore{
+		Breakdown: make(map[string]float64, len(m.Constraints)),
+	}
+
+	for _, wc := range m.Constraints {
+		penalty := wc.Weight * wc.Constraint.Penalty(data, sessions)
+		score.Breakdown[wc.Constraint.Name()] += penalty
+		score.Total += penalty
+	}
+
+	return score
+}
+
+// Data indexes the scheduler input so constraints can look up related records
+type Data struct {
+	Config        *scheduler.Config
+	Rooms         map[uuid.UUID]*models.Room
+	Courses       map[uuid.UUID]*models.Course
+	Sessions      map[uuid.UUID]*models.CourseSession
+	Instructors   map[uuid.UUID]*models.Instructor
+	CourseCohorts map[uuid.UUID][]uuid.UUID
+}
+
+func NewData(input *scheduler.Input) *Data {
+	data := &Data{
+		Config:        input.Config,
+		Rooms:         make(map[uuid.UUID]*models.Room, len(input.Rooms)),
+		Courses:       make(map[uuid.UUID]*models.Course, len(input.Courses)),
+		Sessions:      make(map[uuid.UUID]*models.CourseSession, len(input.CourseSessions)),
+		Instructors:   make(map[uuid.UUID]*models.Instructor, len(input.Instructors)),
+		CourseCohorts: scheduler.CohortsByCourse(input.Cohorts),
+	}
+	if data.Config == nil {
+		data.Config = scheduler.DefaultConfig()
+	}
+
+	for _, room := range input.Rooms {
+		if room != nil {
+			data.Rooms[room.ID] = room
+		}
+	}
+	for _, course := range input.Courses {
+		if course != nil {
+			data.Courses[course.ID] = course
+		}
+	}
+	for _, session := range input.CourseSessions {
+		if session != nil {
+			data.Sessions[session.ID] = session
+		}
+	}
+	for _, instructor := range input.Instructors {
+		if instructor != nil {
+			data.Instructors[instructor.ID] = instructor
+		}
+	}
+
+	return data
+}
+
+// enrollment returns the expected enrollment for a scheduled session, or 0 if unknown
+func (d *Data) enrollment(s *models.ScheduledSession) int {
+	var session *models.CourseSession
+	if s.CourseSessionID != nil {
+		session = d.Sessions[*s.CourseSessionID]
+	}
+
+	return scheduler.ExpectedEnrollment(session, d.Courses[s.CourseID])
+}
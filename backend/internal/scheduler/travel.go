@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+)
+
+// TravelTimes holds the walking time in minutes between pairs of buildings
+type TravelTimes map[[2]uuid.UUID]int
+
+// NewTravelTimes indexes building distances. Walking times are symmetric; if the two directions
+// of a pair differ, the longer one is used.
+func NewTravelTimes(distances []*models.BuildingDistance) TravelTimes {
+	travel := make(TravelTimes)
+
+	for _, d := range distances {
+		if d == nil || d.FromBuildingID == d.ToBuildingID {
+			continue
+		}
+
+		key := buildingPair(d.FromBuildingID, d.ToBuildingID)
+		travel[key] = max(travel[key], int(d.Minutes))
+	}
+
+	return travel
+}
+
+// Between returns the walking time between two buildings: 0 for the same building or a pair with no distance set
+func (t TravelTimes) Between(a, b uuid.UUID) int {
+	if a == b {
+		return 0
+	}
+	return t[buildingPair(a, b)]
+}
+
+// Gap returns the minimum time between two sessions attended by the same cohort, instructor or
+// students, held in rooms in buildings a and b: the configured break, or the walk if that is longer
+func (t TravelTimes) Gap(config *Config, a, b uuid.UUID) int {
+	return max(config.MinBreakBetweenSessions, t.Between(a, b))
+}
+
+func buildingPair(a, b uuid.UUID) [2]uuid.UUID {
+	if a.String() > b.String() {
+		a, b = b, a
+	}
+	return [2]uuid.UUID{a, b}
+}
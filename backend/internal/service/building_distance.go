@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ BuildingDistanceServiceInterface = (*BuildingDistanceService)(nil)
+
+type BuildingDistanceServiceInterface interface {
+	Set(ctx context.Context, distance *models.BuildingDistance) (*models.BuildingDistance, error)
+	GetByBuildingID(ctx context.Context, buildingID uuid.UUID) ([]*models.BuildingDistance, error)
+	List(ctx context.Context) ([]*models.BuildingDistance, error)
+	Delete(ctx context.Context, fromBuildingID, toBuildingID uuid.UUID) error
+}
+
+type BuildingDistanceService struct {
+	repo         repository.BuildingDistanceRepositoryInterface
+	buildingRepo repository.BuildingRepositoryInterface
+}
+
+func NewBuildingDistanceService(repo repository.BuildingDistanceRepositoryInterface, buildingRepo repository.BuildingRepositoryInterface) *BuildingDistanceService {
+	return &BuildingDistanceService{
+		repo:         repo,
+		buildingRepo: buildingRepo,
+	}
+}
+
+// Set stores the walking time between two buildings. Both must exist, otherwise
+// repository.ErrNotFound is returned.
+func (s *BuildingDistanceService) Set(ctx context.Context, distance *models.BuildingDistance) (*models.BuildingDistance, error) {
+	if _, err := s.buildingRepo.GetByID(ctx, distance.FromBuildingID); err != nil {
+		return nil, err
+	}
+	if _, err := s.buildingRepo.GetByID(ctx, distance.ToBuildingID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.Set(ctx, distance)
+}
+
+// GetByBuildingID lists the walking times from a building, or repository.ErrNotFound if it doesn't exist
+func (s *BuildingDistanceService) GetByBuildingID(ctx context.Context, buildingID uuid.UUID) ([]*models.BuildingDistance, error) {
+	if _, err := s.buildingRepo.GetByID(ctx, buildingID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetByBuildingID(ctx, buildingID)
+}
+
+func (s *BuildingDistanceService) List(ctx context.Context) ([]*models.BuildingDistance, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *BuildingDistanceService) Delete(ctx context.Context, fromBuildingID, toBuildingID uuid.UUID) error {
+	return s.repo.Delete(ctx, fromBuildingID, toBuildingID)
+}
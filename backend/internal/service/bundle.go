@@ -0,0 +1,675 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidBundle is returned when a bundle can't be restored, e.g. because a record refers to
+// one that isn't in the bundle or its name is already taken
+var ErrInvalidBundle = errors.New("invalid bundle")
+
+var _ BundleServiceInterface = (*BundleService)(nil)
+
+type BundleServiceInterface interface {
+	Export(ctx context.Context) (*models.Bundle, error)
+	Restore(ctx context.Context, bundle *models.Bundle) (*models.BundleReport, error)
+}
+
+type BundleService struct {
+	roomTypeRepo          repository.RoomTypeRepositoryInterface
+	buildingRepo          repository.BuildingRepositoryInterface
+	buildingDistanceRepo  repository.BuildingDistanceRepositoryInterface
+	roomRepo              repository.RoomRepositoryInterface
+	roomBlackoutRepo      repository.RoomBlackoutRepositoryInterface
+	instructorRepo        repository.InstructorRepositoryInterface
+	courseRepo            repository.CourseRepositoryInterface
+	termRepo              repository.TermRepositoryInterface
+	courseSessionRepo     repository.CourseSessionRepositoryInterface
+	sessionConstraintRepo repository.SessionConstraintRepositoryInterface
+	cohortRepo            repository.CohortRepositoryInterface
+	scheduleRepo          repository.ScheduleRepositoryInterface
+}
+
+func NewBundleService(
+	roomTypeRepo repository.RoomTypeRepositoryInterface,
+	buildingRepo repository.BuildingRepositoryInterface,
+	buildingDistanceRepo repository.BuildingDistanceRepositoryInterface,
+	roomRepo repository.RoomRepositoryInterface,
+	roomBlackoutRepo repository.RoomBlackoutRepositoryInterface,
+	instructorRepo repository.InstructorRepositoryInterface,
+	courseRepo repository.CourseRepositoryInterface,
+	termRepo repository.TermRepositoryInterface,
+	courseSessionRepo repository.CourseSessionRepositoryInterface,
+	sessionConstraintRepo repository.SessionConstraintRepositoryInterface,
+	cohortRepo repository.CohortRepositoryInterface,
+	scheduleRepo repository.ScheduleRepositoryInterface,
+) *BundleService {
+	return &BundleService{
+		roomTypeRepo:          roomTypeRepo,
+		buildingRepo:          buildingRepo,
+		buildingDistanceRepo:  buildingDistanceRepo,
+		roomRepo:              roomRepo,
+		roomBlackoutRepo:      roomBlackoutRepo,
+		instructorRepo:        instructorRepo,
+		courseRepo:            courseRepo,
+		termRepo:              termRepo,
+		courseSessionRepo:     courseSessionRepo,
+		sessionConstraintRepo: sessionConstraintRepo,
+		cohortRepo:            cohortRepo,
+		scheduleRepo:          scheduleRepo,
+	}
+}
+
+// Export snapshots the user's records into a bundle
+func (s *BundleService) Export(ctx context.Context) (*models.Bundle, error) {
+	bundle := &models.Bundle{Version: models.BundleVersion, ExportedAt: time.Now().UTC()}
+
+	var err error
+	if bundle.RoomTypes, err = s.roomTypeRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list room types: %w", err)
+	}
+	if bundle.Buildings, err = s.buildingRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list buildings: %w", err)
+	}
+	if bundle.BuildingDistances, err = s.buildingDistanceRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list building distances: %w", err)
+	}
+	if bundle.Rooms, err = s.roomRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list rooms: %w", err)
+	}
+	if bundle.RoomBlackouts, err = s.roomBlackoutRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list room blackouts: %w", err)
+	}
+	if bundle.Instructors, err = s.instructorRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list instructors: %w", err)
+	}
+	if bundle.Courses, err = s.courseRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list courses: %w", err)
+	}
+	if bundle.Terms, err = s.termRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list terms: %w", err)
+	}
+	if bundle.CourseSessions, err = s.courseSessionRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list course sessions: %w", err)
+	}
+	if bundle.SessionConstraints, err = s.sessionConstraintRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list session constraints: %w", err)
+	}
+	if bundle.Cohorts, err = s.cohortRepo.List(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list cohorts: %w", err)
+	}
+
+	schedules, err := s.scheduleRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	archived, err := s.scheduleRepo.ListArchived(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived schedules: %w", err)
+	}
+	bundle.Schedules = append(schedules, archived...)
+
+	return bundle, nil
+}
+
+// Restore creates a copy of every record in the bundle for the current user. Each record gets a
+// new ID, so a bundle can be restored into any account, and references between records are
+// remapped to the new IDs. Room types the user already has are reused. Nothing is created if
+// any record is invalid, and the records are created in the caller's transaction so that a
+// failure part way through can be rolled back.
+func (s *BundleService) Restore(ctx context.Context, bundle *models.Bundle) (*models.BundleReport, error) {
+	if bundle.Version != models.BundleVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidBundle, bundle.Version)
+	}
+
+	plan, err := s.newRestorePlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := plan.remap(bundle); err != nil {
+		return nil, err
+	}
+
+	return s.create(ctx, plan)
+}
+
+// create saves the planned records, each after the records it refers to
+func (s *BundleService) create(ctx context.Context, plan *restorePlan) (*models.BundleReport, error) {
+	report := &models.BundleReport{Created: make(map[string]int)}
+	b := plan.bundle
+
+	for _, roomType := range b.RoomTypes {
+		if _, err := s.roomTypeRepo.Create(ctx, roomType); err != nil {
+			return nil, fmt.Errorf("failed to restore room type %q: %w", roomType.Name, err)
+		}
+		report.Created["room_types"]++
+	}
+
+	for i := range b.Buildings {
+		if _, err := s.buildingRepo.Create(ctx, &b.Buildings[i]); err != nil {
+			return nil, fmt.Errorf("failed to restore building %q: %w", b.Buildings[i].Name, err)
+		}
+		report.Created["buildings"]++
+	}
+
+	for _, distance := range b.BuildingDistances {
+		if _, err := s.buildingDistanceRepo.Set(ctx, distance); err != nil {
+			return nil, fmt.Errorf("failed to restore building distance: %w", err)
+		}
+		report.Created["building_distances"]++
+	}
+
+	for _, room := range b.Rooms {
+		if _, err := s.roomRepo.Create(ctx, room); err != nil {
+			return nil, fmt.Errorf("failed to restore room %q: %w", room.Name, err)
+		}
+		report.Created["rooms"]++
+	}
+
+	for _, blackout := range b.RoomBlackouts {
+		if _, err := s.roomBlackoutRepo.Create(ctx, blackout); err != nil {
+			return nil, fmt.Errorf("failed to restore room blackout: %w", err)
+		}
+		report.Created["room_blackouts"]++
+	}
+
+	for _, instructor := range b.Instructors {
+		if _, err := s.instructorRepo.Create(ctx, instructor); err != nil {
+			return nil, fmt.Errorf("failed to restore instructor %q: %w", instructor.Name, err)
+		}
+		report.Created["instructors"]++
+	}
+
+	for i := range b.Courses {
+		if _, err := s.courseRepo.Create(ctx, &b.Courses[i]); err != nil {
+			return nil, fmt.Errorf("failed to restore course %q: %w", b.Courses[i].Name, err)
+		}
+		report.Created["courses"]++
+	}
+
+	for _, term := range b.Terms {
+		if _, err := s.termRepo.Create(ctx, term); err != nil {
+			return nil, fmt.Errorf("failed to restore term %q: %w", term.Name, err)
+		}
+		report.Created["terms"]++
+	}
+
+	for _, session := range b.CourseSessions {
+		if _, err := s.courseSessionRepo.Create(ctx, session); err != nil {
+			return nil, fmt.Errorf("failed to restore course session: %w", err)
+		}
+		report.Created["course_sessions"]++
+	}
+
+	for _, constraint := range b.SessionConstraints {
+		if _, err := s.sessionConstraintRepo.Create(ctx, constraint); err != nil {
+			return nil, fmt.Errorf("failed to restore session constraint: %w", err)
+		}
+		report.Created["session_constraints"]++
+	}
+
+	for _, cohort := range b.Cohorts {
+		if _, err := s.cohortRepo.Create(ctx, cohort); err != nil {
+			return nil, fmt.Errorf("failed to restore cohort %q: %w", cohort.Programme, err)
+		}
+		report.Created["cohorts"]++
+	}
+
+	for _, schedule := range b.Schedules {
+		if _, err := s.scheduleRepo.Create(ctx, schedule); err != nil {
+			return nil, fmt.Errorf("failed to restore schedule %q: %w", schedule.Name, err)
+		}
+
+		// Create always saves an inactive schedule, so the flags are restored afterwards
+		switch {
+		case schedule.IsArchived:
+			if _, err := s.scheduleRepo.Archive(ctx, schedule.ID); err != nil {
+				return nil, fmt.Errorf("failed to archive schedule %q: %w", schedule.Name, err)
+			}
+		case schedule.IsActive:
+			if _, err := s.scheduleRepo.SetActive(ctx, schedule.ID); err != nil {
+				return nil, fmt.Errorf("failed to activate schedule %q: %w", schedule.Name, err)
+			}
+		}
+		report.Created["schedules"]++
+	}
+
+	return report, nil
+}
+
+// restorePlan remaps a bundle onto new IDs and checks it against the user's existing records
+type restorePlan struct {
+	// Names the user's records already have
+	roomTypes   map[string]bool
+	buildings   map[string]bool
+	instructors map[string]bool
+	courses     map[string]bool
+	terms       map[string]bool
+	cohorts     map[string]bool
+	schedules   map[string]bool // schedules without a term; restored terms are always new
+
+	// hasActive is whether the user has an active schedule without a term, which a restored one
+	// doesn't replace
+	hasActive bool
+
+	// New IDs of the bundle's records by their old IDs
+	buildingIDs   map[uuid.UUID]uuid.UUID
+	roomIDs       map[uuid.UUID]uuid.UUID
+	instructorIDs map[uuid.UUID]uuid.UUID
+	courseIDs     map[uuid.UUID]uuid.UUID
+	termIDs       map[uuid.UUID]uuid.UUID
+	sessionIDs    map[uuid.UUID]uuid.UUID
+
+	// bundle holds the records to create, with their new IDs
+	bundle models.Bundle
+}
+
+// newRestorePlan loads the names of existing records
+func (s *BundleService) newRestorePlan(ctx context.Context) (*restorePlan, error) {
+	plan := &restorePlan{
+		roomTypes:     make(map[string]bool),
+		buildings:     make(map[string]bool),
+		instructors:   make(map[string]bool),
+		courses:       make(map[string]bool),
+		terms:         make(map[string]bool),
+		cohorts:       make(map[string]bool),
+		schedules:     make(map[string]bool),
+		buildingIDs:   make(map[uuid.UUID]uuid.UUID),
+		roomIDs:       make(map[uuid.UUID]uuid.UUID),
+		instructorIDs: make(map[uuid.UUID]uuid.UUID),
+		courseIDs:     make(map[uuid.UUID]uuid.UUID),
+		termIDs:       make(map[uuid.UUID]uuid.UUID),
+		sessionIDs:    make(map[uuid.UUID]uuid.UUID),
+	}
+
+	roomTypes, err := s.roomTypeRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list room types: %w", err)
+	}
+	for _, roomType := range roomTypes {
+		plan.roomTypes[roomType.Name] = true
+	}
+
+	buildings, err := s.buildingRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list buildings: %w", err)
+	}
+	for _, building := range buildings {
+		plan.buildings[building.Name] = true
+	}
+
+	instructors, err := s.instructorRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instructors: %w", err)
+	}
+	for _, instructor := range instructors {
+		plan.instructors[instructor.Name] = true
+	}
+
+	courses, err := s.courseRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses: %w", err)
+	}
+	for _, course := range courses {
+		plan.courses[course.Name] = true
+	}
+
+	terms, err := s.termRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list terms: %w", err)
+	}
+	for _, term := range terms {
+		plan.terms[term.Name] = true
+	}
+
+	cohorts, err := s.cohortRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cohorts: %w", err)
+	}
+	for _, cohort := range cohorts {
+		plan.cohorts[cohortName(cohort)] = true
+	}
+
+	schedules, err := s.scheduleRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	archived, err := s.scheduleRepo.ListArchived(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived schedules: %w", err)
+	}
+	for _, schedule := range append(schedules, archived...) {
+		if schedule.TermID == nil {
+			plan.schedules[schedule.Name] = true
+		}
+	}
+
+	_, err = s.scheduleRepo.GetActive(ctx, nil)
+	switch {
+	case err == nil:
+		plan.hasActive = true
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, fmt.Errorf("failed to get active schedule: %w", err)
+	}
+
+	return plan, nil
+}
+
+// remap copies the bundle's records onto new IDs, validating each one
+func (p *restorePlan) remap(bundle *models.Bundle) error {
+	out := &p.bundle
+
+	for i, roomType := range bundle.RoomTypes {
+		if err := roomType.Validate(); err != nil {
+			return bundleError("room_types", i, err)
+		}
+		if p.roomTypes[roomType.Name] {
+			continue
+		}
+		p.roomTypes[roomType.Name] = true
+		out.RoomTypes = append(out.RoomTypes, &models.RoomType{Name: roomType.Name})
+	}
+
+	for i, building := range bundle.Buildings {
+		building.CreatedAt, building.UpdatedAt = nil, nil
+		if err := assignID(p.buildingIDs, &building.ID); err != nil {
+			return bundleError("buildings", i, err)
+		}
+		if err := p.checkRecord(&building, p.buildings, building.Name, "building"); err != nil {
+			return bundleError("buildings", i, err)
+		}
+		out.Buildings = append(out.Buildings, building)
+	}
+
+	for i, d := range bundle.BuildingDistances {
+		distance := *d
+		distance.CreatedAt, distance.UpdatedAt = nil, nil
+		if err := lookupIDs(p.buildingIDs, "building", &distance.FromBuildingID, &distance.ToBuildingID); err != nil {
+			return bundleError("building_distances", i, err)
+		}
+		if err := distance.Validate(); err != nil {
+			return bundleError("building_distances", i, err)
+		}
+		out.BuildingDistances = append(out.BuildingDistances, &distance)
+	}
+
+	for i, r := range bundle.Rooms {
+		room := *r
+		room.CreatedAt, room.UpdatedAt = nil, nil
+		if err := assignID(p.roomIDs, &room.ID); err != nil {
+			return bundleError("rooms", i, err)
+		}
+		if err := lookupIDs(p.buildingIDs, "building", &room.Building); err != nil {
+			return bundleError("rooms", i, err)
+		}
+		if err := room.Validate(); err != nil {
+			return bundleError("rooms", i, err)
+		}
+		if !p.roomTypes[room.Type] {
+			return bundleError("rooms", i, fmt.Errorf("room type %q is not in the bundle", room.Type))
+		}
+		out.Rooms = append(out.Rooms, &room)
+	}
+
+	for i, b := range bundle.RoomBlackouts {
+		blackout := *b
+		blackout.CreatedAt, blackout.UpdatedAt = nil, nil
+		blackout.ID = uuid.New()
+		if err := lookupIDs(p.roomIDs, "room", &blackout.RoomID); err != nil {
+			return bundleError("room_blackouts", i, err)
+		}
+		if err := blackout.Validate(); err != nil {
+			return bundleError("room_blackouts", i, err)
+		}
+		out.RoomBlackouts = append(out.RoomBlackouts, &blackout)
+	}
+
+	for i, in := range bundle.Instructors {
+		instructor := *in
+		instructor.CreatedAt, instructor.UpdatedAt = nil, nil
+		if err := assignID(p.instructorIDs, &instructor.ID); err != nil {
+			return bundleError("instructors", i, err)
+		}
+		if err := p.checkRecord(&instructor, p.instructors, instructor.Name, "instructor"); err != nil {
+			return bundleError("instructors", i, err)
+		}
+		out.Instructors = append(out.Instructors, &instructor)
+	}
+
+	for i, course := range bundle.Courses {
+		course.CreatedAt, course.UpdatedAt = nil, nil
+		if err := assignID(p.courseIDs, &course.ID); err != nil {
+			return bundleError("courses", i, err)
+		}
+		if err := p.checkRecord(&course, p.courses, course.Name, "course"); err != nil {
+			return bundleError("courses", i, err)
+		}
+		out.Courses = append(out.Courses, course)
+	}
+
+	for i, t := range bundle.Terms {
+		term := *t
+		term.CreatedAt, term.UpdatedAt = nil, nil
+		if err := assignID(p.termIDs, &term.ID); err != nil {
+			return bundleError("terms", i, err)
+		}
+		term.CourseIDs = append([]uuid.UUID(nil), t.CourseIDs...)
+		if err := lookupIDs(p.courseIDs, "course", pointers(term.CourseIDs)...); err != nil {
+			return bundleError("terms", i, err)
+		}
+		if err := p.checkRecord(&term, p.terms, term.Name, "term"); err != nil {
+			return bundleError("terms", i, err)
+		}
+		out.Terms = append(out.Terms, &term)
+	}
+
+	for i, cs := range bundle.CourseSessions {
+		session := *cs
+		session.CreatedAt, session.UpdatedAt = nil, nil
+		if err := assignID(p.sessionIDs, &session.ID); err != nil {
+			return bundleError("course_sessions", i, err)
+		}
+		if err := lookupIDs(p.courseIDs, "course", &session.CourseID); err != nil {
+			return bundleError("course_sessions", i, err)
+		}
+		if err := lookupOptionalID(p.instructorIDs, "instructor", &session.InstructorID); err != nil {
+			return bundleError("course_sessions", i, err)
+		}
+		if err := lookupOptionalID(p.termIDs, "term", &session.TermID); err != nil {
+			return bundleError("course_sessions", i, err)
+		}
+		if err := session.Validate(); err != nil {
+			return bundleError("course_sessions", i, err)
+		}
+		if !p.roomTypes[session.RequiredRoom] {
+			return bundleError("course_sessions", i, fmt.Errorf("room type %q is not in the bundle", session.RequiredRoom))
+		}
+		out.CourseSessions = append(out.CourseSessions, &session)
+	}
+
+	for i, c := range bundle.SessionConstraints {
+		constraint := *c
+		constraint.CreatedAt, constraint.UpdatedAt = nil, nil
+		constraint.ID = uuid.New()
+		if err := lookupIDs(p.courseIDs, "course", &constraint.CourseID); err != nil {
+			return bundleError("session_constraints", i, err)
+		}
+		if err := constraint.Validate(); err != nil {
+			return bundleError("session_constraints", i, err)
+		}
+		out.SessionConstraints = append(out.SessionConstraints, &constraint)
+	}
+
+	for i, c := range bundle.Cohorts {
+		cohort := *c
+		cohort.CreatedAt, cohort.UpdatedAt = nil, nil
+		cohort.ID = uuid.New()
+		cohort.CourseIDs = append([]uuid.UUID(nil), c.CourseIDs...)
+		if err := lookupIDs(p.courseIDs, "course", pointers(cohort.CourseIDs)...); err != nil {
+			return bundleError("cohorts", i, err)
+		}
+		if err := p.checkRecord(&cohort, p.cohorts, cohortName(&cohort), "cohort"); err != nil {
+			return bundleError("cohorts", i, err)
+		}
+		out.Cohorts = append(out.Cohorts, &cohort)
+	}
+
+	for i, sch := range bundle.Schedules {
+		schedule, err := p.remapSchedule(sch)
+		if err != nil {
+			return bundleError("schedules", i, err)
+		}
+		out.Schedules = append(out.Schedules, schedule)
+	}
+
+	return nil
+}
+
+// remapSchedule copies a schedule onto new IDs. Schedules keep what was scheduled after a
+// course, room or instructor is deleted, so references to records that aren't in the bundle are
+// kept as they are rather than rejected.
+func (p *restorePlan) remapSchedule(s *models.Schedule) (*models.Schedule, error) {
+	schedule := *s
+	schedule.ID = uuid.New()
+	schedule.CreatedAt = nil
+	schedule.Score = nil
+
+	if err := lookupOptionalID(p.termIDs, "term", &schedule.TermID); err != nil {
+		return nil, err
+	}
+
+	schedule.Sessions = make([]models.ScheduledSession, len(s.Sessions))
+	for i, session := range s.Sessions {
+		session.CourseID = remapID(p.courseIDs, session.CourseID)
+		session.CourseSessionID = remapOptionalID(p.sessionIDs, session.CourseSessionID)
+		session.RoomID = remapID(p.roomIDs, session.RoomID)
+		session.InstructorID = remapOptionalID(p.instructorIDs, session.InstructorID)
+		schedule.Sessions[i] = session
+	}
+
+	if s.Pins != nil {
+		schedule.Pins = make([]models.SessionPin, len(s.Pins))
+		for i, pin := range s.Pins {
+			pin.CourseSessionID = remapID(p.sessionIDs, pin.CourseSessionID)
+			pin.RoomID = remapOptionalID(p.roomIDs, pin.RoomID)
+			if pin.SectionRooms != nil {
+				rooms := make([]uuid.UUID, len(pin.SectionRooms))
+				for j, room := range pin.SectionRooms {
+					rooms[j] = remapID(p.roomIDs, room)
+				}
+				pin.SectionRooms = rooms
+			}
+			schedule.Pins[i] = pin
+		}
+	}
+
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	if schedule.TermID == nil {
+		if p.schedules[schedule.Name] {
+			return nil, fmt.Errorf("schedule %q already exists", schedule.Name)
+		}
+		p.schedules[schedule.Name] = true
+
+		if schedule.IsActive && !schedule.IsArchived {
+			if p.hasActive {
+				schedule.IsActive = false
+			}
+			p.hasActive = true
+		}
+	}
+
+	return &schedule, nil
+}
+
+// checkRecord validates a record and claims its name, which must not be taken
+func (p *restorePlan) checkRecord(record interface{ Validate() error }, names map[string]bool, name, kind string) error {
+	if err := record.Validate(); err != nil {
+		return err
+	}
+	if names[name] {
+		return fmt.Errorf("%s %q already exists", kind, name)
+	}
+	names[name] = true
+	return nil
+}
+
+// cohortName identifies a cohort; a programme has one cohort per year
+func cohortName(cohort *models.Cohort) string {
+	return fmt.Sprintf("%s year %d", cohort.Programme, cohort.Year)
+}
+
+// bundleError reports a problem with a record of the bundle
+func bundleError(field string, index int, err error) error {
+	return fmt.Errorf("%w: %s[%d]: %v", ErrInvalidBundle, field, index, err)
+}
+
+// assignID gives a record a new ID, remembering its old one. IDs must be unique in the bundle.
+func assignID(ids map[uuid.UUID]uuid.UUID, id *uuid.UUID) error {
+	if _, ok := ids[*id]; ok {
+		return fmt.Errorf("duplicate id %s", *id)
+	}
+	newID := uuid.New()
+	ids[*id] = newID
+	*id = newID
+	return nil
+}
+
+// lookupIDs replaces references with the new IDs of the records they refer to
+func lookupIDs(ids map[uuid.UUID]uuid.UUID, kind string, refs ...*uuid.UUID) error {
+	for _, ref := range refs {
+		newID, ok := ids[*ref]
+		if !ok {
+			return fmt.Errorf("%s %s is not in the bundle", kind, *ref)
+		}
+		*ref = newID
+	}
+	return nil
+}
+
+// lookupOptionalID replaces an optional reference with the new ID of the record it refers to
+func lookupOptionalID(ids map[uuid.UUID]uuid.UUID, kind string, ref **uuid.UUID) error {
+	if *ref == nil {
+		return nil
+	}
+	id := **ref
+	if err := lookupIDs(ids, kind, &id); err != nil {
+		return err
+	}
+	*ref = &id
+	return nil
+}
+
+// remapID returns the new ID of a record in the bundle, or the ID itself for other records
+func remapID(ids map[uuid.UUID]uuid.UUID, id uuid.UUID) uuid.UUID {
+	if newID, ok := ids[id]; ok {
+		return newID
+	}
+	return id
+}
+
+func remapOptionalID(ids map[uuid.UUID]uuid.UUID, id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	newID := remapID(ids, *id)
+	return &newID
+}
+
+func pointers(ids []uuid.UUID) []*uuid.UUID {
+	refs := make([]*uuid.UUID, len(ids))
+	for i := range ids {
+		refs[i] = &ids[i]
+	}
+	return refs
+}
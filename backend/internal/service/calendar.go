@@ -0,0 +1,447 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/ical"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/google/uuid"
+)
+
+// MaxOccurrenceRangeDays limits how many days one request for occurrences may cover
+const MaxOccurrenceRangeDays = 366
+
+// ErrInvalidDateRange is returned when the dates to expand a schedule over are missing or invalid
+var ErrInvalidDateRange = errors.New("invalid date range")
+
+var _ CalendarServiceInterface = (*CalendarService)(nil)
+
+type CalendarServiceInterface interface {
+	Occurrences(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) ([]models.Occurrence, error)
+	ICal(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) (*ical.Calendar, error)
+}
+
+// CalendarService turns the weekly sessions of a schedule into dated occurrences
+type CalendarService struct {
+	nameRepos
+	scheduleRepo repository.ScheduleRepositoryInterface
+	termRepo     repository.TermRepositoryInterface
+}
+
+func NewCalendarService(
+	scheduleRepo repository.ScheduleRepositoryInterface,
+	termRepo repository.TermRepositoryInterface,
+	roomRepo repository.RoomRepositoryInterface,
+	buildingRepo repository.BuildingRepositoryInterface,
+	courseRepo repository.CourseRepositoryInterface,
+	courseSessionRepo repository.CourseSessionRepositoryInterface,
+	instructorRepo repository.InstructorRepositoryInterface,
+) *CalendarService {
+	return &CalendarService{
+		nameRepos: nameRepos{
+			roomRepo:          roomRepo,
+			buildingRepo:      buildingRepo,
+			courseRepo:        courseRepo,
+			courseSessionRepo: courseSessionRepo,
+			instructorRepo:    instructorRepo,
+		},
+		scheduleRepo: scheduleRepo,
+		termRepo:     termRepo,
+	}
+}
+
+// Occurrences expands a schedule into the dated occurrences between filter.From and filter.To.
+// A schedule with a term only meets in the term's teaching weeks and never on its holidays;
+// weeks where every day the schedule meets on is a holiday, such as a reading week, don't
+// count as teaching weeks. A schedule without a term meets every week and needs both dates.
+func (s *CalendarService) Occurrences(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) ([]models.Occurrence, error) {
+	expanded, err := s.expand(ctx, scheduleID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := []models.Occurrence{}
+	for _, day := range expanded.days {
+		for _, i := range expanded.byDay[weekday(day.date)] {
+			session := &expanded.schedule.Sessions[i]
+			occurrences = append(occurrences, models.Occurrence{
+				Date:            day.date.Format(time.DateOnly),
+				Week:            day.week,
+				CourseID:        session.CourseID,
+				CourseSessionID: session.CourseSessionID,
+				RoomID:          session.RoomID,
+				InstructorID:    session.InstructorID,
+				StartTime:       session.StartTime,
+				EndTime:         session.EndTime,
+				Block:           session.Block,
+				Section:         session.Section,
+			})
+		}
+	}
+
+	return occurrences, nil
+}
+
+// ICal exports the same occurrences as Occurrences as an iCalendar with one weekly recurring
+// event per scheduled session. Weeks the session doesn't meet in are excluded with EXDATE.
+func (s *CalendarService) ICal(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) (*ical.Calendar, error) {
+	expanded, err := s.expand(ctx, scheduleID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.loadNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := &ical.Calendar{Name: expanded.schedule.Name, Events: []ical.Event{}}
+	stamp := time.Now()
+
+	for day, sessions := range expanded.byDay {
+		var dates []time.Time
+		for _, d := range expanded.days {
+			if weekday(d.date) == day {
+				dates = append(dates, d.date)
+			}
+		}
+		if len(dates) == 0 {
+			continue
+		}
+
+		for _, i := range sessions {
+			session := &expanded.schedule.Sessions[i]
+			event := ical.Event{
+				UID:         fmt.Sprintf("%s-%d@course-scheduler", expanded.schedule.ID, i),
+				Stamp:       stamp,
+				Summary:     names.summary(session),
+				Location:    names.location(session.RoomID),
+				Description: names.description(session),
+				Start:       atMinute(dates[0], session.StartTime),
+				End:         atMinute(dates[0], session.EndTime),
+			}
+
+			if len(dates) > 1 {
+				last := dates[len(dates)-1]
+				event.Until = last
+				next := 1
+				for date := dates[0].AddDate(0, 0, 7); date.Before(last); date = date.AddDate(0, 0, 7) {
+					if dates[next].Equal(date) {
+						next++
+						continue
+					}
+					event.ExDates = append(event.ExDates, atMinute(date, session.StartTime))
+				}
+			}
+
+			calendar.Events = append(calendar.Events, event)
+		}
+	}
+
+	return calendar, nil
+}
+
+// expansion is a schedule's filtered sessions with the teaching days to repeat them on
+type expansion struct {
+	schedule *models.Schedule
+	days     []teachingDay
+	byDay    [][]int // indexes of the matching sessions on each day of the week, in the order they start
+}
+
+// expand loads a schedule and its term and works out the days between the filter's dates
+// that the schedule meets on
+func (s *CalendarService) expand(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) (*expansion, error) {
+	schedule, err := s.scheduleRepo.GetByID(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	var term *models.Term
+	if schedule.TermID != nil {
+		term, err = s.termRepo.GetByID(ctx, *schedule.TermID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get term: %w", err)
+		}
+	}
+
+	from, to, err := occurrenceRange(term, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rooms are only needed to filter by building
+	var inBuilding map[uuid.UUID]bool
+	if filter.BuildingID != nil {
+		rooms, err := s.roomRepo.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list rooms: %w", err)
+		}
+		inBuilding = make(map[uuid.UUID]bool)
+		for _, room := range rooms {
+			if room.Building == *filter.BuildingID {
+				inBuilding[room.ID] = true
+			}
+		}
+	}
+
+	// Group the weekly sessions by day, in the order they start. The days the schedule meets on
+	// decide which weeks teach, so they don't depend on the filter.
+	var meetsOn [7]bool
+	byDay := make([][]int, 7)
+	for i := range schedule.Sessions {
+		session := &schedule.Sessions[i]
+		meetsOn[session.Day] = true
+		if filter.Matches(session) && (inBuilding == nil || inBuilding[session.RoomID]) {
+			byDay[session.Day] = append(byDay[session.Day], i)
+		}
+	}
+	for _, sessions := range byDay {
+		sort.SliceStable(sessions, func(i, j int) bool {
+			return schedule.Sessions[sessions[i]].StartTime < schedule.Sessions[sessions[j]].StartTime
+		})
+	}
+
+	return &expansion{
+		schedule: schedule,
+		days:     teachingDays(term, meetsOn, from, to),
+		byDay:    byDay,
+	}, nil
+}
+
+// calendarNames holds the names used to describe scheduled sessions in a calendar
+type calendarNames struct {
+	courses        map[uuid.UUID]string
+	courseSessions map[uuid.UUID]*models.CourseSession
+	rooms          map[uuid.UUID]*models.Room
+	buildings      map[uuid.UUID]string
+	instructors    map[uuid.UUID]string
+}
+
+// nameRepos are the repositories the names of scheduled sessions are looked up in
+type nameRepos struct {
+	roomRepo          repository.RoomRepositoryInterface
+	buildingRepo      repository.BuildingRepositoryInterface
+	courseRepo        repository.CourseRepositoryInterface
+	courseSessionRepo repository.CourseSessionRepositoryInterface
+	instructorRepo    repository.InstructorRepositoryInterface
+}
+
+func (s *nameRepos) loadNames(ctx context.Context) (*calendarNames, error) {
+	names := &calendarNames{
+		courses:        make(map[uuid.UUID]string),
+		courseSessions: make(map[uuid.UUID]*models.CourseSession),
+		rooms:          make(map[uuid.UUID]*models.Room),
+		buildings:      make(map[uuid.UUID]string),
+		instructors:    make(map[uuid.UUID]string),
+	}
+
+	courses, err := s.courseRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses: %w", err)
+	}
+	for _, course := range courses {
+		names.courses[course.ID] = course.Name
+	}
+
+	courseSessions, err := s.courseSessionRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list course sessions: %w", err)
+	}
+	for _, cs := range courseSessions {
+		names.courseSessions[cs.ID] = cs
+	}
+
+	rooms, err := s.roomRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rooms: %w", err)
+	}
+	for _, room := range rooms {
+		names.rooms[room.ID] = room
+	}
+
+	buildings, err := s.buildingRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list buildings: %w", err)
+	}
+	for _, building := range buildings {
+		names.buildings[building.ID] = building.Name
+	}
+
+	instructors, err := s.instructorRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instructors: %w", err)
+	}
+	for _, instructor := range instructors {
+		names.instructors[instructor.ID] = instructor.Name
+	}
+
+	return names, nil
+}
+
+// summary names the course and session type, e.g. "Math 101 Lecture"
+func (n *calendarNames) summary(session *models.ScheduledSession) string {
+	summary, ok := n.courses[session.CourseID]
+	if !ok {
+		summary = "Course"
+	}
+
+	if session.CourseSessionID != nil {
+		if cs, ok := n.courseSessions[*session.CourseSessionID]; ok && cs.Type != "" {
+			summary += " " + strings.ToUpper(cs.Type[:1]) + cs.Type[1:]
+		}
+	}
+
+	return summary
+}
+
+// location names the room and its building, e.g. "Room 101, Science Building"
+func (n *calendarNames) location(roomID uuid.UUID) string {
+	room, ok := n.rooms[roomID]
+	if !ok {
+		return ""
+	}
+
+	if building, ok := n.buildings[room.Building]; ok {
+		return room.Name + ", " + building
+	}
+	return room.Name
+}
+
+// description names the instructor and, for parallel sections, the section
+func (n *calendarNames) description(session *models.ScheduledSession) string {
+	var lines []string
+
+	if session.InstructorID != nil {
+		if instructor, ok := n.instructors[*session.InstructorID]; ok {
+			lines = append(lines, "Instructor: "+instructor)
+		}
+	}
+
+	if session.CourseSessionID != nil {
+		if cs, ok := n.courseSessions[*session.CourseSessionID]; ok && cs.ParallelSections != nil && *cs.ParallelSections > 1 {
+			lines = append(lines, fmt.Sprintf("Section %d of %d", session.Section+1, *cs.ParallelSections))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// occurrenceRange parses the filter's dates, defaulting them to the term's
+func occurrenceRange(term *models.Term, filter models.OccurrenceFilter) (time.Time, time.Time, error) {
+	if term != nil {
+		if filter.From == "" {
+			filter.From = term.StartDate
+		}
+		if filter.To == "" {
+			filter.To = term.EndDate
+		}
+	}
+
+	if filter.From == "" || filter.To == "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: from and to are required for a schedule without a term", ErrInvalidDateRange)
+	}
+
+	from, err := time.Parse(time.DateOnly, filter.From)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: from must be formatted as YYYY-MM-DD", ErrInvalidDateRange)
+	}
+
+	to, err := time.Parse(time.DateOnly, filter.To)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: to must be formatted as YYYY-MM-DD", ErrInvalidDateRange)
+	}
+
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: to must not be before from", ErrInvalidDateRange)
+	}
+
+	if to.Sub(from) >= MaxOccurrenceRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: a range may cover at most %d days", ErrInvalidDateRange, MaxOccurrenceRangeDays)
+	}
+
+	return from, to, nil
+}
+
+// teachingDay is a date that sessions meet on, with its teaching week
+type teachingDay struct {
+	date time.Time
+	week int
+}
+
+// teachingDays lists the days between from and to that sessions meet on. Without a term every
+// day counts. With one, only days in the term's teaching weeks that aren't holidays count.
+func teachingDays(term *models.Term, meetsOn [7]bool, from, to time.Time) []teachingDay {
+	var days []teachingDay
+
+	if term == nil {
+		for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+			days = append(days, teachingDay{date: date})
+		}
+		return days
+	}
+
+	// Term dates are validated when the term is saved
+	termStart, _ := time.Parse(time.DateOnly, term.StartDate)
+	termEnd, _ := time.Parse(time.DateOnly, term.EndDate)
+
+	// Schedules don't keep the config they were generated with, so the term operates on the
+	// default operating days and any other day the schedule meets on
+	operating := meetsOn
+	for _, day := range scheduler.DefaultConfig().OperatingDays {
+		operating[day] = true
+	}
+
+	// Walk the term a calendar week (Monday to Sunday) at a time, counting the weeks that teach
+	week := 0
+	for weekStart := termStart.AddDate(0, 0, -weekday(termStart)); !weekStart.After(termEnd); weekStart = weekStart.AddDate(0, 0, 7) {
+		if week >= int(term.TeachingWeeks) || weekStart.After(to) {
+			break
+		}
+
+		teaches := false
+		var open []time.Time
+		for date := weekStart; date.Before(weekStart.AddDate(0, 0, 7)); date = date.AddDate(0, 0, 1) {
+			if date.Before(termStart) || date.After(termEnd) || term.IsHoliday(date.Format(time.DateOnly)) {
+				continue
+			}
+			if operating[weekday(date)] {
+				teaches = true
+			}
+			if meetsOn[weekday(date)] {
+				open = append(open, date)
+			}
+		}
+
+		// A week whose operating days are all holidays, e.g. a reading week, isn't a teaching week.
+		// Otherwise it counts, even if the schedule's own days that week are holidays.
+		if !teaches {
+			continue
+		}
+		week++
+
+		for _, date := range open {
+			if !date.Before(from) && !date.After(to) {
+				days = append(days, teachingDay{date: date, week: week})
+			}
+		}
+	}
+
+	return days
+}
+
+// atMinute returns the date at a number of minutes from midnight
+func atMinute(date time.Time, minutes int) time.Time {
+	return date.Add(time.Duration(minutes) * time.Minute)
+}
+
+// weekday returns the day of the week with 0 = Monday, as used by scheduled sessions
+func weekday(date time.Time) int {
+	return (int(date.Weekday()) + 6) % 7
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/ical"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// calendarFeedTokenBytes is how many random bytes make up a share token
+	calendarFeedTokenBytes = 32
+
+	// A schedule without a term has no dates of its own, so its feed covers a window around today
+	calendarFeedDaysBefore = 28
+	calendarFeedDaysAfter  = 182
+)
+
+var _ CalendarFeedServiceInterface = (*CalendarFeedService)(nil)
+
+type CalendarFeedServiceInterface interface {
+	Create(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.CalendarFeed, error)
+	List(ctx context.Context) ([]*models.CalendarFeed, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Owner(ctx context.Context, token string) (uuid.UUID, error)
+	ICal(ctx context.Context, token string) (*ical.Calendar, error)
+}
+
+type CalendarFeedService struct {
+	repo         repository.CalendarFeedRepositoryInterface
+	scheduleRepo repository.ScheduleRepositoryInterface
+	calendar     CalendarServiceInterface
+}
+
+func NewCalendarFeedService(
+	repo repository.CalendarFeedRepositoryInterface,
+	scheduleRepo repository.ScheduleRepositoryInterface,
+	calendar CalendarServiceInterface,
+) *CalendarFeedService {
+	return &CalendarFeedService{
+		repo:         repo,
+		scheduleRepo: scheduleRepo,
+		calendar:     calendar,
+	}
+}
+
+// Create saves the feed with a new random share token, replacing any token it was given
+func (s *CalendarFeedService) Create(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+	token, err := newCalendarFeedToken()
+	if err != nil {
+		return nil, err
+	}
+	feed.Token = token
+
+	return s.repo.Create(ctx, feed)
+}
+
+func (s *CalendarFeedService) GetByID(ctx context.Context, id uuid.UUID) (*models.CalendarFeed, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *CalendarFeedService) List(ctx context.Context) ([]*models.CalendarFeed, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *CalendarFeedService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// Owner returns the user who shared the feed with the token
+func (s *CalendarFeedService) Owner(ctx context.Context, token string) (uuid.UUID, error) {
+	return s.repo.GetOwner(ctx, token)
+}
+
+// ICal exports the schedule that is active for the feed's term right now, narrowed by the feed's
+// filters. Without an active schedule the calendar is empty, so clients keep polling.
+// A schedule without a term is shown from four weeks ago to six months ahead.
+func (s *CalendarFeedService) ICal(ctx context.Context, token string) (*ical.Calendar, error) {
+	feed, err := s.repo.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.scheduleRepo.GetActive(ctx, feed.TermID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return &ical.Calendar{Name: feed.Name, Events: []ical.Event{}}, nil
+		}
+		return nil, fmt.Errorf("failed to get active schedule: %w", err)
+	}
+
+	filter := feed.Filter()
+	if active.TermID == nil {
+		today := time.Now()
+		filter.From = today.AddDate(0, 0, -calendarFeedDaysBefore).Format(time.DateOnly)
+		filter.To = today.AddDate(0, 0, calendarFeedDaysAfter).Format(time.DateOnly)
+	}
+
+	calendar, err := s.calendar.ICal(ctx, active.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+	calendar.Name = feed.Name
+
+	return calendar, nil
+}
+
+// newCalendarFeedToken returns an unguessable URL-safe token
+func newCalendarFeedToken() (string, error) {
+	b := make([]byte, calendarFeedTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
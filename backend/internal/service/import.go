@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidImport is returned when an import that isn't a dry run has rows with errors
+var ErrInvalidImport = errors.New("import has invalid rows")
+
+// importColumns are the CSV columns of each entity. Rows refer to other records by name.
+var importColumns = map[models.ImportEntity]struct{ required, optional []string }{
+	models.ImportBuildings: {
+		required: []string{"name"},
+	},
+	models.ImportRooms: {
+		required: []string{"name", "type", "building", "capacity"},
+	},
+	models.ImportCourses: {
+		required: []string{"name"},
+		optional: []string{"expected_enrollment"},
+	},
+	models.ImportSessions: {
+		required: []string{"course", "type", "required_room", "duration", "number_of_sessions"},
+		optional: []string{"instructor", "expected_enrollment", "blocks", "parallel_sections", "term"},
+	},
+}
+
+var _ ImportServiceInterface = (*ImportService)(nil)
+
+type ImportServiceInterface interface {
+	Import(ctx context.Context, files map[models.ImportEntity]io.Reader, dryRun bool) (*models.ImportReport, error)
+}
+
+type ImportService struct {
+	buildingRepo      repository.BuildingRepositoryInterface
+	roomTypeRepo      repository.RoomTypeRepositoryInterface
+	roomRepo          repository.RoomRepositoryInterface
+	courseRepo        repository.CourseRepositoryInterface
+	courseSessionRepo repository.CourseSessionRepositoryInterface
+	instructorRepo    repository.InstructorRepositoryInterface
+	termRepo          repository.TermRepositoryInterface
+}
+
+func NewImportService(
+	buildingRepo repository.BuildingRepositoryInterface,
+	roomTypeRepo repository.RoomTypeRepositoryInterface,
+	roomRepo repository.RoomRepositoryInterface,
+	courseRepo repository.CourseRepositoryInterface,
+	courseSessionRepo repository.CourseSessionRepositoryInterface,
+	instructorRepo repository.InstructorRepositoryInterface,
+	termRepo repository.TermRepositoryInterface,
+) *ImportService {
+	return &ImportService{
+		buildingRepo:      buildingRepo,
+		roomTypeRepo:      roomTypeRepo,
+		roomRepo:          roomRepo,
+		courseRepo:        courseRepo,
+		courseSessionRepo: courseSessionRepo,
+		instructorRepo:    instructorRepo,
+		termRepo:          termRepo,
+	}
+}
+
+// Import reads a CSV file per entity and validates every row, resolving names against existing
+// records and rows imported before it. A dry run only reports. Otherwise nothing is created
+// unless every row is valid, and the records are created in the caller's transaction so that
+// a failure part way through can be rolled back.
+func (s *ImportService) Import(ctx context.Context, files map[models.ImportEntity]io.Reader, dryRun bool) (*models.ImportReport, error) {
+	report := &models.ImportReport{
+		DryRun:  dryRun,
+		Rows:    make(map[models.ImportEntity]int),
+		Created: make(map[models.ImportEntity]int),
+		Errors:  []models.ImportRowError{},
+	}
+
+	plan, err := s.newImportPlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entity := range models.ImportEntities {
+		file, ok := files[entity]
+		if !ok {
+			continue
+		}
+
+		rows, err := readImportCSV(file, entity)
+		if err != nil {
+			report.Errors = append(report.Errors, models.ImportRowError{Entity: entity, Message: err.Error()})
+			continue
+		}
+		report.Rows[entity] = len(rows)
+
+		for _, row := range rows {
+			if row.err == nil {
+				row.err = plan.add(entity, row.values)
+			}
+			if row.err != nil {
+				report.Errors = append(report.Errors, models.ImportRowError{Entity: entity, Row: row.line, Message: row.err.Error()})
+			}
+		}
+	}
+
+	if len(report.Errors) > 0 && !dryRun {
+		return report, ErrInvalidImport
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	if err := s.create(ctx, plan, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// create saves the planned records in import order
+func (s *ImportService) create(ctx context.Context, plan *importPlan, report *models.ImportReport) error {
+	for _, building := range plan.newBuildings {
+		if _, err := s.buildingRepo.Create(ctx, building); err != nil {
+			return fmt.Errorf("failed to import building %q: %w", building.Name, err)
+		}
+		report.Created[models.ImportBuildings]++
+	}
+
+	for _, room := range plan.newRooms {
+		if _, err := s.roomRepo.Create(ctx, room); err != nil {
+			return fmt.Errorf("failed to import room %q: %w", room.Name, err)
+		}
+		report.Created[models.ImportRooms]++
+	}
+
+	for _, course := range plan.newCourses {
+		if _, err := s.courseRepo.Create(ctx, course); err != nil {
+			return fmt.Errorf("failed to import course %q: %w", course.Name, err)
+		}
+		report.Created[models.ImportCourses]++
+	}
+
+	for _, session := range plan.newSessions {
+		if _, err := s.courseSessionRepo.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to import %s session: %w", session.Type, err)
+		}
+		report.Created[models.ImportSessions]++
+	}
+
+	return nil
+}
+
+// importPlan resolves names to IDs and collects the records an import will create
+type importPlan struct {
+	buildings   map[string]uuid.UUID
+	roomTypes   map[string]bool
+	rooms       map[roomKey]bool
+	courses     map[string]uuid.UUID
+	sessions    map[sessionKey]bool
+	instructors map[string]uuid.UUID
+	terms       map[string]uuid.UUID
+
+	newBuildings []*models.Building
+	newRooms     []*models.Room
+	newCourses   []*models.Course
+	newSessions  []*models.CourseSession
+}
+
+// roomKey identifies a room; room names are unique within a building
+type roomKey struct {
+	name     string
+	building uuid.UUID
+}
+
+// sessionKey identifies a course session; a course has one session of each type per term
+type sessionKey struct {
+	course      uuid.UUID
+	sessionType string
+	term        uuid.UUID // uuid.Nil for every term
+}
+
+// newImportPlan loads the names of existing records
+func (s *ImportService) newImportPlan(ctx context.Context) (*importPlan, error) {
+	plan := &importPlan{
+		buildings:   make(map[string]uuid.UUID),
+		roomTypes:   make(map[string]bool),
+		rooms:       make(map[roomKey]bool),
+		courses:     make(map[string]uuid.UUID),
+		sessions:    make(map[sessionKey]bool),
+		instructors: make(map[string]uuid.UUID),
+		terms:       make(map[string]uuid.UUID),
+	}
+
+	buildings, err := s.buildingRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list buildings: %w", err)
+	}
+	for _, building := range buildings {
+		plan.buildings[building.Name] = building.ID
+	}
+
+	roomTypes, err := s.roomTypeRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list room types: %w", err)
+	}
+	for _, roomType := range roomTypes {
+		plan.roomTypes[roomType.Name] = true
+	}
+
+	rooms, err := s.roomRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rooms: %w", err)
+	}
+	for _, room := range rooms {
+		plan.rooms[roomKey{room.Name, room.Building}] = true
+	}
+
+	courses, err := s.courseRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses: %w", err)
+	}
+	for _, course := range courses {
+		plan.courses[course.Name] = course.ID
+	}
+
+	sessions, err := s.courseSessionRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list course sessions: %w", err)
+	}
+	for _, session := range sessions {
+		plan.sessions[newSessionKey(session)] = true
+	}
+
+	instructors, err := s.instructorRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instructors: %w", err)
+	}
+	for _, instructor := range instructors {
+		plan.instructors[instructor.Name] = instructor.ID
+	}
+
+	terms, err := s.termRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list terms: %w", err)
+	}
+	for _, term := range terms {
+		plan.terms[term.Name] = term.ID
+	}
+
+	return plan, nil
+}
+
+// add validates a row and plans the record it creates
+func (p *importPlan) add(entity models.ImportEntity, row map[string]string) error {
+	switch entity {
+	case models.ImportBuildings:
+		return p.addBuilding(row)
+	case models.ImportRooms:
+		return p.addRoom(row)
+	case models.ImportCourses:
+		return p.addCourse(row)
+	case models.ImportSessions:
+		return p.addSession(row)
+	}
+	return fmt.Errorf("unknown entity %q", entity)
+}
+
+func (p *importPlan) addBuilding(row map[string]string) error {
+	building := models.NewBuilding(uuid.New(), row["name"], nil, nil)
+	if err := building.Validate(); err != nil {
+		return err
+	}
+
+	if _, ok := p.buildings[building.Name]; ok {
+		return fmt.Errorf("building %q already exists", building.Name)
+	}
+
+	p.buildings[building.Name] = building.ID
+	p.newBuildings = append(p.newBuildings, building)
+	return nil
+}
+
+func (p *importPlan) addRoom(row map[string]string) error {
+	buildingID, ok := p.buildings[row["building"]]
+	if !ok {
+		return fmt.Errorf("building %q not found", row["building"])
+	}
+
+	capacity, err := parseImportInt(row, "capacity")
+	if err != nil {
+		return err
+	}
+	if capacity == nil {
+		return errors.New("capacity is required")
+	}
+
+	room := models.NewRoom(uuid.New(), row["name"], row["type"], buildingID, *capacity, nil, nil)
+	if err := room.Validate(); err != nil {
+		return err
+	}
+
+	if !p.roomTypes[room.Type] {
+		return fmt.Errorf("room type %q not found", room.Type)
+	}
+
+	key := roomKey{room.Name, buildingID}
+	if p.rooms[key] {
+		return fmt.Errorf("room %q already exists in building %q", room.Name, row["building"])
+	}
+
+	p.rooms[key] = true
+	p.newRooms = append(p.newRooms, room)
+	return nil
+}
+
+func (p *importPlan) addCourse(row map[string]string) error {
+	course := models.NewCourse(uuid.New(), row["name"], nil, nil)
+
+	enrollment, err := parseImportInt(row, "expected_enrollment")
+	if err != nil {
+		return err
+	}
+	course.ExpectedEnrollment = enrollment
+
+	if err := course.Validate(); err != nil {
+		return err
+	}
+
+	if _, ok := p.courses[course.Name]; ok {
+		return fmt.Errorf("course %q already exists", course.Name)
+	}
+
+	p.courses[course.Name] = course.ID
+	p.newCourses = append(p.newCourses, course)
+	return nil
+}
+
+func (p *importPlan) addSession(row map[string]string) error {
+	courseID, ok := p.courses[row["course"]]
+	if !ok {
+		return fmt.Errorf("course %q not found", row["course"])
+	}
+
+	session := models.NewCourseSession(uuid.New(), courseID, row["required_room"], row["type"], nil, nil, nil, nil)
+
+	for _, column := range []struct {
+		name string
+		dest **int32
+	}{
+		{"duration", &session.Duration},
+		{"number_of_sessions", &session.NumberOfSessions},
+		{"expected_enrollment", &session.ExpectedEnrollment},
+		{"blocks", &session.Blocks},
+		{"parallel_sections", &session.ParallelSections},
+	} {
+		value, err := parseImportInt(row, column.name)
+		if err != nil {
+			return err
+		}
+		*column.dest = value
+	}
+
+	if name := row["instructor"]; name != "" {
+		instructorID, ok := p.instructors[name]
+		if !ok {
+			return fmt.Errorf("instructor %q not found", name)
+		}
+		session.InstructorID = &instructorID
+	}
+
+	if name := row["term"]; name != "" {
+		termID, ok := p.terms[name]
+		if !ok {
+			return fmt.Errorf("term %q not found", name)
+		}
+		session.TermID = &termID
+	}
+
+	if err := session.Validate(); err != nil {
+		return err
+	}
+
+	if !p.roomTypes[session.RequiredRoom] {
+		return fmt.Errorf("room type %q not found", session.RequiredRoom)
+	}
+
+	key := newSessionKey(session)
+	if p.sessions[key] {
+		return fmt.Errorf("course %q already has a %s session", row["course"], session.Type)
+	}
+
+	p.sessions[key] = true
+	p.newSessions = append(p.newSessions, session)
+	return nil
+}
+
+func newSessionKey(session *models.CourseSession) sessionKey {
+	key := sessionKey{course: session.CourseID, sessionType: session.Type}
+	if session.TermID != nil {
+		key.term = *session.TermID
+	}
+	return key
+}
+
+// parseImportInt parses an optional whole number column, returning nil when it is empty
+func parseImportInt(row map[string]string, column string) (*int32, error) {
+	value := row[column]
+	if value == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a whole number", column)
+	}
+
+	n32 := int32(n)
+	return &n32, nil
+}
+
+// importRow is one record of an imported CSV file, by column name
+type importRow struct {
+	line   int
+	values map[string]string
+	err    error
+}
+
+// readImportCSV reads an entity's CSV file. The first line names the columns, in any order.
+// Values are trimmed. An error is returned for problems with the file as a whole; problems
+// with one row are left on the row.
+func readImportCSV(file io.Reader, entity models.ImportEntity) ([]importRow, error) {
+	columns := importColumns[entity]
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("file is empty")
+		}
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, column := range append(columns.required, columns.optional...) {
+		known[column] = true
+	}
+
+	seen := make(map[string]bool)
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff") // spreadsheets may start the file with a byte order mark
+		}
+		if !known[column] {
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("column %q appears more than once", column)
+		}
+		seen[column] = true
+		header[i] = column
+	}
+
+	for _, column := range columns.required {
+		if !seen[column] {
+			return nil, fmt.Errorf("missing column %q", column)
+		}
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+
+		if len(rows) == models.MaxImportRows {
+			return nil, fmt.Errorf("a file may have at most %d rows", models.MaxImportRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := importRow{line: line, values: make(map[string]string, len(header))}
+		if len(record) != len(header) {
+			row.err = fmt.Errorf("expected %d values, found %d", len(header), len(record))
+		} else {
+			for i, value := range record {
+				row.values[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
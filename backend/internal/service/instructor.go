@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ InstructorServiceInterface = (*InstructorService)(nil)
+
+type InstructorServiceInterface interface {
+	Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error)
+	CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error)
+	List(ctx context.Context) ([]*models.Instructor, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error)
+}
+
+type InstructorService struct {
+	repo repository.InstructorRepositoryInterface
+}
+
+func NewInstructorService(repo repository.InstructorRepositoryInterface) *InstructorService {
+	return &InstructorService{
+		repo: repo,
+	}
+}
+
+func (s *InstructorService) Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error) {
+	return s.repo.Create(ctx, instructor)
+}
+
+func (s *InstructorService) CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error) {
+	return s.repo.CreateBatch(ctx, instructors)
+}
+
+func (s *InstructorService) GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *InstructorService) List(ctx context.Context) ([]*models.Instructor, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *InstructorService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *InstructorService) Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error) {
+	return s.repo.Update(ctx, id, updates)
+}
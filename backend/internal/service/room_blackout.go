@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ RoomBlackoutServiceInterface = (*RoomBlackoutService)(nil)
+
+type RoomBlackoutServiceInterface interface {
+	Create(ctx context.Context, blackout *models.RoomBlackout) (*models.RoomBlackout, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.RoomBlackout, error)
+	GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomBlackout, error)
+	List(ctx context.Context) ([]*models.RoomBlackout, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.RoomBlackoutUpdate) (*models.RoomBlackout, error)
+}
+
+type RoomBlackoutService struct {
+	repo repository.RoomBlackoutRepositoryInterface
+}
+
+func NewRoomBlackoutService(repo repository.RoomBlackoutRepositoryInterface) *RoomBlackoutService {
+	return &RoomBlackoutService{
+		repo: repo,
+	}
+}
+
+func (s *RoomBlackoutService) Create(ctx context.Context, blackout *models.RoomBlackout) (*models.RoomBlackout, error) {
+	return s.repo.Create(ctx, blackout)
+}
+
+func (s *RoomBlackoutService) GetByID(ctx context.Context, id uuid.UUID) (*models.RoomBlackout, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *RoomBlackoutService) GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomBlackout, error) {
+	return s.repo.GetByRoomID(ctx, roomID)
+}
+
+func (s *RoomBlackoutService) List(ctx context.Context) ([]*models.RoomBlackout, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *RoomBlackoutService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *RoomBlackoutService) Update(ctx context.Context, id uuid.UUID, updates *models.RoomBlackoutUpdate) (*models.RoomBlackout, error) {
+	return s.repo.Update(ctx, id, updates)
+}
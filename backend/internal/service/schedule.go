@@ -15,6 +15,7 @@ type ScheduleServiceInterface interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Schedule, error)
 	GetByName(ctx context.Context, name string) (*models.Schedule, error)
 	List(ctx context.Context) ([]*models.Schedule, error)
+	ListByTerm(ctx context.Context, termID uuid.UUID) ([]*models.Schedule, error)
 	ListArchived(ctx context.Context) ([]*models.Schedule, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	Update(ctx context.Context, id uuid.UUID, updates *models.ScheduleUpdate) (*models.Schedule, error)
@@ -49,6 +50,10 @@ func (s *ScheduleService) List(ctx context.Context) ([]*models.Schedule, error)
 	return s.repo.List(ctx)
 }
 
+func (s *ScheduleService) ListByTerm(ctx context.Context, termID uuid.UUID) ([]*models.Schedule, error) {
+	return s.repo.ListByTerm(ctx, termID)
+}
+
 func (s *ScheduleService) Delete(ctx context.Context, id uuid.UUID) error {
 	return s.repo.Delete(ctx, id)
 }
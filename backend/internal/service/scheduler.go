@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -9,21 +10,40 @@ import (
 	"github.com/TerrenceMurray/course-scheduler/internal/models"
 	"github.com/TerrenceMurray/course-scheduler/internal/repository"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/optimize"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/scoring"
 )
 
 var _ SchedulerServiceInterface = (*SchedulerService)(nil)
 
+// ErrUnknownAlgorithm is returned when a request selects an algorithm that isn't registered
+var ErrUnknownAlgorithm = errors.New("unknown scheduling algorithm")
+
+// ErrUnknownTerm is returned when a request selects a term that doesn't exist
+var ErrUnknownTerm = errors.New("unknown term")
+
 type SchedulerServiceInterface interface {
 	GenerateAndSave(ctx context.Context, name string, config *scheduler.Config) (*models.Schedule, *scheduler.Output, error)
 	Generate(ctx context.Context, config *scheduler.Config) (*scheduler.Output, error)
+	Optimize(ctx context.Context, scheduleID uuid.UUID, name string, config *scheduler.Config) (*models.Schedule, *optimize.Result, error)
+	Repair(ctx context.Context, name string, config *scheduler.Config) (*models.Schedule, *scheduler.RepairResult, error)
+	Score(ctx context.Context, termID *uuid.UUID, sessions []models.ScheduledSession) (*models.ScheduleScore, error)
 }
 
 type SchedulerService struct {
-	scheduler   scheduler.Scheduler
-	scheduleRepo repository.ScheduleRepositoryInterface
-	roomRepo     repository.RoomRepositoryInterface
-	courseRepo   repository.CourseRepositoryInterface
-	sessionRepo  repository.CourseSessionRepositoryInterface
+	scheduler      scheduler.Scheduler                         // used when the config doesn't select an algorithm
+	algorithms     map[scheduler.Algorithm]scheduler.Scheduler // alternatives selectable via Config.Algorithm
+	scoring        *scoring.Model
+	scheduleRepo   repository.ScheduleRepositoryInterface
+	roomRepo       repository.RoomRepositoryInterface
+	courseRepo     repository.CourseRepositoryInterface
+	sessionRepo    repository.CourseSessionRepositoryInterface
+	cohortRepo     repository.CohortRepositoryInterface
+	instructorRepo repository.InstructorRepositoryInterface
+	blackoutRepo   repository.RoomBlackoutRepositoryInterface
+	distanceRepo   repository.BuildingDistanceRepositoryInterface
+	constraintRepo repository.SessionConstraintRepositoryInterface
+	termRepo       repository.TermRepositoryInterface
 }
 
 func NewSchedulerService(
@@ -32,24 +52,72 @@ func NewSchedulerService(
 	roomRepo repository.RoomRepositoryInterface,
 	courseRepo repository.CourseRepositoryInterface,
 	sessionRepo repository.CourseSessionRepositoryInterface,
+	cohortRepo repository.CohortRepositoryInterface,
+	instructorRepo repository.InstructorRepositoryInterface,
+	blackoutRepo repository.RoomBlackoutRepositoryInterface,
+	distanceRepo repository.BuildingDistanceRepositoryInterface,
+	constraintRepo repository.SessionConstraintRepositoryInterface,
+	termRepo repository.TermRepositoryInterface,
 ) *SchedulerService {
 	return &SchedulerService{
-		scheduler:    sched,
-		scheduleRepo: scheduleRepo,
-		roomRepo:     roomRepo,
-		courseRepo:   courseRepo,
-		sessionRepo:  sessionRepo,
+		scheduler:      sched,
+		algorithms:     make(map[scheduler.Algorithm]scheduler.Scheduler),
+		scoring:        scoring.DefaultModel(),
+		scheduleRepo:   scheduleRepo,
+		roomRepo:       roomRepo,
+		courseRepo:     courseRepo,
+		sessionRepo:    sessionRepo,
+		cohortRepo:     cohortRepo,
+		instructorRepo: instructorRepo,
+		blackoutRepo:   blackoutRepo,
+		distanceRepo:   distanceRepo,
+		constraintRepo: constraintRepo,
+		termRepo:       termRepo,
 	}
 }
 
+// RegisterAlgorithm makes a scheduler selectable per request through Config.Algorithm
+func (s *SchedulerService) RegisterAlgorithm(algorithm scheduler.Algorithm, sched scheduler.Scheduler) *SchedulerService {
+	s.algorithms[algorithm] = sched
+	return s
+}
+
 // Generate creates a schedule without persisting it
 func (s *SchedulerService) Generate(ctx context.Context, config *scheduler.Config) (*scheduler.Output, error) {
+	sched, err := s.schedulerFor(config)
+	if err != nil {
+		return nil, err
+	}
+
 	input, err := s.buildInput(ctx, config)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.scheduler.Generate(input)
+	output, err := sched.Generate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	output.Score = s.scoring.Score(input, output.ScheduledSessions)
+
+	return output, nil
+}
+
+// Score evaluates sessions against the soft-constraint model using the current rooms, courses,
+// cohorts and instructors of the term
+func (s *SchedulerService) Score(ctx context.Context, termID *uuid.UUID, sessions []models.ScheduledSession) (*models.ScheduleScore, error) {
+	input, err := s.buildTermInput(ctx, nil, termID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make([]*models.ScheduledSession, len(sessions))
+	for i := range sessions {
+		current[i] = &sessions[i]
+	}
+
+	return s.scoring.Score(input, current), nil
 }
 
 // GenerateAndSave creates a schedule and persists it to the database
@@ -63,15 +131,23 @@ func (s *SchedulerService) GenerateAndSave(ctx context.Context, name string, con
 	sessions := make([]models.ScheduledSession, len(output.ScheduledSessions))
 	for i, ss := range output.ScheduledSessions {
 		sessions[i] = models.ScheduledSession{
-			CourseID:  ss.CourseID,
-			RoomID:    ss.RoomID,
-			Day:       ss.Day,
-			StartTime: ss.StartTime,
-			EndTime:   ss.EndTime,
+			CourseID:        ss.CourseID,
+			CourseSessionID: ss.CourseSessionID,
+			RoomID:          ss.RoomID,
+			InstructorID:    ss.InstructorID,
+			Day:             ss.Day,
+			StartTime:       ss.StartTime,
+			EndTime:         ss.EndTime,
+			Block:           ss.Block,
+			Section:         ss.Section,
 		}
 	}
 
 	schedule := models.NewSchedule(uuid.New(), name, sessions, nil)
+	if config != nil {
+		schedule.Pins = config.Pins
+		schedule.TermID = config.TermID
+	}
 
 	saved, err := s.scheduleRepo.Create(ctx, schedule)
 	if err != nil {
@@ -81,8 +157,168 @@ func (s *SchedulerService) GenerateAndSave(ctx context.Context, name string, con
 	return saved, output, nil
 }
 
-// buildInput fetches all required data and builds scheduler input
+// Optimize improves a saved schedule by local search and saves the result as a new schedule,
+// leaving the original untouched. If name is empty, the new schedule is named after the original.
+// Unless the config gives its own pins, sessions pinned by the original schedule are kept in place.
+// The new schedule belongs to the original's term, whatever term the config selects.
+func (s *SchedulerService) Optimize(ctx context.Context, scheduleID uuid.UUID, name string, config *scheduler.Config) (*models.Schedule, *optimize.Result, error) {
+	original, err := s.scheduleRepo.GetByID(ctx, scheduleID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch schedule: %w", err)
+	}
+
+	if config == nil {
+		config = scheduler.DefaultConfig()
+	}
+	withOriginal := *config
+	withOriginal.TermID = original.TermID
+	if len(config.Pins) == 0 && len(original.Pins) > 0 {
+		withOriginal.Pins = original.Pins
+	}
+	config = &withOriginal
+
+	input, err := s.buildInput(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current := make([]*models.ScheduledSession, len(original.Sessions))
+	for i := range original.Sessions {
+		current[i] = &original.Sessions[i]
+	}
+
+	result, err := optimize.NewAnnealer(s.scoring.Objective(input)).Optimize(ctx, input, current)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to optimize schedule: %w", err)
+	}
+
+	sessions := make([]models.ScheduledSession, len(result.Sessions))
+	for i, ss := range result.Sessions {
+		sessions[i] = *ss
+	}
+
+	if name == "" {
+		name = original.Name + " (optimized)"
+	}
+
+	optimized := models.NewSchedule(uuid.New(), name, sessions, nil)
+	optimized.Pins = config.Pins
+	optimized.TermID = original.TermID
+
+	saved, err := s.scheduleRepo.Create(ctx, optimized)
+	if err != nil {
+		return nil, result, fmt.Errorf("failed to save schedule: %w", err)
+	}
+
+	return saved, result, nil
+}
+
+// Repair brings the active schedule of the config's term up to date with the current data while
+// disturbing as little as possible: only sessions the data invalidates are placed again, by the
+// selected algorithm, and every other session stays where it is. The result is saved as a new
+// schedule of the same term and the active one is left untouched. If name is empty, the new schedule
+// is named after the active one. Unless the config gives its own pins, the active schedule's pins are used,
+// leaving out any that no longer resolve.
+func (s *SchedulerService) Repair(ctx context.Context, name string, config *scheduler.Config) (*models.Schedule, *scheduler.RepairResult, error) {
+	if config == nil {
+		config = scheduler.DefaultConfig()
+	}
+
+	active, err := s.scheduleRepo.GetActive(ctx, config.TermID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch active schedule: %w", err)
+	}
+	var inherited []models.SessionPin
+	if len(config.Pins) == 0 {
+		inherited = active.Pins
+	}
+
+	sched, err := s.schedulerFor(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input, err := s.buildInput(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := scheduler.PlanRepair(input, config, active.Sessions, inherited)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to plan repair: %w", err)
+	}
+
+	input.Config = plan.Config
+	output, err := sched.Generate(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to repair schedule: %w", err)
+	}
+	output.Score = s.scoring.Score(input, output.ScheduledSessions)
+
+	result := plan.Result(output)
+
+	sessions := make([]models.ScheduledSession, len(output.ScheduledSessions))
+	for i, ss := range output.ScheduledSessions {
+		sessions[i] = *ss
+	}
+
+	if name == "" {
+		name = active.Name + " (repaired)"
+	}
+
+	repaired := models.NewSchedule(uuid.New(), name, sessions, nil)
+	repaired.Pins = plan.Pins
+	repaired.TermID = active.TermID
+
+	saved, err := s.scheduleRepo.Create(ctx, repaired)
+	if err != nil {
+		return nil, result, fmt.Errorf("failed to save schedule: %w", err)
+	}
+
+	return saved, result, nil
+}
+
+// schedulerFor returns the scheduler selected by the config, or the default if none is selected
+func (s *SchedulerService) schedulerFor(config *scheduler.Config) (scheduler.Scheduler, error) {
+	if config == nil || config.Algorithm == "" {
+		return s.scheduler, nil
+	}
+
+	sched, ok := s.algorithms[config.Algorithm]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, config.Algorithm)
+	}
+
+	return sched, nil
+}
+
+// buildInput fetches all required data for the config's term and builds scheduler input
 func (s *SchedulerService) buildInput(ctx context.Context, config *scheduler.Config) (*scheduler.Input, error) {
+	var termID *uuid.UUID
+	if config != nil {
+		termID = config.TermID
+	}
+
+	return s.buildTermInput(ctx, config, termID)
+}
+
+// buildTermInput fetches all required data and builds scheduler input. Only courses offered in the
+// term are included, with their sessions for that term or for every term, and one-off blackouts
+// are left out unless they fall within the term; if termID is nil, every course is included with
+// the sessions that aren't tied to a term.
+func (s *SchedulerService) buildTermInput(ctx context.Context, config *scheduler.Config, termID *uuid.UUID) (*scheduler.Input, error) {
+	var term *models.Term
+	if termID != nil {
+		var err error
+		term, err = s.termRepo.GetByID(ctx, *termID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownTerm, *termID)
+			}
+			return nil, fmt.Errorf("failed to fetch term: %w", err)
+		}
+	}
+
 	rooms, err := s.roomRepo.List(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch rooms: %w", err)
@@ -94,20 +330,75 @@ func (s *SchedulerService) buildInput(ctx context.Context, config *scheduler.Con
 	}
 
 	// Convert []models.Course to []*models.Course
-	courses := make([]*models.Course, len(coursesVal))
+	courses := make([]*models.Course, 0, len(coursesVal))
 	for i := range coursesVal {
-		courses[i] = &coursesVal[i]
+		if term == nil || term.Offers(coursesVal[i].ID) {
+			courses = append(courses, &coursesVal[i])
+		}
 	}
 
-	sessions, err := s.sessionRepo.List(ctx)
+	allSessions, err := s.sessionRepo.List(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch sessions: %w", err)
 	}
 
+	sessions := make([]*models.CourseSession, 0, len(allSessions))
+	for _, session := range allSessions {
+		if term == nil {
+			if session.TermID == nil {
+				sessions = append(sessions, session)
+			}
+			continue
+		}
+		if term.Offers(session.CourseID) && (session.TermID == nil || *session.TermID == term.ID) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	cohorts, err := s.cohortRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cohorts: %w", err)
+	}
+
+	instructors, err := s.instructorRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch instructors: %w", err)
+	}
+
+	allBlackouts, err := s.blackoutRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch room blackouts: %w", err)
+	}
+
+	// A one-off blackout only matters to the term it falls in
+	blackouts := make([]*models.RoomBlackout, 0, len(allBlackouts))
+	for _, blackout := range allBlackouts {
+		if term != nil && blackout.Recurrence == models.RecurrenceOnce && blackout.Date != nil &&
+			(*blackout.Date < term.StartDate || *blackout.Date > term.EndDate) {
+			continue
+		}
+		blackouts = append(blackouts, blackout)
+	}
+
+	distances, err := s.distanceRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch building distances: %w", err)
+	}
+
+	constraints, err := s.constraintRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch session constraints: %w", err)
+	}
+
 	return &scheduler.Input{
-		Config:         config,
-		Rooms:          rooms,
-		Courses:        courses,
-		CourseSessions: sessions,
+		Config:             config,
+		Rooms:              rooms,
+		Courses:            courses,
+		CourseSessions:     sessions,
+		Cohorts:            cohorts,
+		Instructors:        instructors,
+		RoomBlackouts:      blackouts,
+		BuildingDistances:  distances,
+		SessionConstraints: constraints,
 	}, nil
 }
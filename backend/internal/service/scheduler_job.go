@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/jobs"
+)
+
+var _ SchedulerJobServiceInterface = (*SchedulerJobService)(nil)
+
+type SchedulerJobServiceInterface interface {
+	Submit(ctx context.Context, userID string, config *scheduler.Config) (*jobs.Job, error)
+	GetByID(ctx context.Context, userID string, id uuid.UUID) (*jobs.Job, error)
+	List(ctx context.Context, userID string) ([]*jobs.Job, error)
+	Cancel(ctx context.Context, userID string, id uuid.UUID) (*jobs.Job, error)
+	Watch(ctx context.Context, userID string, id uuid.UUID) (<-chan jobs.Job, func(), error)
+}
+
+// SchedulerJobService runs schedule generation in the background. The input is read when the job
+// is submitted, inside the request, so a job sees exactly the data its user could see at that moment.
+type SchedulerJobService struct {
+	schedulerService *SchedulerService
+	manager          *jobs.Manager
+}
+
+func NewSchedulerJobService(schedulerService *SchedulerService, manager *jobs.Manager) *SchedulerJobService {
+	return &SchedulerJobService{
+		schedulerService: schedulerService,
+		manager:          manager,
+	}
+}
+
+// Submit queues a generation job for the user
+func (s *SchedulerJobService) Submit(ctx context.Context, userID string, config *scheduler.Config) (*jobs.Job, error) {
+	sched, err := s.schedulerService.schedulerFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := s.schedulerService.buildInput(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	scoring := s.schedulerService.scoring
+	return s.manager.Submit(userID, func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		input.Progress = progress
+		output, err := sched.Generate(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Score = scoring.Score(input, output.ScheduledSessions)
+		return output, nil
+	})
+}
+
+func (s *SchedulerJobService) GetByID(ctx context.Context, userID string, id uuid.UUID) (*jobs.Job, error) {
+	return s.manager.Get(userID, id)
+}
+
+func (s *SchedulerJobService) List(ctx context.Context, userID string) ([]*jobs.Job, error) {
+	return s.manager.List(userID), nil
+}
+
+func (s *SchedulerJobService) Cancel(ctx context.Context, userID string, id uuid.UUID) (*jobs.Job, error) {
+	return s.manager.Cancel(userID, id)
+}
+
+// Watch streams snapshots of the user's job as it progresses; see jobs.Manager.Watch
+func (s *SchedulerJobService) Watch(ctx context.Context, userID string, id uuid.UUID) (<-chan jobs.Job, func(), error) {
+	return s.manager.Watch(userID, id)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+var _ SessionConstraintServiceInterface = (*SessionConstraintService)(nil)
+
+type SessionConstraintServiceInterface interface {
+	Create(ctx context.Context, constraint *models.SessionConstraint) (*models.SessionConstraint, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.SessionConstraint, error)
+	GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.SessionConstraint, error)
+	List(ctx context.Context) ([]*models.SessionConstraint, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type SessionConstraintService struct {
+	repo repository.SessionConstraintRepositoryInterface
+}
+
+func NewSessionConstraintService(repo repository.SessionConstraintRepositoryInterface) *SessionConstraintService {
+	return &SessionConstraintService{
+		repo: repo,
+	}
+}
+
+func (s *SessionConstraintService) Create(ctx context.Context, constraint *models.SessionConstraint) (*models.SessionConstraint, error) {
+	return s.repo.Create(ctx, constraint)
+}
+
+func (s *SessionConstraintService) GetByID(ctx context.Context, id uuid.UUID) (*models.SessionConstraint, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *SessionConstraintService) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.SessionConstraint, error) {
+	return s.repo.GetByCourseID(ctx, courseID)
+}
+
+func (s *SessionConstraintService) List(ctx context.Context) ([]*models.SessionConstraint, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *SessionConstraintService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.repo.Delete(ctx, id)
+}
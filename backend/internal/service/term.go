@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/google/uuid"
+)
+
+// ErrTermInUse is returned when deleting a term that schedules still belong to
+var ErrTermInUse = errors.New("term is in use")
+
+var _ TermServiceInterface = (*TermService)(nil)
+
+type TermServiceInterface interface {
+	Create(ctx context.Context, term *models.Term) (*models.Term, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Term, error)
+	List(ctx context.Context) ([]*models.Term, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, id uuid.UUID, updates *models.TermUpdate) (*models.Term, error)
+}
+
+type TermService struct {
+	repo         repository.TermRepositoryInterface
+	scheduleRepo repository.ScheduleRepositoryInterface
+}
+
+func NewTermService(repo repository.TermRepositoryInterface, scheduleRepo repository.ScheduleRepositoryInterface) *TermService {
+	return &TermService{
+		repo:         repo,
+		scheduleRepo: scheduleRepo,
+	}
+}
+
+func (s *TermService) Create(ctx context.Context, term *models.Term) (*models.Term, error) {
+	return s.repo.Create(ctx, term)
+}
+
+func (s *TermService) GetByID(ctx context.Context, id uuid.UUID) (*models.Term, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *TermService) List(ctx context.Context) ([]*models.Term, error) {
+	return s.repo.List(ctx)
+}
+
+// Delete deletes a term and its own course sessions. A term that still has schedules, archived
+// or not, can't be deleted, so they aren't lost with it.
+func (s *TermService) Delete(ctx context.Context, id uuid.UUID) error {
+	schedules, err := s.scheduleRepo.ListByTerm(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to list schedules: %w", err)
+	}
+	count := len(schedules)
+
+	archived, err := s.scheduleRepo.ListArchived(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list archived schedules: %w", err)
+	}
+	for _, schedule := range archived {
+		if schedule.TermID != nil && *schedule.TermID == id {
+			count++
+		}
+	}
+
+	if count > 0 {
+		return fmt.Errorf("%w: %d schedules still belong to it", ErrTermInUse, count)
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *TermService) Update(ctx context.Context, id uuid.UUID, updates *models.TermUpdate) (*models.Term, error) {
+	return s.repo.Update(ctx, id, updates)
+}
@@ -0,0 +1,415 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+)
+
+// ErrInvalidTimetableSlot is returned when a timetable's slot length is out of range
+var ErrInvalidTimetableSlot = errors.New("invalid timetable slot")
+
+// ErrInvalidTimetableFilter is returned when a weekly timetable isn't for exactly one room, course
+// or building that exists
+var ErrInvalidTimetableFilter = errors.New("invalid timetable filter")
+
+var _ TimetableServiceInterface = (*TimetableService)(nil)
+
+type TimetableServiceInterface interface {
+	Grid(ctx context.Context, scheduleID uuid.UUID, slot int) (*models.Timetable, error)
+	Weekly(ctx context.Context, scheduleID uuid.UUID, filter models.TimetableFilter, slot int) (*models.WeeklyTimetable, error)
+	RoomTimetables(ctx context.Context, scheduleID uuid.UUID, buildingID uuid.UUID, slot int) ([]*models.WeeklyTimetable, error)
+}
+
+// TimetableService lays schedules out as grids for printing and spreadsheets
+type TimetableService struct {
+	nameRepos
+	scheduleRepo repository.ScheduleRepositoryInterface
+}
+
+func NewTimetableService(
+	scheduleRepo repository.ScheduleRepositoryInterface,
+	roomRepo repository.RoomRepositoryInterface,
+	buildingRepo repository.BuildingRepositoryInterface,
+	courseRepo repository.CourseRepositoryInterface,
+	courseSessionRepo repository.CourseSessionRepositoryInterface,
+	instructorRepo repository.InstructorRepositoryInterface,
+) *TimetableService {
+	return &TimetableService{
+		nameRepos: nameRepos{
+			roomRepo:          roomRepo,
+			buildingRepo:      buildingRepo,
+			courseRepo:        courseRepo,
+			courseSessionRepo: courseSessionRepo,
+			instructorRepo:    instructorRepo,
+		},
+		scheduleRepo: scheduleRepo,
+	}
+}
+
+// Grid lays a schedule out as a grid per day, with rows of slot minutes and a column for each
+// room the schedule uses. A session fills every slot it overlaps; sessions that share a slot
+// and room, which only happens when they don't line up with the slots, share the cell.
+func (s *TimetableService) Grid(ctx context.Context, scheduleID uuid.UUID, slot int) (*models.Timetable, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.loadNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timetable := &models.Timetable{
+		Name:  schedule.Name,
+		Slot:  slot,
+		Rooms: []models.TimetableRoom{},
+		Days:  []models.TimetableDay{},
+	}
+	if len(schedule.Sessions) == 0 {
+		return timetable, nil
+	}
+
+	// Columns are the rooms in use
+	columns := make(map[uuid.UUID]int)
+	for i := range schedule.Sessions {
+		session := &schedule.Sessions[i]
+		if _, ok := columns[session.RoomID]; !ok {
+			columns[session.RoomID] = 0
+			timetable.Rooms = append(timetable.Rooms, timetableRoom(names, session.RoomID))
+		}
+	}
+
+	sort.SliceStable(timetable.Rooms, func(i, j int) bool {
+		a, b := &timetable.Rooms[i], &timetable.Rooms[j]
+		if a.Building != b.Building {
+			return a.Building < b.Building
+		}
+		return a.Name < b.Name
+	})
+	for i, room := range timetable.Rooms {
+		columns[room.ID] = i
+	}
+
+	meetsOn, start, end := weekSpan(schedule.Sessions, slot)
+	days := make(map[int]int)
+	for _, day := range meetsOn {
+		days[day] = len(timetable.Days)
+
+		grid := models.TimetableDay{Day: day}
+		for t := start; t < end; t += slot {
+			grid.Slots = append(grid.Slots, models.TimetableSlot{
+				StartTime: t,
+				EndTime:   t + slot,
+				Cells:     make([]string, len(timetable.Rooms)),
+			})
+		}
+		timetable.Days = append(timetable.Days, grid)
+	}
+
+	for _, i := range sessionsByStart(schedule.Sessions) {
+		session := &schedule.Sessions[i]
+		grid := &timetable.Days[days[session.Day]]
+		summary := names.summary(session)
+
+		for row := (session.StartTime - start) / slot; row < len(grid.Slots) && grid.Slots[row].StartTime < session.EndTime; row++ {
+			cell := &grid.Slots[row].Cells[columns[session.RoomID]]
+			if *cell == "" {
+				*cell = summary
+			} else {
+				*cell += " / " + summary
+			}
+		}
+	}
+
+	return timetable, nil
+}
+
+// Weekly lays out the week of the room, course or building the filter picks, with rows of slot
+// minutes. Every weekly timetable of a schedule has the same days and hours, those of the whole
+// schedule, so that they line up when printed together.
+func (s *TimetableService) Weekly(ctx context.Context, scheduleID uuid.UUID, filter models.TimetableFilter, slot int) (*models.WeeklyTimetable, error) {
+	set := 0
+	for _, id := range []*uuid.UUID{filter.RoomID, filter.CourseID, filter.BuildingID} {
+		if id != nil {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("%w: pick one room, course or building", ErrInvalidTimetableFilter)
+	}
+
+	week, err := s.loadWeek(ctx, scheduleID, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case filter.RoomID != nil:
+		return week.room(*filter.RoomID)
+	case filter.CourseID != nil:
+		name, ok := week.names.courses[*filter.CourseID]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown course %s", ErrInvalidTimetableFilter, *filter.CourseID)
+		}
+		return week.timetable(name, func(session *models.ScheduledSession) ([]string, bool) {
+			if session.CourseID != *filter.CourseID {
+				return nil, false
+			}
+			return week.lines(session, week.names.location(session.RoomID)), true
+		}), nil
+	default:
+		name, ok := week.names.buildings[*filter.BuildingID]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown building %s", ErrInvalidTimetableFilter, *filter.BuildingID)
+		}
+		return week.timetable(name, func(session *models.ScheduledSession) ([]string, bool) {
+			room, ok := week.names.rooms[session.RoomID]
+			if !ok || room.Building != *filter.BuildingID {
+				return nil, false
+			}
+			return week.lines(session, room.Name), true
+		}), nil
+	}
+}
+
+// RoomTimetables lays out the week of each room of a building, by room name, to print one per
+// door. Rooms the schedule doesn't use get an empty timetable.
+func (s *TimetableService) RoomTimetables(ctx context.Context, scheduleID uuid.UUID, buildingID uuid.UUID, slot int) ([]*models.WeeklyTimetable, error) {
+	week, err := s.loadWeek(ctx, scheduleID, slot)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := week.names.buildings[buildingID]; !ok {
+		return nil, fmt.Errorf("%w: unknown building %s", ErrInvalidTimetableFilter, buildingID)
+	}
+
+	var rooms []*models.Room
+	for _, room := range week.names.rooms {
+		if room.Building == buildingID {
+			rooms = append(rooms, room)
+		}
+	}
+	sort.Slice(rooms, func(i, j int) bool {
+		if rooms[i].Name != rooms[j].Name {
+			return rooms[i].Name < rooms[j].Name
+		}
+		return rooms[i].ID.String() < rooms[j].ID.String()
+	})
+
+	timetables := []*models.WeeklyTimetable{}
+	for _, room := range rooms {
+		timetable, err := week.room(room.ID)
+		if err != nil {
+			return nil, err
+		}
+		timetables = append(timetables, timetable)
+	}
+	return timetables, nil
+}
+
+// week is a schedule with the days and hours its weekly timetables cover
+type week struct {
+	schedule   *models.Schedule
+	names      *calendarNames
+	slot       int
+	days       []int
+	start, end int
+}
+
+// loadWeek loads a schedule and the names of what it refers to. A schedule without sessions
+// covers the default operating days and hours.
+func (s *TimetableService) loadWeek(ctx context.Context, scheduleID uuid.UUID, slot int) (*week, error) {
+	if err := checkSlot(slot); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := s.loadNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &week{schedule: schedule, names: names, slot: slot}
+	if len(schedule.Sessions) > 0 {
+		w.days, w.start, w.end = weekSpan(schedule.Sessions, slot)
+		return w, nil
+	}
+
+	config := scheduler.DefaultConfig()
+	for _, day := range config.OperatingDays {
+		w.days = append(w.days, int(day))
+	}
+	w.start = config.OperatingHours.Start - config.OperatingHours.Start%slot
+	w.end = config.OperatingHours.End + (slot-config.OperatingHours.End%slot)%slot
+	return w, nil
+}
+
+// room lays out the week of one room
+func (w *week) room(roomID uuid.UUID) (*models.WeeklyTimetable, error) {
+	if _, ok := w.names.rooms[roomID]; !ok {
+		return nil, fmt.Errorf("%w: unknown room %s", ErrInvalidTimetableFilter, roomID)
+	}
+
+	title := timetableRoom(w.names, roomID)
+	return w.timetable(title.Label(), func(session *models.ScheduledSession) ([]string, bool) {
+		if session.RoomID != roomID {
+			return nil, false
+		}
+		return w.lines(session, ""), true
+	}), nil
+}
+
+// timetable lays out the sessions entry picks, with the lines it gives them
+func (w *week) timetable(title string, entry func(session *models.ScheduledSession) ([]string, bool)) *models.WeeklyTimetable {
+	timetable := &models.WeeklyTimetable{
+		Title:     title,
+		Schedule:  w.schedule.Name,
+		Slot:      w.slot,
+		Days:      w.days,
+		StartTime: w.start,
+		EndTime:   w.end,
+		Entries:   []models.TimetableEntry{},
+	}
+
+	for _, i := range sessionsByStart(w.schedule.Sessions) {
+		session := &w.schedule.Sessions[i]
+		if lines, ok := entry(session); ok {
+			timetable.Entries = append(timetable.Entries, models.TimetableEntry{
+				Day:       session.Day,
+				StartTime: session.StartTime,
+				EndTime:   session.EndTime,
+				Lines:     lines,
+			})
+		}
+	}
+
+	assignLanes(timetable.Entries)
+	return timetable
+}
+
+// lines describes a session on a timetable: the course and session type, where it is unless
+// the timetable is for one room, and who teaches it
+func (w *week) lines(session *models.ScheduledSession, where string) []string {
+	lines := []string{w.names.summary(session)}
+	if where != "" {
+		lines = append(lines, where)
+	}
+	if description := w.names.description(session); description != "" {
+		lines = append(lines, strings.Split(description, "\n")...)
+	}
+	return lines
+}
+
+// assignLanes places overlapping entries side by side. Entries must be in the order they start.
+// Each entry takes the first lane free when it starts, and every entry in a run of overlapping
+// entries is given the number of lanes the run needs.
+func assignLanes(entries []models.TimetableEntry) {
+	for day := range 7 {
+		var run []*models.TimetableEntry
+		var laneEnds []int // when each lane of the run is next free
+		runEnd := 0
+
+		finish := func() {
+			for _, e := range run {
+				e.Lanes = len(laneEnds)
+			}
+			run, laneEnds = nil, nil
+		}
+
+		for i := range entries {
+			e := &entries[i]
+			if e.Day != day {
+				continue
+			}
+			if len(run) > 0 && e.StartTime >= runEnd {
+				finish()
+			}
+
+			e.Lane = len(laneEnds)
+			for lane, end := range laneEnds {
+				if end <= e.StartTime {
+					e.Lane = lane
+					break
+				}
+			}
+			if e.Lane == len(laneEnds) {
+				laneEnds = append(laneEnds, e.EndTime)
+			} else {
+				laneEnds[e.Lane] = e.EndTime
+			}
+
+			if len(run) == 0 {
+				runEnd = e.EndTime
+			}
+			runEnd = max(runEnd, e.EndTime)
+			run = append(run, e)
+		}
+		finish()
+	}
+}
+
+// checkSlot checks a timetable's slot length is in range
+func checkSlot(slot int) error {
+	if slot < models.MinTimetableSlot || slot > models.MaxTimetableSlot {
+		return fmt.Errorf("%w: must be between %d and %d minutes", ErrInvalidTimetableSlot, models.MinTimetableSlot, models.MaxTimetableSlot)
+	}
+	return nil
+}
+
+// weekSpan works out the days sessions meet on and the times from the start of the slot the
+// earliest one starts in to the end of the slot the latest one ends in
+func weekSpan(sessions []models.ScheduledSession, slot int) ([]int, int, int) {
+	var meetsOn [7]bool
+	start, end := sessions[0].StartTime, sessions[0].EndTime
+	for i := range sessions {
+		meetsOn[sessions[i].Day] = true
+		start, end = min(start, sessions[i].StartTime), max(end, sessions[i].EndTime)
+	}
+
+	var days []int
+	for day, meets := range meetsOn {
+		if meets {
+			days = append(days, day)
+		}
+	}
+	return days, start - start%slot, end + (slot-end%slot)%slot
+}
+
+// timetableRoom names a room and its building, falling back to the room's ID if it has been deleted
+func timetableRoom(names *calendarNames, roomID uuid.UUID) models.TimetableRoom {
+	room, ok := names.rooms[roomID]
+	if !ok {
+		return models.TimetableRoom{ID: roomID, Name: roomID.String()}
+	}
+	return models.TimetableRoom{ID: roomID, Name: room.Name, Building: names.buildings[room.Building]}
+}
+
+// sessionsByStart returns the indexes of the sessions in the order they start
+func sessionsByStart(sessions []models.ScheduledSession) []int {
+	order := make([]int, len(sessions))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return sessions[order[i]].StartTime < sessions[order[j]].StartTime
+	})
+	return order
+}
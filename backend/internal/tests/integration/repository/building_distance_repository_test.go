@@ -0,0 +1,161 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type BuildingDistanceRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.BuildingDistanceRepositoryInterface
+	buildingRepo repository.BuildingRepositoryInterface
+	library      *models.Building
+	science      *models.Building
+	arts         *models.Building
+}
+
+func (s *BuildingDistanceRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewBuildingDistanceRepository(s.testDB.DB, s.testDB.Logger)
+	s.buildingRepo = repository.NewBuildingRepository(s.testDB.DB, s.testDB.Logger)
+
+	// Setup test user context for RLS and created_by trigger
+	_, err := s.testDB.SetupTestUserContext()
+	if err != nil {
+		s.T().Fatalf("failed to setup test user context: %v", err)
+	}
+}
+
+func (s *BuildingDistanceRepositorySuite) SetupTest() {
+	// Create fresh buildings before each test
+	var err error
+	s.library, err = s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Library", nil, nil))
+	s.Require().NoError(err)
+	s.science, err = s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Science Block", nil, nil))
+	s.Require().NoError(err)
+	s.arts, err = s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Arts Centre", nil, nil))
+	s.Require().NoError(err)
+}
+
+func (s *BuildingDistanceRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *BuildingDistanceRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.building_distances")
+	s.testDB.Truncate("scheduler.buildings")
+}
+
+// TestSet
+func (s *BuildingDistanceRepositorySuite) TestSet_Success() {
+	actual, err := s.repo.Set(s.ctx, models.NewBuildingDistance(s.library.ID, s.science.ID, 10, nil, nil))
+
+	s.Require().NoError(err)
+	s.Require().Equal(s.library.ID, actual.FromBuildingID)
+	s.Require().Equal(s.science.ID, actual.ToBuildingID)
+	s.Require().Equal(int32(10), actual.Minutes)
+	s.Require().NotNil(actual.CreatedAt)
+}
+
+func (s *BuildingDistanceRepositorySuite) TestSet_StoresBothDirections() {
+	_, err := s.repo.Set(s.ctx, models.NewBuildingDistance(s.library.ID, s.science.ID, 10, nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.GetByBuildingID(s.ctx, s.science.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 1)
+	s.Require().Equal(s.library.ID, actual[0].ToBuildingID)
+	s.Require().Equal(int32(10), actual[0].Minutes)
+}
+
+func (s *BuildingDistanceRepositorySuite) TestSet_ReplacesExisting() {
+	_, err := s.repo.Set(s.ctx, models.NewBuildingDistance(s.library.ID, s.science.ID, 10, nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.Set(s.ctx, models.NewBuildingDistance(s.science.ID, s.library.ID, 15, nil, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(int32(15), actual.Minutes)
+
+	all, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(all, 2)
+	for _, d := range all {
+		s.Require().Equal(int32(15), d.Minutes)
+	}
+}
+
+func (s *BuildingDistanceRepositorySuite) TestSet_ValidationError() {
+	cases := map[string]*models.BuildingDistance{
+		"same building":    models.NewBuildingDistance(s.library.ID, s.library.ID, 10, nil, nil),
+		"negative minutes": models.NewBuildingDistance(s.library.ID, s.science.ID, -1, nil, nil),
+		"missing building": models.NewBuildingDistance(uuid.Nil, s.science.ID, 10, nil, nil),
+	}
+
+	for name, distance := range cases {
+		_, err := s.repo.Set(s.ctx, distance)
+
+		s.Require().Error(err, name)
+		s.Require().ErrorContains(err, "validation failed:", name)
+	}
+}
+
+// TestGetByBuildingID
+func (s *BuildingDistanceRepositorySuite) TestGetByBuildingID_Success() {
+	// GetByBuildingID orders by walking time
+	_, err := s.repo.Set(s.ctx, models.NewBuildingDistance(s.library.ID, s.arts.ID, 12, nil, nil))
+	s.Require().NoError(err)
+	_, err = s.repo.Set(s.ctx, models.NewBuildingDistance(s.library.ID, s.science.ID, 5, nil, nil))
+	s.Require().NoError(err)
+
+	actual, err := s.repo.GetByBuildingID(s.ctx, s.library.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(s.science.ID, actual[0].ToBuildingID)
+	s.Require().Equal(s.arts.ID, actual[1].ToBuildingID)
+}
+
+func (s *BuildingDistanceRepositorySuite) TestDeleteBuilding_CascadesDistances() {
+	_, err := s.repo.Set(s.ctx, models.NewBuildingDistance(s.library.ID, s.science.ID, 10, nil, nil))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.buildingRepo.Delete(s.ctx, s.science.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 0)
+}
+
+// TestDelete
+func (s *BuildingDistanceRepositorySuite) TestDelete_Success() {
+	_, err := s.repo.Set(s.ctx, models.NewBuildingDistance(s.library.ID, s.science.ID, 10, nil, nil))
+	s.Require().NoError(err)
+
+	err = s.repo.Delete(s.ctx, s.science.ID, s.library.ID)
+	s.Require().NoError(err)
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 0)
+}
+
+func (s *BuildingDistanceRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, s.library.ID, s.science.ID)
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestBuildingDistanceRepositorySuite
+func TestBuildingDistanceRepositorySuite(t *testing.T) {
+	suite.Run(t, new(BuildingDistanceRepositorySuite))
+}
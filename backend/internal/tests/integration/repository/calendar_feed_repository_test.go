@@ -0,0 +1,135 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type CalendarFeedRepositorySuite struct {
+	suite.Suite
+	repo       repository.CalendarFeedRepositoryInterface
+	courseRepo repository.CourseRepositoryInterface
+	testDB     *utils.TestDB
+	userID     uuid.UUID
+	ctx        context.Context
+}
+
+func (s *CalendarFeedRepositorySuite) SetupSuite() {
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewCalendarFeedRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.ctx = context.Background()
+
+	// Setup test user context for RLS and created_by trigger
+	userID, err := s.testDB.SetupTestUserContext()
+	if err != nil {
+		s.T().Fatalf("failed to setup test user context: %v", err)
+	}
+	s.userID = userID
+}
+
+func (s *CalendarFeedRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *CalendarFeedRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.calendar_feeds")
+	s.testDB.Truncate("scheduler.courses")
+}
+
+func newCalendarFeed(name string, courseID *uuid.UUID) *models.CalendarFeed {
+	return models.NewCalendarFeed(uuid.New(), name, uuid.NewString()+uuid.NewString(), nil, courseID, nil, nil, nil, nil)
+}
+
+// TestCreate
+func (s *CalendarFeedRepositorySuite) TestCreate_Success() {
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Math 101", nil, nil))
+	s.Require().NoError(err)
+	expected := newCalendarFeed("Math 101", &course.ID)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.Token, actual.Token)
+	s.Require().Equal(&course.ID, actual.CourseID)
+	s.Require().Nil(actual.TermID)
+}
+
+func (s *CalendarFeedRepositorySuite) TestCreate_ValidationError() {
+	feed := newCalendarFeed("Math 101", nil)
+	feed.Token = "short"
+
+	_, err := s.repo.Create(s.ctx, feed)
+
+	s.Require().ErrorContains(err, "validation failed:")
+}
+
+// TestGetByToken
+func (s *CalendarFeedRepositorySuite) TestGetByToken_Success() {
+	feed, createErr := s.repo.Create(s.ctx, newCalendarFeed("Everything", nil))
+
+	actual, err := s.repo.GetByToken(s.ctx, feed.Token)
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(err)
+	s.Require().Equal(feed.ID, actual.ID)
+}
+
+func (s *CalendarFeedRepositorySuite) TestGetByToken_NotFoundError() {
+	_, err := s.repo.GetByToken(s.ctx, "unknown")
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestGetOwner
+func (s *CalendarFeedRepositorySuite) TestGetOwner_Success() {
+	feed, createErr := s.repo.Create(s.ctx, newCalendarFeed("Everything", nil))
+
+	owner, err := s.repo.GetOwner(s.ctx, feed.Token)
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(err)
+	s.Require().Equal(s.userID, owner)
+}
+
+func (s *CalendarFeedRepositorySuite) TestGetOwner_RevokedToken() {
+	feed, _ := s.repo.Create(s.ctx, newCalendarFeed("Everything", nil))
+	s.Require().NoError(s.repo.Delete(s.ctx, feed.ID))
+
+	_, err := s.repo.GetOwner(s.ctx, feed.Token)
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestList
+func (s *CalendarFeedRepositorySuite) TestList_Success() {
+	// List orders by Name ASC
+	b, _ := s.repo.Create(s.ctx, newCalendarFeed("B feed", nil))
+	a, _ := s.repo.Create(s.ctx, newCalendarFeed("A feed", nil))
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(a.ID, actual[0].ID)
+	s.Require().Equal(b.ID, actual[1].ID)
+}
+
+// TestDelete
+func (s *CalendarFeedRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestCalendarFeedRepositorySuite
+func TestCalendarFeedRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CalendarFeedRepositorySuite))
+}
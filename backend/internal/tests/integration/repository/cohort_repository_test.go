@@ -0,0 +1,145 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type CohortRepositorySuite struct {
+	suite.Suite
+	repo       repository.CohortRepositoryInterface
+	courseRepo repository.CourseRepositoryInterface
+	testDB     *utils.TestDB
+	ctx        context.Context
+}
+
+func (s *CohortRepositorySuite) SetupSuite() {
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewCohortRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.ctx = context.Background()
+
+	// Setup test user context for RLS and created_by trigger
+	_, err := s.testDB.SetupTestUserContext()
+	if err != nil {
+		s.T().Fatalf("failed to setup test user context: %v", err)
+	}
+}
+
+func (s *CohortRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *CohortRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.cohort_courses")
+	s.testDB.Truncate("scheduler.cohorts")
+	s.testDB.Truncate("scheduler.courses")
+}
+
+func (s *CohortRepositorySuite) createCourse(name string) *models.Course {
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), name, nil, nil))
+	s.Require().NoError(err)
+	return course
+}
+
+// TestCreate
+func (s *CohortRepositorySuite) TestCreate_Success() {
+	course := s.createCourse("Math 101")
+	expected := models.NewCohort(uuid.New(), "BSc Computer Science", 1, []uuid.UUID{course.ID}, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.Programme, actual.Programme)
+	s.Require().Equal(expected.Year, actual.Year)
+	s.Require().Equal([]uuid.UUID{course.ID}, actual.CourseIDs)
+}
+
+func (s *CohortRepositorySuite) TestCreate_ValidationError() {
+	_, err := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "BSc Computer Science", 0, nil, nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed:")
+}
+
+// TestGetByID
+func (s *CohortRepositorySuite) TestGetByID_NotFoundError() {
+	_, err := s.repo.GetByID(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestList
+func (s *CohortRepositorySuite) TestList_Success() {
+	course := s.createCourse("Math 101")
+
+	// List orders by Programme ASC, Year ASC
+	expected1, _ := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "BSc Computer Science", 1, []uuid.UUID{course.ID}, nil, nil))
+	expected2, _ := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "BSc Computer Science", 2, nil, nil, nil))
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(expected1.ID, actual[0].ID)
+	s.Require().Equal([]uuid.UUID{course.ID}, actual[0].CourseIDs)
+	s.Require().Equal(expected2.ID, actual[1].ID)
+	s.Require().Empty(actual[1].CourseIDs)
+}
+
+// TestUpdate
+func (s *CohortRepositorySuite) TestUpdate_ReplacesCourses() {
+	course1 := s.createCourse("Math 101")
+	course2 := s.createCourse("Physics 101")
+	cohort, createErr := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "BSc Computer Science", 1, []uuid.UUID{course1.ID}, nil, nil))
+
+	updatedYear := int32(2)
+	actual, updateErr := s.repo.Update(s.ctx, cohort.ID, &models.CohortUpdate{
+		Year:      &updatedYear,
+		CourseIDs: []uuid.UUID{course2.ID},
+	})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().Equal(updatedYear, actual.Year)
+	s.Require().Equal([]uuid.UUID{course2.ID}, actual.CourseIDs)
+}
+
+func (s *CohortRepositorySuite) TestUpdate_ErrNotFound() {
+	updatedYear := int32(2)
+	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.CohortUpdate{Year: &updatedYear})
+
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestDelete
+func (s *CohortRepositorySuite) TestDelete_Success() {
+	cohort, _ := s.repo.Create(s.ctx, models.NewCohort(uuid.New(), "BSc Computer Science", 1, nil, nil, nil))
+
+	err := s.repo.Delete(s.ctx, cohort.ID)
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, cohort.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *CohortRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestCohortRepositorySuite
+func TestCohortRepositorySuite(t *testing.T) {
+	suite.Run(t, new(CohortRepositorySuite))
+}
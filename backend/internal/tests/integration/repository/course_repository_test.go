@@ -56,6 +56,18 @@ func (s *CourseRepositorySuite) TestCreate_Success() {
 	s.Require().Equal(expected.ID, actual.ID)
 }
 
+func (s *CourseRepositorySuite) TestCreate_WithExpectedEnrollment() {
+	expected := models.NewCourse(uuid.New(), "Introduction to Data Analytics", nil, nil)
+	enrollment := int32(120)
+	expected.ExpectedEnrollment = &enrollment
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual.ExpectedEnrollment)
+	s.Require().Equal(enrollment, *actual.ExpectedEnrollment)
+}
+
 func (s *CourseRepositorySuite) TestCreate_ValidationError() {
 	now := time.Now()
 
@@ -213,6 +225,29 @@ func (s *CourseRepositorySuite) TestUpdateCourse_Success() {
 	s.Require().Contains(actual.Name, "Adv.")
 }
 
+func (s *CourseRepositorySuite) TestUpdateCourse_ClearsExpectedEnrollment() {
+	expected := models.NewCourse(uuid.New(), "Data Analytics", nil, nil)
+	enrollment := int32(120)
+	expected.ExpectedEnrollment = &enrollment
+	course, createErr := s.repo.Create(s.ctx, expected)
+
+	actual, updateErr := s.repo.Update(s.ctx, course.ID, &models.CourseUpdate{ClearExpectedEnrollment: true})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().Nil(actual.ExpectedEnrollment)
+	s.Require().Equal(course.Name, actual.Name) // Unchanged
+}
+
+func (s *CourseRepositorySuite) TestUpdateCourse_ClearAndSetExpectedEnrollment() {
+	enrollment := int32(120)
+	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.CourseUpdate{ExpectedEnrollment: &enrollment, ClearExpectedEnrollment: true})
+
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+	s.Require().ErrorContains(err, "validation failed")
+}
+
 func (s *CourseRepositorySuite) TestUpdateCourse_ValidationError() {
 	updatedName := ""
 	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.CourseUpdate{
@@ -13,13 +13,15 @@ import (
 
 type CourseSessionRepositorySuite struct {
 	suite.Suite
-	ctx          context.Context
-	testDB       *utils.TestDB
-	repo         repository.CourseSessionRepositoryInterface
-	courseRepo   repository.CourseRepositoryInterface
-	roomTypeRepo repository.RoomTypeRepositoryInterface
-	testCourse   *models.Course
-	testRoomType *models.RoomType
+	ctx            context.Context
+	testDB         *utils.TestDB
+	repo           repository.CourseSessionRepositoryInterface
+	courseRepo     repository.CourseRepositoryInterface
+	roomTypeRepo   repository.RoomTypeRepositoryInterface
+	instructorRepo repository.InstructorRepositoryInterface
+	termRepo       repository.TermRepositoryInterface
+	testCourse     *models.Course
+	testRoomType   *models.RoomType
 }
 
 func (s *CourseSessionRepositorySuite) SetupSuite() {
@@ -28,6 +30,8 @@ func (s *CourseSessionRepositorySuite) SetupSuite() {
 	s.repo = repository.NewCourseSessionRepository(s.testDB.DB, s.testDB.Logger)
 	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
 	s.roomTypeRepo = repository.NewRoomTypeRepository(s.testDB.DB, s.testDB.Logger)
+	s.instructorRepo = repository.NewInstructorRepository(s.testDB.DB, s.testDB.Logger)
+	s.termRepo = repository.NewTermRepository(s.testDB.DB, s.testDB.Logger)
 
 	// Setup test user context for RLS and created_by trigger
 	_, err := s.testDB.SetupTestUserContext()
@@ -54,8 +58,10 @@ func (s *CourseSessionRepositorySuite) TearDownSuite() {
 
 func (s *CourseSessionRepositorySuite) TearDownTest() {
 	s.testDB.Truncate("scheduler.course_sessions")
+	s.testDB.Truncate("scheduler.terms")
 	s.testDB.Truncate("scheduler.courses")
 	s.testDB.Truncate("scheduler.room_types")
+	s.testDB.Truncate("scheduler.instructors")
 }
 
 func (s *CourseSessionRepositorySuite) createTestSession() *models.CourseSession {
@@ -90,6 +96,64 @@ func (s *CourseSessionRepositorySuite) TestCreate_Success() {
 	s.Require().NotNil(actual.CreatedAt)
 }
 
+func (s *CourseSessionRepositorySuite) TestCreate_WithInstructor() {
+	instructor, err := s.instructorRepo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Ada Lovelace", nil, nil))
+	s.Require().NoError(err)
+
+	expected := s.createTestSession()
+	expected.InstructorID = &instructor.ID
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual.InstructorID)
+	s.Require().Equal(instructor.ID, *actual.InstructorID)
+}
+
+func (s *CourseSessionRepositorySuite) TestCreate_Linked() {
+	blocks := int32(2)
+	sections := int32(3)
+	expected := s.createTestSession()
+	expected.Blocks = &blocks
+	expected.ParallelSections = &sections
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual.Blocks)
+	s.Require().Equal(blocks, *actual.Blocks)
+	s.Require().NotNil(actual.ParallelSections)
+	s.Require().Equal(sections, *actual.ParallelSections)
+}
+
+func (s *CourseSessionRepositorySuite) TestCreate_PerTerm() {
+	term, err := s.termRepo.Create(s.ctx, models.NewTerm(uuid.New(), "Fall 2025", "2025-09-01", "2025-12-19", 14, nil, []uuid.UUID{s.testCourse.ID}, nil, nil))
+	s.Require().NoError(err)
+
+	// The same session type may be defined for every term and again for one term
+	everyTerm, everyTermErr := s.repo.Create(s.ctx, s.createTestSession())
+	inTerm := s.createTestSession()
+	inTerm.TermID = &term.ID
+	actual, inTermErr := s.repo.Create(s.ctx, inTerm)
+
+	s.Require().NoError(everyTermErr)
+	s.Require().NoError(inTermErr)
+	s.Require().Nil(everyTerm.TermID)
+	s.Require().Equal(&term.ID, actual.TermID)
+}
+
+func (s *CourseSessionRepositorySuite) TestCreate_LinkedValidationError() {
+	blocks := int32(models.MaxSessionBlocks + 1)
+	session := s.createTestSession()
+	session.Blocks = &blocks
+
+	actual, err := s.repo.Create(s.ctx, session)
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+	s.Require().Nil(actual)
+}
+
 func (s *CourseSessionRepositorySuite) TestCreate_ValidationError() {
 	duration := int32(60)
 	numSessions := int32(2)
@@ -283,6 +347,50 @@ func (s *CourseSessionRepositorySuite) TestUpdate_Success() {
 	s.Require().Equal(session.CourseID, actual.CourseID) // Unchanged
 }
 
+func (s *CourseSessionRepositorySuite) TestUpdate_ClearsInstructorAndTerm() {
+	instructor, instructorErr := s.instructorRepo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Ada Lovelace", nil, nil))
+	term, termErr := s.termRepo.Create(s.ctx, models.NewTerm(uuid.New(), "Fall 2025", "2025-09-01", "2025-12-19", 14, nil, []uuid.UUID{s.testCourse.ID}, nil, nil))
+	s.Require().NoError(instructorErr)
+	s.Require().NoError(termErr)
+
+	expected := s.createTestSession()
+	expected.InstructorID = &instructor.ID
+	expected.TermID = &term.ID
+	session, createErr := s.repo.Create(s.ctx, expected)
+
+	actual, updateErr := s.repo.Update(s.ctx, session.ID, &models.CourseSessionUpdate{ClearInstructor: true, ClearTerm: true})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().Nil(actual.InstructorID)
+	s.Require().Nil(actual.TermID)
+	s.Require().Equal(*session.Duration, *actual.Duration) // Unchanged
+}
+
+func (s *CourseSessionRepositorySuite) TestUpdate_ClearsExpectedEnrollment() {
+	expected := s.createTestSession()
+	enrollment := int32(40)
+	expected.ExpectedEnrollment = &enrollment
+	session, createErr := s.repo.Create(s.ctx, expected)
+
+	actual, updateErr := s.repo.Update(s.ctx, session.ID, &models.CourseSessionUpdate{ClearExpectedEnrollment: true})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().Nil(actual.ExpectedEnrollment)
+	s.Require().Equal(*session.Duration, *actual.Duration) // Unchanged
+}
+
+func (s *CourseSessionRepositorySuite) TestUpdate_ClearAndSetInstructor() {
+	session, _ := s.repo.Create(s.ctx, s.createTestSession())
+
+	instructorID := uuid.New()
+	_, err := s.repo.Update(s.ctx, session.ID, &models.CourseSessionUpdate{InstructorID: &instructorID, ClearInstructor: true})
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed")
+}
+
 func (s *CourseSessionRepositorySuite) TestUpdate_NotFound() {
 	newDuration := int32(90)
 	updates := &models.CourseSessionUpdate{
@@ -0,0 +1,163 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type InstructorRepositorySuite struct {
+	suite.Suite
+	repo   repository.InstructorRepositoryInterface
+	testDB *utils.TestDB
+	ctx    context.Context
+}
+
+func (s *InstructorRepositorySuite) SetupSuite() {
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewInstructorRepository(s.testDB.DB, s.testDB.Logger)
+	s.ctx = context.Background()
+
+	// Setup test user context for RLS and created_by trigger
+	_, err := s.testDB.SetupTestUserContext()
+	if err != nil {
+		s.T().Fatalf("failed to setup test user context: %v", err)
+	}
+}
+
+func (s *InstructorRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *InstructorRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.instructors")
+}
+
+// TestCreate
+func (s *InstructorRepositorySuite) TestCreate_Success() {
+	expected := models.NewInstructor(uuid.New(), "Dr. Ada Lovelace", nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.Name, actual.Name)
+}
+
+func (s *InstructorRepositorySuite) TestCreate_ValidationError() {
+	_, err := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), " ", nil, nil))
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed:")
+}
+
+// TestCreateBatch
+func (s *InstructorRepositorySuite) TestCreateBatch_RollbackOnError() {
+	instructors := []*models.Instructor{
+		models.NewInstructor(uuid.New(), "Valid Instructor", nil, nil),
+		models.NewInstructor(uuid.New(), " ", nil, nil), // Invalid - will fail validation
+	}
+
+	_, createErr := s.repo.CreateBatch(s.ctx, instructors)
+	s.Require().Error(createErr)
+
+	// Verify no instructors were persisted (transaction rolled back)
+	actual, getErr := s.repo.List(s.ctx)
+	s.Require().NoError(getErr)
+	s.Require().Len(actual, 0)
+}
+
+// TestGetByID
+func (s *InstructorRepositorySuite) TestGetByID_NotFoundError() {
+	_, err := s.repo.GetByID(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestList
+func (s *InstructorRepositorySuite) TestList_Success() {
+	// List orders by Name ASC
+	expected1, _ := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), "Alan Turing", nil, nil))
+	expected2, _ := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), "Grace Hopper", nil, nil))
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(expected1.ID, actual[0].ID)
+	s.Require().Equal(expected2.ID, actual[1].ID)
+}
+
+// TestUpdate
+func (s *InstructorRepositorySuite) TestUpdate_Success() {
+	instructor, createErr := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Hopper", nil, nil))
+
+	updatedName := "Rear Admiral Hopper"
+	actual, updateErr := s.repo.Update(s.ctx, instructor.ID, &models.InstructorUpdate{Name: &updatedName})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().NotNil(actual.UpdatedAt)
+	s.Require().Equal(updatedName, actual.Name)
+}
+
+func (s *InstructorRepositorySuite) TestUpdate_ClearsPreferredHours() {
+	expected := models.NewInstructor(uuid.New(), "Dr. Lovelace", nil, nil)
+	start, end := int32(540), int32(780)
+	expected.PreferredStart, expected.PreferredEnd = &start, &end
+	instructor, createErr := s.repo.Create(s.ctx, expected)
+
+	actual, updateErr := s.repo.Update(s.ctx, instructor.ID, &models.InstructorUpdate{ClearPreferredHours: true})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().Nil(actual.PreferredStart)
+	s.Require().Nil(actual.PreferredEnd)
+	s.Require().Equal(instructor.Name, actual.Name) // Unchanged
+}
+
+func (s *InstructorRepositorySuite) TestUpdate_ClearAndSetPreferredHours() {
+	start := int32(540)
+	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.InstructorUpdate{PreferredStart: &start, ClearPreferredHours: true})
+
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+	s.Require().ErrorContains(err, "validation failed")
+}
+
+func (s *InstructorRepositorySuite) TestUpdate_ErrNotFound() {
+	updatedName := "Nobody"
+	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.InstructorUpdate{Name: &updatedName})
+
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestDelete
+func (s *InstructorRepositorySuite) TestDelete_Success() {
+	instructor, _ := s.repo.Create(s.ctx, models.NewInstructor(uuid.New(), "Dr. Ada Lovelace", nil, nil))
+
+	err := s.repo.Delete(s.ctx, instructor.ID)
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, instructor.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *InstructorRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestInstructorRepositorySuite
+func TestInstructorRepositorySuite(t *testing.T) {
+	suite.Run(t, new(InstructorRepositorySuite))
+}
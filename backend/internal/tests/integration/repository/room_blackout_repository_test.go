@@ -0,0 +1,216 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoomBlackoutRepositorySuite struct {
+	suite.Suite
+	ctx          context.Context
+	testDB       *utils.TestDB
+	repo         repository.RoomBlackoutRepositoryInterface
+	roomRepo     repository.RoomRepositoryInterface
+	buildingRepo repository.BuildingRepositoryInterface
+	roomTypeRepo repository.RoomTypeRepositoryInterface
+	testRoom     *models.Room
+}
+
+func (s *RoomBlackoutRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewRoomBlackoutRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomRepo = repository.NewRoomRepository(s.testDB.DB, s.testDB.Logger)
+	s.buildingRepo = repository.NewBuildingRepository(s.testDB.DB, s.testDB.Logger)
+	s.roomTypeRepo = repository.NewRoomTypeRepository(s.testDB.DB, s.testDB.Logger)
+
+	// Setup test user context for RLS and created_by trigger
+	_, err := s.testDB.SetupTestUserContext()
+	if err != nil {
+		s.T().Fatalf("failed to setup test user context: %v", err)
+	}
+}
+
+func (s *RoomBlackoutRepositorySuite) SetupTest() {
+	// Create a fresh room before each test
+	building, err := s.buildingRepo.Create(s.ctx, models.NewBuilding(uuid.New(), "Test Building", nil, nil))
+	s.Require().NoError(err)
+
+	roomType, err := s.roomTypeRepo.Create(s.ctx, models.NewRoomType("lecture_room", nil, nil))
+	s.Require().NoError(err)
+
+	room, err := s.roomRepo.Create(s.ctx, models.NewRoom(uuid.New(), "FST 113", roomType.Name, building.ID, 50, nil, nil))
+	s.Require().NoError(err)
+	s.testRoom = room
+}
+
+func (s *RoomBlackoutRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *RoomBlackoutRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.room_blackouts")
+	s.testDB.Truncate("scheduler.rooms")
+	s.testDB.Truncate("scheduler.buildings")
+	s.testDB.Truncate("scheduler.room_types")
+}
+
+func (s *RoomBlackoutRepositorySuite) weekly(day, start, end int32) *models.RoomBlackout {
+	return models.NewRoomBlackout(uuid.New(), s.testRoom.ID, day, start, end, models.RecurrenceWeekly, nil, nil, nil, nil)
+}
+
+// TestCreate
+func (s *RoomBlackoutRepositorySuite) TestCreate_Success() {
+	reason := "Projector maintenance"
+	expected := s.weekly(2, 480, 600)
+	expected.Reason = &reason
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(expected.RoomID, actual.RoomID)
+	s.Require().Equal(int32(2), actual.Day)
+	s.Require().Equal(int32(480), actual.StartTime)
+	s.Require().Equal(int32(600), actual.EndTime)
+	s.Require().Equal(models.RecurrenceWeekly, actual.Recurrence)
+	s.Require().Nil(actual.Date)
+	s.Require().Equal(reason, *actual.Reason)
+}
+
+func (s *RoomBlackoutRepositorySuite) TestCreate_OneOff() {
+	date := "2025-09-01" // a Monday
+	expected := models.NewRoomBlackout(uuid.New(), s.testRoom.ID, 0, 480, 1260, models.RecurrenceOnce, &date, nil, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Equal(models.RecurrenceOnce, actual.Recurrence)
+	s.Require().NotNil(actual.Date)
+	s.Require().Equal(date, *actual.Date)
+}
+
+func (s *RoomBlackoutRepositorySuite) TestCreate_ValidationError() {
+	monday := "2025-09-01"
+	cases := map[string]*models.RoomBlackout{
+		"ends before it starts": s.weekly(0, 600, 480),
+		"day out of range":      s.weekly(7, 480, 600),
+		"one-off without date":  models.NewRoomBlackout(uuid.New(), s.testRoom.ID, 0, 480, 600, models.RecurrenceOnce, nil, nil, nil, nil),
+		"date on wrong weekday": models.NewRoomBlackout(uuid.New(), s.testRoom.ID, 1, 480, 600, models.RecurrenceOnce, &monday, nil, nil, nil),
+		"unknown recurrence":    models.NewRoomBlackout(uuid.New(), s.testRoom.ID, 0, 480, 600, "monthly", nil, nil, nil, nil),
+	}
+
+	for name, blackout := range cases {
+		_, err := s.repo.Create(s.ctx, blackout)
+
+		s.Require().Error(err, name)
+		s.Require().ErrorContains(err, "validation failed:", name)
+	}
+}
+
+// TestGetByRoomID
+func (s *RoomBlackoutRepositorySuite) TestGetByRoomID_Success() {
+	// GetByRoomID orders by day, then start time
+	expected1, _ := s.repo.Create(s.ctx, s.weekly(0, 1080, 1260))
+	expected2, _ := s.repo.Create(s.ctx, s.weekly(3, 480, 540))
+	expected3, _ := s.repo.Create(s.ctx, s.weekly(0, 480, 540))
+
+	actual, err := s.repo.GetByRoomID(s.ctx, s.testRoom.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 3)
+	s.Require().Equal(expected3.ID, actual[0].ID)
+	s.Require().Equal(expected1.ID, actual[1].ID)
+	s.Require().Equal(expected2.ID, actual[2].ID)
+}
+
+func (s *RoomBlackoutRepositorySuite) TestDeleteRoom_CascadesBlackouts() {
+	_, err := s.repo.Create(s.ctx, s.weekly(0, 480, 540))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.roomRepo.Delete(s.ctx, s.testRoom.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 0)
+}
+
+// TestUpdate
+func (s *RoomBlackoutRepositorySuite) TestUpdate_Success() {
+	blackout, createErr := s.repo.Create(s.ctx, s.weekly(4, 1080, 1260))
+
+	newStart := int32(1020)
+	actual, updateErr := s.repo.Update(s.ctx, blackout.ID, &models.RoomBlackoutUpdate{StartTime: &newStart})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().NotNil(actual.UpdatedAt)
+	s.Require().Equal(newStart, actual.StartTime)
+	s.Require().Equal(int32(1260), actual.EndTime)
+}
+
+func (s *RoomBlackoutRepositorySuite) TestUpdate_DayOffTheDate() {
+	date := "2025-09-01" // a Monday
+	blackout, createErr := s.repo.Create(s.ctx, models.NewRoomBlackout(uuid.New(), s.testRoom.ID, 0, 480, 600, models.RecurrenceOnce, &date, nil, nil, nil))
+
+	thursday := int32(3)
+	actual, updateErr := s.repo.Update(s.ctx, blackout.ID, &models.RoomBlackoutUpdate{Day: &thursday})
+
+	s.Require().NoError(createErr)
+	s.Require().ErrorIs(updateErr, repository.ErrInvalidInput)
+	s.Require().ErrorContains(updateErr, "does not match the weekday")
+	s.Require().Nil(actual)
+
+	stored, err := s.repo.GetByID(s.ctx, blackout.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(int32(0), stored.Day)
+}
+
+func (s *RoomBlackoutRepositorySuite) TestUpdate_StartAfterStoredEnd() {
+	blackout, createErr := s.repo.Create(s.ctx, s.weekly(4, 480, 600))
+
+	newStart := int32(660)
+	actual, updateErr := s.repo.Update(s.ctx, blackout.ID, &models.RoomBlackoutUpdate{StartTime: &newStart})
+
+	s.Require().NoError(createErr)
+	s.Require().ErrorIs(updateErr, repository.ErrInvalidInput)
+	s.Require().ErrorContains(updateErr, "end_time must be after start_time")
+	s.Require().Nil(actual)
+}
+
+func (s *RoomBlackoutRepositorySuite) TestUpdate_ErrNotFound() {
+	newStart := int32(1020)
+	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.RoomBlackoutUpdate{StartTime: &newStart})
+
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestDelete
+func (s *RoomBlackoutRepositorySuite) TestDelete_Success() {
+	blackout, _ := s.repo.Create(s.ctx, s.weekly(0, 480, 540))
+
+	err := s.repo.Delete(s.ctx, blackout.ID)
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, blackout.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *RoomBlackoutRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestRoomBlackoutRepositorySuite
+func TestRoomBlackoutRepositorySuite(t *testing.T) {
+	suite.Run(t, new(RoomBlackoutRepositorySuite))
+}
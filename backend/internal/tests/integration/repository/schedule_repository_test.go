@@ -16,12 +16,14 @@ type ScheduleRepositorySuite struct {
 	ctx    context.Context
 	testDB *utils.TestDB
 	repo   repository.ScheduleRepositoryInterface
+	terms  repository.TermRepositoryInterface
 }
 
 func (s *ScheduleRepositorySuite) SetupSuite() {
 	s.ctx = context.Background()
 	s.testDB = utils.NewTestDB(s.T())
 	s.repo = repository.NewScheduleRepository(s.testDB.DB, s.testDB.Logger)
+	s.terms = repository.NewTermRepository(s.testDB.DB, s.testDB.Logger)
 
 	// Setup test user context for RLS and created_by trigger
 	_, err := s.testDB.SetupTestUserContext()
@@ -36,6 +38,13 @@ func (s *ScheduleRepositorySuite) TearDownSuite() {
 
 func (s *ScheduleRepositorySuite) TearDownTest() {
 	s.testDB.Truncate("scheduler.schedules")
+	s.testDB.Truncate("scheduler.terms")
+}
+
+func (s *ScheduleRepositorySuite) createTerm(name, startDate, endDate string) *models.Term {
+	term, err := s.terms.Create(s.ctx, models.NewTerm(uuid.New(), name, startDate, endDate, 12, nil, nil, nil, nil))
+	s.Require().NoError(err)
+	return term
 }
 
 func (s *ScheduleRepositorySuite) createTestSchedule(name string) *models.Schedule {
@@ -243,6 +252,24 @@ func (s *ScheduleRepositorySuite) TestUpdate_Sessions_Success() {
 	s.Require().Equal(2, actual.Sessions[1].Day) // Wednesday
 }
 
+func (s *ScheduleRepositorySuite) TestUpdate_Pins_Success() {
+	expected := s.createTestSchedule("Fall 2025")
+	roomID := uuid.New()
+	expected.Pins = []models.SessionPin{
+		{CourseSessionID: uuid.New(), Day: 1, StartTime: 600, RoomID: &roomID},
+	}
+
+	created, err := s.repo.Create(s.ctx, expected)
+	s.Require().NoError(err)
+	s.Require().Equal(expected.Pins, created.Pins)
+
+	// An empty list clears the pins
+	actual, err := s.repo.Update(s.ctx, created.ID, &models.ScheduleUpdate{Pins: []models.SessionPin{}})
+
+	s.Require().NoError(err)
+	s.Require().Empty(actual.Pins)
+}
+
 func (s *ScheduleRepositorySuite) TestUpdate_NotFound() {
 	newName := "Updated"
 	updates := &models.ScheduleUpdate{
@@ -289,6 +316,27 @@ func (s *ScheduleRepositorySuite) TestSetActive_Success() {
 	s.Require().False(schedule2Updated.IsActive)
 }
 
+func (s *ScheduleRepositorySuite) TestGetActive_Success() {
+	s.repo.Create(s.ctx, s.createTestSchedule("Fall 2025"))
+	spring, _ := s.repo.Create(s.ctx, s.createTestSchedule("Spring 2026"))
+	s.repo.SetActive(s.ctx, spring.ID)
+
+	actual, err := s.repo.GetActive(s.ctx, nil)
+
+	s.Require().NoError(err)
+	s.Require().Equal(spring.ID, actual.ID)
+	s.Require().True(actual.IsActive)
+}
+
+func (s *ScheduleRepositorySuite) TestGetActive_NotFoundError() {
+	s.repo.Create(s.ctx, s.createTestSchedule("Fall 2025"))
+
+	_, err := s.repo.GetActive(s.ctx, nil)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
 func (s *ScheduleRepositorySuite) TestSetActive_NotFound() {
 	_, err := s.repo.SetActive(s.ctx, uuid.New())
 
@@ -373,6 +421,77 @@ func (s *ScheduleRepositorySuite) TestList_ExcludesArchived() {
 	s.Require().Equal(schedule2.ID, actual[0].ID) // Only non-archived schedule
 }
 
+// TestTerms
+func (s *ScheduleRepositorySuite) TestCreate_SameNameInDifferentTerms() {
+	fall := s.createTerm("Fall 2025", "2025-09-01", "2025-12-19")
+	spring := s.createTerm("Spring 2026", "2026-01-12", "2026-05-01")
+
+	first := s.createTestSchedule("Draft")
+	first.TermID = &fall.ID
+	second := s.createTestSchedule("Draft")
+	second.TermID = &spring.ID
+	duplicate := s.createTestSchedule("Draft")
+	duplicate.TermID = &fall.ID
+
+	_, firstErr := s.repo.Create(s.ctx, first)
+	actual, secondErr := s.repo.Create(s.ctx, second)
+	_, duplicateErr := s.repo.Create(s.ctx, duplicate)
+
+	s.Require().NoError(firstErr)
+	s.Require().NoError(secondErr)
+	s.Require().Equal(&spring.ID, actual.TermID)
+	s.Require().Error(duplicateErr)
+}
+
+func (s *ScheduleRepositorySuite) TestSetActive_OnePerTerm() {
+	fall := s.createTerm("Fall 2025", "2025-09-01", "2025-12-19")
+	spring := s.createTerm("Spring 2026", "2026-01-12", "2026-05-01")
+
+	fallDraft := s.createTestSchedule("Draft")
+	fallDraft.TermID = &fall.ID
+	fallFinal := s.createTestSchedule("Final")
+	fallFinal.TermID = &fall.ID
+	springFinal := s.createTestSchedule("Final")
+	springFinal.TermID = &spring.ID
+	for _, schedule := range []*models.Schedule{fallDraft, fallFinal, springFinal} {
+		_, err := s.repo.Create(s.ctx, schedule)
+		s.Require().NoError(err)
+	}
+
+	_, err := s.repo.SetActive(s.ctx, springFinal.ID)
+	s.Require().NoError(err)
+	_, err = s.repo.SetActive(s.ctx, fallDraft.ID)
+	s.Require().NoError(err)
+	_, err = s.repo.SetActive(s.ctx, fallFinal.ID)
+	s.Require().NoError(err)
+
+	// Activating a fall schedule leaves the spring one active
+	activeFall, fallErr := s.repo.GetActive(s.ctx, &fall.ID)
+	activeSpring, springErr := s.repo.GetActive(s.ctx, &spring.ID)
+	_, noTermErr := s.repo.GetActive(s.ctx, nil)
+
+	s.Require().NoError(fallErr)
+	s.Require().NoError(springErr)
+	s.Require().Equal(fallFinal.ID, activeFall.ID)
+	s.Require().Equal(springFinal.ID, activeSpring.ID)
+	s.Require().ErrorIs(noTermErr, repository.ErrNotFound)
+}
+
+func (s *ScheduleRepositorySuite) TestListByTerm() {
+	fall := s.createTerm("Fall 2025", "2025-09-01", "2025-12-19")
+
+	inTerm := s.createTestSchedule("Fall draft")
+	inTerm.TermID = &fall.ID
+	s.repo.Create(s.ctx, inTerm)
+	s.repo.Create(s.ctx, s.createTestSchedule("Untermed"))
+
+	actual, err := s.repo.ListByTerm(s.ctx, fall.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 1)
+	s.Require().Equal(inTerm.ID, actual[0].ID)
+}
+
 // TestScheduleRepositorySuite
 func TestScheduleRepositorySuite(t *testing.T) {
 	suite.Run(t, new(ScheduleRepositorySuite))
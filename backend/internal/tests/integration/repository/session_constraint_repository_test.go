@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionConstraintRepositorySuite struct {
+	suite.Suite
+	ctx        context.Context
+	testDB     *utils.TestDB
+	repo       repository.SessionConstraintRepositoryInterface
+	courseRepo repository.CourseRepositoryInterface
+	testCourse *models.Course
+}
+
+func (s *SessionConstraintRepositorySuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewSessionConstraintRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+
+	// Setup test user context for RLS and created_by trigger
+	_, err := s.testDB.SetupTestUserContext()
+	if err != nil {
+		s.T().Fatalf("failed to setup test user context: %v", err)
+	}
+}
+
+func (s *SessionConstraintRepositorySuite) SetupTest() {
+	// Create a fresh course before each test
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), "Test Course", nil, nil))
+	s.Require().NoError(err)
+	s.testCourse = course
+}
+
+func (s *SessionConstraintRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *SessionConstraintRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.session_constraints")
+	s.testDB.Truncate("scheduler.courses")
+}
+
+func (s *SessionConstraintRepositorySuite) after(first, second string) *models.SessionConstraint {
+	return models.NewSessionConstraint(uuid.New(), s.testCourse.ID, models.SessionConstraintAfter, first, second, nil, nil, nil)
+}
+
+// TestCreate
+func (s *SessionConstraintRepositorySuite) TestCreate_Success() {
+	expected := s.after("lecture", "lab")
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal(s.testCourse.ID, actual.CourseID)
+	s.Require().Equal(models.SessionConstraintAfter, actual.Kind)
+	s.Require().Equal("lecture", actual.FirstType)
+	s.Require().Equal("lab", actual.SecondType)
+	s.Require().Nil(actual.MinDays)
+}
+
+func (s *SessionConstraintRepositorySuite) TestCreate_MinDaysApart() {
+	minDays := int32(2)
+	expected := models.NewSessionConstraint(uuid.New(), s.testCourse.ID, models.SessionConstraintMinDaysApart, "lecture", "lecture", &minDays, nil, nil)
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(actual.MinDays)
+	s.Require().Equal(minDays, *actual.MinDays)
+}
+
+func (s *SessionConstraintRepositorySuite) TestCreate_ValidationError() {
+	minDays := int32(7)
+	cases := map[string]*models.SessionConstraint{
+		"same type after":        s.after("lab", "lab"),
+		"unknown session type":   s.after("lecture", "seminar"),
+		"unknown kind":           models.NewSessionConstraint(uuid.New(), s.testCourse.ID, "before", "lecture", "lab", nil, nil, nil),
+		"min days out of range":  models.NewSessionConstraint(uuid.New(), s.testCourse.ID, models.SessionConstraintMinDaysApart, "lecture", "lab", &minDays, nil, nil),
+		"min days missing":       models.NewSessionConstraint(uuid.New(), s.testCourse.ID, models.SessionConstraintMinDaysApart, "lecture", "lab", nil, nil, nil),
+		"min days on after rule": models.NewSessionConstraint(uuid.New(), s.testCourse.ID, models.SessionConstraintAfter, "lecture", "lab", &minDays, nil, nil),
+	}
+
+	for name, constraint := range cases {
+		_, err := s.repo.Create(s.ctx, constraint)
+
+		s.Require().Error(err, name)
+		s.Require().ErrorContains(err, "validation failed:", name)
+	}
+}
+
+// TestGetByCourseID
+func (s *SessionConstraintRepositorySuite) TestGetByCourseID_Success() {
+	// GetByCourseID orders by kind, then first and second type
+	expected1, _ := s.repo.Create(s.ctx, s.after("lecture", "tutorial"))
+	expected2, _ := s.repo.Create(s.ctx, s.after("lecture", "lab"))
+
+	actual, err := s.repo.GetByCourseID(s.ctx, s.testCourse.ID)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(expected2.ID, actual[0].ID)
+	s.Require().Equal(expected1.ID, actual[1].ID)
+}
+
+func (s *SessionConstraintRepositorySuite) TestDeleteCourse_CascadesConstraints() {
+	_, err := s.repo.Create(s.ctx, s.after("lecture", "lab"))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.courseRepo.Delete(s.ctx, s.testCourse.ID))
+
+	actual, err := s.repo.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 0)
+}
+
+// TestDelete
+func (s *SessionConstraintRepositorySuite) TestDelete_Success() {
+	constraint, _ := s.repo.Create(s.ctx, s.after("lecture", "lab"))
+
+	err := s.repo.Delete(s.ctx, constraint.ID)
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, constraint.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *SessionConstraintRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestSessionConstraintRepositorySuite
+func TestSessionConstraintRepositorySuite(t *testing.T) {
+	suite.Run(t, new(SessionConstraintRepositorySuite))
+}
@@ -0,0 +1,217 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type TermRepositorySuite struct {
+	suite.Suite
+	repo       repository.TermRepositoryInterface
+	courseRepo repository.CourseRepositoryInterface
+	testDB     *utils.TestDB
+	ctx        context.Context
+}
+
+func (s *TermRepositorySuite) SetupSuite() {
+	s.testDB = utils.NewTestDB(s.T())
+	s.repo = repository.NewTermRepository(s.testDB.DB, s.testDB.Logger)
+	s.courseRepo = repository.NewCourseRepository(s.testDB.DB, s.testDB.Logger)
+	s.ctx = context.Background()
+
+	// Setup test user context for RLS and created_by trigger
+	_, err := s.testDB.SetupTestUserContext()
+	if err != nil {
+		s.T().Fatalf("failed to setup test user context: %v", err)
+	}
+}
+
+func (s *TermRepositorySuite) TearDownSuite() {
+	s.testDB.Close()
+}
+
+func (s *TermRepositorySuite) TearDownTest() {
+	s.testDB.Truncate("scheduler.term_courses")
+	s.testDB.Truncate("scheduler.terms")
+	s.testDB.Truncate("scheduler.courses")
+}
+
+func (s *TermRepositorySuite) createCourse(name string) *models.Course {
+	course, err := s.courseRepo.Create(s.ctx, models.NewCourse(uuid.New(), name, nil, nil))
+	s.Require().NoError(err)
+	return course
+}
+
+func newFallTerm(courseIDs []uuid.UUID) *models.Term {
+	return models.NewTerm(uuid.New(), "Fall 2025", "2025-09-01", "2025-12-19", 14,
+		[]models.TermHoliday{{Name: "Reading week", StartDate: "2025-10-20", EndDate: "2025-10-24"}},
+		courseIDs, nil, nil)
+}
+
+// TestCreate
+func (s *TermRepositorySuite) TestCreate_Success() {
+	course := s.createCourse("Math 101")
+	expected := newFallTerm([]uuid.UUID{course.ID})
+
+	actual, err := s.repo.Create(s.ctx, expected)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected.ID, actual.ID)
+	s.Require().Equal("2025-09-01", actual.StartDate)
+	s.Require().Equal("2025-12-19", actual.EndDate)
+	s.Require().Equal(int32(14), actual.TeachingWeeks)
+	s.Require().Equal(expected.Holidays, actual.Holidays)
+	s.Require().Equal([]uuid.UUID{course.ID}, actual.CourseIDs)
+}
+
+func (s *TermRepositorySuite) TestCreate_ValidationError() {
+	term := newFallTerm(nil)
+	term.EndDate = "2025-08-01"
+
+	_, err := s.repo.Create(s.ctx, term)
+
+	s.Require().Error(err)
+	s.Require().ErrorContains(err, "validation failed:")
+}
+
+// TestGetByID
+func (s *TermRepositorySuite) TestGetByID_Success() {
+	course := s.createCourse("Math 101")
+	term, createErr := s.repo.Create(s.ctx, newFallTerm([]uuid.UUID{course.ID}))
+
+	actual, err := s.repo.GetByID(s.ctx, term.ID)
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(err)
+	s.Require().Equal(term.Name, actual.Name)
+	s.Require().Equal(term.Holidays, actual.Holidays)
+	s.Require().True(actual.Offers(course.ID))
+}
+
+func (s *TermRepositorySuite) TestGetByID_NotFoundError() {
+	_, err := s.repo.GetByID(s.ctx, uuid.New())
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestList
+func (s *TermRepositorySuite) TestList_Success() {
+	// List orders by StartDate ASC
+	spring, _ := s.repo.Create(s.ctx, models.NewTerm(uuid.New(), "Spring 2026", "2026-01-12", "2026-05-01", 15, nil, nil, nil, nil))
+	fall, _ := s.repo.Create(s.ctx, newFallTerm(nil))
+
+	actual, err := s.repo.List(s.ctx)
+
+	s.Require().NoError(err)
+	s.Require().Len(actual, 2)
+	s.Require().Equal(fall.ID, actual[0].ID)
+	s.Require().Equal(spring.ID, actual[1].ID)
+	s.Require().Empty(actual[1].Holidays)
+	s.Require().Empty(actual[1].CourseIDs)
+}
+
+// TestUpdate
+func (s *TermRepositorySuite) TestUpdate_ReplacesCoursesAndHolidays() {
+	course1 := s.createCourse("Math 101")
+	course2 := s.createCourse("Physics 101")
+	term, createErr := s.repo.Create(s.ctx, newFallTerm([]uuid.UUID{course1.ID}))
+
+	weeks := int32(12)
+	actual, updateErr := s.repo.Update(s.ctx, term.ID, &models.TermUpdate{
+		TeachingWeeks: &weeks,
+		Holidays:      []models.TermHoliday{},
+		CourseIDs:     []uuid.UUID{course2.ID},
+	})
+
+	s.Require().NoError(createErr)
+	s.Require().NoError(updateErr)
+	s.Require().Equal(weeks, actual.TeachingWeeks)
+	s.Require().Empty(actual.Holidays)
+	s.Require().Equal([]uuid.UUID{course2.ID}, actual.CourseIDs)
+}
+
+func (s *TermRepositorySuite) TestUpdate_ErrNotFound() {
+	weeks := int32(12)
+	actual, err := s.repo.Update(s.ctx, uuid.New(), &models.TermUpdate{TeachingWeeks: &weeks})
+
+	s.Require().Error(err)
+	s.Require().Nil(actual)
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+func (s *TermRepositorySuite) TestUpdate_StartDateAfterEndDate() {
+	term, createErr := s.repo.Create(s.ctx, newFallTerm(nil))
+
+	startDate := "2026-01-05"
+	actual, updateErr := s.repo.Update(s.ctx, term.ID, &models.TermUpdate{StartDate: &startDate})
+
+	s.Require().NoError(createErr)
+	s.Require().ErrorContains(updateErr, "end_date must not be before start_date")
+	s.Require().Nil(actual)
+	s.requireUnchanged(term)
+}
+
+func (s *TermRepositorySuite) TestUpdate_TeachingWeeksLongerThanTerm() {
+	term, createErr := s.repo.Create(s.ctx, newFallTerm(nil))
+
+	weeks := int32(20)
+	actual, updateErr := s.repo.Update(s.ctx, term.ID, &models.TermUpdate{TeachingWeeks: &weeks})
+
+	s.Require().NoError(createErr)
+	s.Require().ErrorContains(updateErr, "teaching_weeks cannot exceed")
+	s.Require().Nil(actual)
+	s.requireUnchanged(term)
+}
+
+func (s *TermRepositorySuite) TestUpdate_HolidayOutsideTerm() {
+	term, createErr := s.repo.Create(s.ctx, newFallTerm(nil))
+
+	actual, updateErr := s.repo.Update(s.ctx, term.ID, &models.TermUpdate{
+		Holidays: []models.TermHoliday{{Name: "Winter break", StartDate: "2025-12-22", EndDate: "2026-01-02"}},
+	})
+
+	s.Require().NoError(createErr)
+	s.Require().ErrorContains(updateErr, "must fall within the term")
+	s.Require().Nil(actual)
+	s.requireUnchanged(term)
+}
+
+// requireUnchanged checks the stored term still matches the given one
+func (s *TermRepositorySuite) requireUnchanged(term *models.Term) {
+	stored, err := s.repo.GetByID(s.ctx, term.ID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(term.StartDate, stored.StartDate)
+	s.Require().Equal(term.EndDate, stored.EndDate)
+	s.Require().Equal(term.TeachingWeeks, stored.TeachingWeeks)
+	s.Require().Equal(term.Holidays, stored.Holidays)
+}
+
+// TestDelete
+func (s *TermRepositorySuite) TestDelete_Success() {
+	term, _ := s.repo.Create(s.ctx, newFallTerm(nil))
+
+	err := s.repo.Delete(s.ctx, term.ID)
+	s.Require().NoError(err)
+
+	_, getErr := s.repo.GetByID(s.ctx, term.ID)
+	s.Require().ErrorIs(getErr, repository.ErrNotFound)
+}
+
+func (s *TermRepositorySuite) TestDelete_NotFound() {
+	err := s.repo.Delete(s.ctx, uuid.New())
+
+	s.Require().ErrorIs(err, repository.ErrNotFound)
+}
+
+// TestTermRepositorySuite
+func TestTermRepositorySuite(t *testing.T) {
+	suite.Run(t, new(TermRepositorySuite))
+}
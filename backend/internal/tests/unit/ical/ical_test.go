@@ -0,0 +1,82 @@
+package ical_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/ical"
+)
+
+func encode(t *testing.T, calendar *ical.Calendar) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, calendar.Encode(&buf))
+	return buf.String()
+}
+
+func TestCalendar_Encode(t *testing.T) {
+	calendar := &ical.Calendar{
+		Name: "Fall 2025",
+		Events: []ical.Event{{
+			UID:         "abc-0@course-scheduler",
+			Stamp:       time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC),
+			Summary:     "Math 101 Lecture",
+			Location:    "Room 101, Science",
+			Description: "Instructor: Dr. Smith\nSection 1 of 2",
+			Start:       time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC),
+			End:         time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC),
+			Until:       time.Date(2025, 9, 29, 0, 0, 0, 0, time.UTC),
+			ExDates:     []time.Time{time.Date(2025, 9, 15, 9, 0, 0, 0, time.UTC)},
+		}},
+	}
+
+	out := encode(t, calendar)
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Contains(t, out, "X-WR-CALNAME:Fall 2025\r\n")
+	assert.Contains(t, out, "DTSTAMP:20250801T120000Z\r\n")
+	assert.Contains(t, out, "DTSTART:20250901T090000\r\n")
+	assert.Contains(t, out, "DTEND:20250901T100000\r\n")
+	assert.Contains(t, out, "RRULE:FREQ=WEEKLY;UNTIL=20250929T235959\r\n")
+	assert.Contains(t, out, "EXDATE:20250915T090000\r\n")
+	assert.Contains(t, out, `LOCATION:Room 101\, Science`+"\r\n")
+	assert.Contains(t, out, `DESCRIPTION:Instructor: Dr. Smith\nSection 1 of 2`+"\r\n")
+}
+
+func TestCalendar_Encode_SingleOccurrence(t *testing.T) {
+	calendar := &ical.Calendar{Events: []ical.Event{{
+		UID:     "abc-1@course-scheduler",
+		Summary: "Physics 101",
+		Start:   time.Date(2025, 9, 5, 11, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, 9, 5, 12, 0, 0, 0, time.UTC),
+	}}}
+
+	out := encode(t, calendar)
+
+	assert.NotContains(t, out, "RRULE")
+	assert.NotContains(t, out, "EXDATE")
+	assert.NotContains(t, out, "LOCATION")
+	assert.NotContains(t, out, "X-WR-CALNAME")
+}
+
+func TestCalendar_Encode_FoldsLongLines(t *testing.T) {
+	summary := strings.Repeat("é", 60) // 120 octets
+	calendar := &ical.Calendar{Events: []ical.Event{{UID: "abc-2@course-scheduler", Summary: summary}}}
+
+	out := encode(t, calendar)
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, strings.ToValidUTF8(line, "") == line, "line %q splits a character", line)
+	}
+
+	// Unfolding restores the value
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	assert.Contains(t, unfolded, "SUMMARY:"+summary+"\r\n")
+}
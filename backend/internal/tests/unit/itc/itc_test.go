@@ -0,0 +1,214 @@
+package itc_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/itc"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// toy is the example instance from the competition's description of the format
+const toy = `Name: Toy
+Courses: 4
+Rooms: 3
+Days: 5
+Periods_per_day: 4
+Curricula: 2
+Constraints: 8
+
+COURSES:
+SceCosC Ocra 3 3 30
+ArcTec Indaco 3 2 42
+TecCos Rosa 5 4 40
+Geotec Scarlatti 5 4 18
+
+ROOMS:
+A	32
+B	50
+C	40
+
+CURRICULA:
+Cur1 3 SceCosC ArcTec TecCos
+Cur2 2 TecCos Geotec
+
+UNAVAILABILITY_CONSTRAINTS:
+TecCos 2 0
+TecCos 2 1
+TecCos 3 2
+TecCos 3 3
+ArcTec 4 0
+ArcTec 4 1
+ArcTec 4 2
+ArcTec 4 3
+
+END.
+`
+
+func parseToy(t *testing.T) *itc.Instance {
+	t.Helper()
+	instance, err := itc.Parse(strings.NewReader(toy))
+	require.NoError(t, err)
+	return instance
+}
+
+func TestParse(t *testing.T) {
+	instance := parseToy(t)
+
+	assert.Equal(t, "Toy", instance.Name)
+	assert.Equal(t, 5, instance.Days)
+	assert.Equal(t, 4, instance.PeriodsPerDay)
+	require.Len(t, instance.Courses, 4)
+	assert.Equal(t, itc.Course{ID: "TecCos", Teacher: "Rosa", Lectures: 5, MinWorkingDays: 4, Students: 40}, instance.Courses[2])
+	assert.Equal(t, []itc.Room{{ID: "A", Capacity: 32}, {ID: "B", Capacity: 50}, {ID: "C", Capacity: 40}}, instance.Rooms)
+	assert.Equal(t, itc.Curriculum{ID: "Cur2", Courses: []string{"TecCos", "Geotec"}}, instance.Curricula[1])
+	require.Len(t, instance.Unavailability, 8)
+	assert.Equal(t, itc.Unavailability{Course: "ArcTec", Day: 4, Period: 3}, instance.Unavailability[7])
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		err      string
+	}{
+		{"unknown course", "Cur2 2 TecCos Geotec", "Cur2 2 TecCos Math", `line 22: unknown course "Math"`},
+		{"wrong number of courses", "Cur2 2 TecCos Geotec", "Cur2 3 TecCos Geotec", "line 22: expected 3 courses, found 2"},
+		{"bad number", "B\t50", "B\tfifty", `line 17: expected a whole number, found "fifty"`},
+		{"outside the week", "ArcTec 4 3", "ArcTec 5 0", "line 32: day 5, period 0 is outside the week"},
+		{"missing section", "ROOMS:", "SALE:", `line 15: expected "ROOMS:"`},
+		{"truncated", "END.", "", "unexpected end of file"},
+		{"too many days", "Days: 5", "Days: 8", "days must be between 1 and 7, got 8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := itc.Parse(strings.NewReader(strings.Replace(toy, tt.from, tt.to, 1)))
+			require.Error(t, err)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestInstance_Input(t *testing.T) {
+	instance := parseToy(t)
+	instance.Rooms[1].Capacity = 35 // smaller than ArcTec's 42 students
+
+	input := instance.Input(scheduler.Config{MaxDuration: 1000})
+
+	assert.Equal(t, []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday, scheduler.Thursday, scheduler.Friday}, input.Config.OperatingDays)
+	assert.Equal(t, scheduler.TimeRange{Start: itc.DayStart, End: itc.DayStart + 4*itc.PeriodMinutes}, input.Config.OperatingHours)
+	assert.Equal(t, itc.PeriodMinutes, input.Config.PreferredSlotDuration)
+	assert.Equal(t, 1000, input.Config.MaxDuration)
+
+	assert.Len(t, input.Rooms, 3)
+	assert.Len(t, input.Courses, 4)
+	assert.Len(t, input.Instructors, 4)
+	require.Len(t, input.CourseSessions, 4)
+	require.Len(t, input.Cohorts, 2)
+
+	arcTec := input.CourseSessions[1]
+	assert.Equal(t, input.Courses[1].ID, arcTec.CourseID)
+	assert.Equal(t, int32(3), *arcTec.NumberOfSessions)
+	assert.Equal(t, int32(40), *arcTec.ExpectedEnrollment, "enrollment is capped at the largest room")
+	assert.Equal(t, int32(42), *input.Courses[1].ExpectedEnrollment)
+	assert.Equal(t, input.Instructors[1].ID, *arcTec.InstructorID)
+
+	assert.Equal(t, "Cur1", input.Cohorts[0].Programme)
+	assert.Equal(t, []uuid.UUID{input.Courses[0].ID, input.Courses[1].ID, input.Courses[2].ID}, input.Cohorts[0].CourseIDs)
+}
+
+func TestInstance_Evaluate(t *testing.T) {
+	instance := parseToy(t)
+
+	report := instance.Evaluate([]itc.Assignment{
+		// SceCosC: 2 of 3 lectures, both on day 0 in different rooms
+		{Course: "SceCosC", Room: "A", Day: 0, Period: 0},
+		{Course: "SceCosC", Room: "C", Day: 0, Period: 1},
+		// ArcTec: 3 lectures, one in the same period as SceCosC of its curriculum, one unavailable
+		{Course: "ArcTec", Room: "B", Day: 0, Period: 0},
+		{Course: "ArcTec", Room: "B", Day: 1, Period: 0},
+		{Course: "ArcTec", Room: "B", Day: 4, Period: 2},
+		// TecCos: 5 lectures on 4 days, one sharing room A with SceCosC
+		{Course: "TecCos", Room: "A", Day: 0, Period: 0},
+		{Course: "TecCos", Room: "C", Day: 1, Period: 1},
+		{Course: "TecCos", Room: "C", Day: 1, Period: 2},
+		{Course: "TecCos", Room: "C", Day: 2, Period: 3},
+		{Course: "TecCos", Room: "C", Day: 3, Period: 0},
+		// Geotec: 5 lectures on 5 days
+		{Course: "Geotec", Room: "A", Day: 0, Period: 3},
+		{Course: "Geotec", Room: "A", Day: 1, Period: 3},
+		{Course: "Geotec", Room: "A", Day: 2, Period: 1},
+		{Course: "Geotec", Room: "A", Day: 3, Period: 3},
+		{Course: "Geotec", Room: "A", Day: 4, Period: 3},
+	})
+
+	assert.Equal(t, 1, report.Lectures, "SceCosC is missing a lecture")
+	// Day 0 period 0: SceCosC-ArcTec, SceCosC-TecCos and ArcTec-TecCos all in Cur1
+	assert.Equal(t, 3, report.Conflicts)
+	assert.Equal(t, 1, report.Availability, "ArcTec on day 4")
+	assert.Equal(t, 1, report.RoomOccupancy, "room A on day 0 period 0")
+	// Only TecCos overflows a room, with 40 students in A's 32 seats
+	assert.Equal(t, 8, report.RoomCapacity)
+	// SceCosC meets on 1 of 3 days, ArcTec on 3 of 2, TecCos on 4 of 4, Geotec on 5 of 4
+	assert.Equal(t, 2*itc.MinWorkingDaysWeight, report.MinWorkingDays)
+	assert.Equal(t, 1+1, report.RoomStability, "SceCosC in A and C, TecCos in A and C")
+
+	// Cur1 has isolated lectures on days 2, 3 and 4; Cur2 has two on each of days 0, 2 and 3,
+	// and one on day 4
+	assert.Equal(t, 10*itc.CurriculumCompactnessWeight, report.CurriculumCompactness)
+
+	assert.Equal(t, 6, report.Violations())
+	assert.Equal(t, report.RoomCapacity+report.MinWorkingDays+report.CurriculumCompactness+report.RoomStability, report.Cost())
+}
+
+func TestInstance_Assign(t *testing.T) {
+	instance := parseToy(t)
+	input := instance.Input(*scheduler.DefaultConfig())
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	require.Empty(t, output.Failures)
+
+	assignments, err := instance.Assign(output.ScheduledSessions)
+	require.NoError(t, err)
+	assert.Len(t, assignments, 16)
+
+	// The scheduler keeps every hard constraint it models
+	report := instance.Evaluate(assignments)
+	assert.Zero(t, report.Lectures)
+	assert.Zero(t, report.Conflicts)
+	assert.Zero(t, report.RoomOccupancy)
+
+	var solution bytes.Buffer
+	require.NoError(t, itc.WriteSolution(&solution, assignments))
+	assert.Equal(t, 16, strings.Count(solution.String(), "\n"))
+
+	var out bytes.Buffer
+	require.NoError(t, report.Write(&out))
+	assert.Contains(t, out.String(), "Violations of Lectures (hard) : 0\n")
+	assert.Contains(t, out.String(), "Summary: Violations = ")
+}
+
+func TestInstance_Assign_RejectsOtherSessions(t *testing.T) {
+	instance := parseToy(t)
+	input := instance.Input(*scheduler.DefaultConfig())
+
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	session := *output.ScheduledSessions[0]
+	session.StartTime += 30
+	session.EndTime += 30
+
+	_, err = instance.Assign(append(output.ScheduledSessions, &session))
+	assert.ErrorContains(t, err, "doesn't fill one period")
+}
@@ -0,0 +1,101 @@
+package pdf_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/pdf"
+)
+
+func encode(t *testing.T, document *pdf.Document) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, document.Encode(&buf))
+	return buf.Bytes()
+}
+
+// contents inflates the content streams of a document, in page order
+func contents(t *testing.T, data []byte) []string {
+	t.Helper()
+	var pages []string
+	for _, match := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(data, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(match[1]))
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		pages = append(pages, string(content))
+	}
+	return pages
+}
+
+func TestDocument_Encode(t *testing.T) {
+	document := &pdf.Document{Title: "Fall (2025)", Created: time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)}
+	page := document.AddPage(pdf.A4Height, pdf.A4Width)
+	page.SetFillGray(0.9)
+	page.FillRect(36, 100, 50, 20.5)
+	page.SetLineWidth(0.5)
+	page.Line(36, 100, 100, 100)
+	page.Text(36, 54, pdf.HelveticaBold, 18, `Room 101 \ Café`)
+	document.AddPage(pdf.A4Width, pdf.A4Height).Text(10, 20, pdf.Helvetica, 8, "Ω")
+
+	data := encode(t, document)
+	out := string(data)
+
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, "/Title (Fall \\(2025\\)) /CreationDate (D:20250801120000Z)")
+	assert.Contains(t, out, "/Kids [5 0 R 7 0 R] /Count 2")
+	assert.Contains(t, out, "/MediaBox [0 0 841.89 595.28]")
+	assert.Contains(t, out, "/MediaBox [0 0 595.28 841.89]")
+	assert.Contains(t, out, "/BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding")
+
+	// Every object starts where the cross-reference table says it does
+	xref := bytes.LastIndex(data, []byte("startxref\n"))
+	start, err := strconv.Atoi(strings.Fields(out[xref+len("startxref\n"):])[0])
+	require.NoError(t, err)
+	lines := strings.Split(out[start:], "\n")
+	require.Equal(t, "xref", lines[0])
+	require.Equal(t, "0 9", lines[1])
+	for id := 1; id <= 8; id++ {
+		offset, err := strconv.Atoi(strings.Fields(lines[2+id])[0])
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(out[offset:], strconv.Itoa(id)+" 0 obj\n"), "object %d", id)
+	}
+
+	// Coordinates are flipped to measure from the bottom of the page
+	pages := contents(t, data)
+	require.Len(t, pages, 2)
+	assert.Equal(t, "0.9 g\n36 474.78 50 20.5 re f\n0.5 w\n36 495.28 m 100 495.28 l S\n"+
+		"BT /F2 18 Tf 36 541.28 Td (Room 101 \\\\ Caf\\351) Tj ET\n", pages[0])
+	assert.Equal(t, "BT /F1 8 Tf 10 821.89 Td (?) Tj ET\n", pages[1])
+}
+
+func TestDocument_Encode_NoPages(t *testing.T) {
+	assert.Error(t, (&pdf.Document{}).Encode(io.Discard))
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.InDelta(t, 6.672, pdf.TextWidth(pdf.Helvetica, 12, "a"), 0.001)
+	assert.InDelta(t, 5*0.611*10, pdf.TextWidth(pdf.HelveticaBold, 10, "ddddd"), 0.001)
+	assert.Greater(t, pdf.TextWidth(pdf.HelveticaBold, 10, "Lab"), pdf.TextWidth(pdf.Helvetica, 10, "Lab"))
+}
+
+func TestFit(t *testing.T) {
+	assert.Equal(t, "Math 101", pdf.Fit(pdf.Helvetica, 10, 100, "Math 101"))
+
+	short := pdf.Fit(pdf.Helvetica, 10, 60, "Introduction to Programming")
+	assert.True(t, strings.HasSuffix(short, "..."))
+	assert.LessOrEqual(t, pdf.TextWidth(pdf.Helvetica, 10, short), 60.0)
+	assert.Equal(t, "Introductio...", short)
+
+	assert.Empty(t, pdf.Fit(pdf.Helvetica, 10, 5, "Math"))
+}
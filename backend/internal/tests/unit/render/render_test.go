@@ -0,0 +1,129 @@
+package render_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/render"
+)
+
+// pages inflates the content streams of an encoded PDF, in page order
+func pages(t *testing.T, data []byte) []string {
+	t.Helper()
+	var contents []string
+	for _, match := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(data, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(match[1]))
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		contents = append(contents, string(content))
+	}
+	return contents
+}
+
+func TestWriteTimetableCSV(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, render.WriteTimetableCSV(&out, grid()))
+
+	assert.Equal(t, "Day,Time,Lab 2 (Engineering),Room 101\n"+
+		"Monday,09:00-10:00,,\"Math 101 Lecture, Math 101\"\n"+
+		"Monday,10:00-11:00,,\n"+
+		"Wednesday,09:00-10:00,Physics 101 Lab,\n"+
+		"Wednesday,10:00-11:00,Physics 101 Lab,\n", out.String())
+}
+
+func TestTimetableWorkbook(t *testing.T) {
+	workbook := render.TimetableWorkbook(grid())
+
+	require.Len(t, workbook.Sheets, 2)
+	assert.Equal(t, "Monday", workbook.Sheets[0].Name)
+	assert.Equal(t, "Wednesday", workbook.Sheets[1].Name)
+	assert.Equal(t, [][]string{
+		{"Time", "Lab 2 (Engineering)", "Room 101"},
+		{"09:00-10:00", "Physics 101 Lab", ""},
+		{"10:00-11:00", "Physics 101 Lab", ""},
+	}, workbook.Sheets[1].Rows)
+	assert.True(t, workbook.Sheets[1].Header)
+}
+
+func TestTimetableWorkbook_Empty(t *testing.T) {
+	workbook := render.TimetableWorkbook(&models.Timetable{Name: "Empty", Slot: 60})
+
+	require.Len(t, workbook.Sheets, 1, "a workbook can't be empty")
+	assert.Equal(t, "Empty", workbook.Sheets[0].Name)
+	assert.Equal(t, [][]string{{"Time"}}, workbook.Sheets[0].Rows)
+}
+
+// grid is a timetable of two rooms on Monday and Wednesday mornings
+func grid() *models.Timetable {
+	return &models.Timetable{
+		Name: "Fall 2025",
+		Slot: 60,
+		Rooms: []models.TimetableRoom{
+			{ID: uuid.New(), Name: "Lab 2", Building: "Engineering"},
+			{ID: uuid.New(), Name: "Room 101"},
+		},
+		Days: []models.TimetableDay{
+			{Day: 0, Slots: []models.TimetableSlot{
+				{StartTime: 540, EndTime: 600, Cells: []string{"", "Math 101 Lecture, Math 101"}},
+				{StartTime: 600, EndTime: 660, Cells: []string{"", ""}},
+			}},
+			{Day: 2, Slots: []models.TimetableSlot{
+				{StartTime: 540, EndTime: 600, Cells: []string{"Physics 101 Lab", ""}},
+				{StartTime: 600, EndTime: 660, Cells: []string{"Physics 101 Lab", ""}},
+			}},
+		},
+	}
+}
+
+func TestTimetablePDF(t *testing.T) {
+	week := func(title string, entries ...models.TimetableEntry) *models.WeeklyTimetable {
+		return &models.WeeklyTimetable{
+			Title:     title,
+			Schedule:  "Fall 2025",
+			Slot:      60,
+			Days:      []int{0, 2},
+			StartTime: 540,
+			EndTime:   720,
+			Entries:   entries,
+		}
+	}
+	timetables := []*models.WeeklyTimetable{
+		week("Room 101 (Science)",
+			models.TimetableEntry{Day: 0, StartTime: 540, EndTime: 600, Lines: []string{"Math 101 Lecture", "Dr. Smith"}, Lanes: 1},
+			models.TimetableEntry{Day: 2, StartTime: 600, EndTime: 720, Lines: []string{"Physics 101 Lab"}, Lanes: 1},
+		),
+		week("Room 102 (Science)"),
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, render.TimetablePDF(timetables).Encode(&out))
+
+	assert.Contains(t, out.String(), "/Count 2")
+	assert.Contains(t, out.String(), "/Title (Fall 2025)")
+
+	contents := pages(t, out.Bytes())
+	require.Len(t, contents, 2)
+	for _, text := range []string{"(Room 101 \\(Science\\))", "(Monday)", "(Wednesday)", "(09:00)", "(Math 101 Lecture)", "(Dr. Smith)", "(09:00-10:00)", "(Physics 101 Lab)", "(10:00-12:00)"} {
+		assert.Contains(t, contents[0], text)
+	}
+	assert.Contains(t, contents[1], "(Room 102 \\(Science\\))")
+	assert.NotContains(t, contents[1], "Math 101")
+}
+
+func TestTimetablePDF_NoTimetables(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, render.TimetablePDF(nil).Encode(&out), "a building without rooms still prints a page")
+
+	contents := pages(t, out.Bytes())
+	require.Len(t, contents, 1)
+	assert.Contains(t, contents[0], "(No rooms)")
+}
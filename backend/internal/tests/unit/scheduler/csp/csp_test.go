@@ -0,0 +1,661 @@
+package csp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/csp"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+)
+
+// Test helpers
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(id uuid.UUID, name, roomType string) *models.Room {
+	return models.NewRoom(id, name, roomType, uuid.New(), 30, nil, nil)
+}
+
+func makeCourse(id uuid.UUID, name string) *models.Course {
+	return models.NewCourse(id, name, nil, nil)
+}
+
+func makeSession(id, courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(id, courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil)
+}
+
+// fixedWeight lets tests control the order the greedy scheduler visits courses in
+type fixedWeight map[uuid.UUID]int
+
+func (w fixedWeight) Calculate(sessions []*models.CourseSession) int {
+	if len(sessions) == 0 {
+		return 0
+	}
+	return w[sessions[0].CourseID]
+}
+
+// assertNoClashes checks that no room is double-booked
+func assertNoClashes(t *testing.T, sessions []*models.ScheduledSession) {
+	t.Helper()
+
+	for i, a := range sessions {
+		for _, b := range sessions[i+1:] {
+			overlaps := a.Day == b.Day && a.StartTime < b.EndTime && b.StartTime < a.EndTime
+			assert.False(t, overlaps && a.RoomID == b.RoomID, "Room %s is double-booked", a.RoomID)
+		}
+	}
+}
+
+// TestGenerate_SingleSession_Success tests scheduling a single session
+func TestGenerate_SingleSession_Success(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+
+	sched := csp.NewCSPScheduler()
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Empty(t, output.Failures)
+
+	scheduled := output.ScheduledSessions[0]
+	assert.Equal(t, roomID, scheduled.RoomID)
+	assert.Equal(t, courseID, scheduled.CourseID)
+	assert.Equal(t, 60, scheduled.EndTime-scheduled.StartTime)
+}
+
+// TestGenerate_SolvesWhereGreedyFails tests that search recovers from an early choice that blocks a later session.
+// Four courses form a chain of cohorts (A-B, B-C, C-D) with only two time slots. Visiting A and D first,
+// greedy puts both in the first slot, leaving no slot for C. Alternating slots along the chain solves it.
+func TestGenerate_SolvesWhereGreedyFails(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600}, // Two one-hour slots
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{
+			makeRoom(uuid.New(), "Room 101", "lecture"),
+			makeRoom(uuid.New(), "Lab 1", "lab"),
+		},
+		Courses: []*models.Course{
+			makeCourse(a, "A"), makeCourse(b, "B"), makeCourse(c, "C"), makeCourse(d, "D"),
+		},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), a, "lecture", 60, 1),
+			makeSession(uuid.New(), b, "lecture", 60, 1),
+			makeSession(uuid.New(), c, "lab", 60, 1),
+			makeSession(uuid.New(), d, "lab", 60, 1),
+		},
+		Cohorts: []*models.Cohort{
+			{ID: uuid.New(), Programme: "AB", Year: 1, CourseIDs: []uuid.UUID{a, b}},
+			{ID: uuid.New(), Programme: "BC", Year: 1, CourseIDs: []uuid.UUID{b, c}},
+			{ID: uuid.New(), Programme: "CD", Year: 1, CourseIDs: []uuid.UUID{c, d}},
+		},
+	}
+
+	greedyOutput, err := greedy.NewGreedyScheduler(fixedWeight{a: 4, d: 3, b: 2, c: 1}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	require.NotEmpty(t, greedyOutput.Failures, "greedy should get stuck on this input")
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.ScheduledSessions, 4)
+	assertNoClashes(t, output.ScheduledSessions)
+
+	starts := make(map[uuid.UUID]int)
+	for _, s := range output.ScheduledSessions {
+		starts[s.CourseID] = s.StartTime
+	}
+	assert.NotEqual(t, starts[a], starts[b])
+	assert.NotEqual(t, starts[b], starts[c])
+	assert.NotEqual(t, starts[c], starts[d])
+}
+
+// TestGenerate_InstructorNotDoubleBooked tests that sessions sharing an instructor never overlap
+func TestGenerate_InstructorNotDoubleBooked(t *testing.T) {
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	instructorID := uuid.New()
+
+	session1 := makeSession(uuid.New(), course1ID, "lecture", 60, 1)
+	session1.InstructorID = &instructorID
+	session2 := makeSession(uuid.New(), course2ID, "lecture", 60, 1)
+	session2.InstructorID = &instructorID
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{
+			makeRoom(uuid.New(), "Room 101", "lecture"),
+			makeRoom(uuid.New(), "Room 102", "lecture"),
+		},
+		Courses:        []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Math 201")},
+		CourseSessions: []*models.CourseSession{session1, session2},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+
+	s1, s2 := output.ScheduledSessions[0], output.ScheduledSessions[1]
+	overlaps := s1.StartTime < s2.EndTime && s2.StartTime < s1.EndTime
+	assert.False(t, overlaps, "Sessions with the same instructor should not overlap")
+	assert.Equal(t, instructorID, *s1.InstructorID)
+}
+
+// TestGenerate_TightestFitRoom tests that the smallest room that fits is preferred
+func TestGenerate_TightestFitRoom(t *testing.T) {
+	mediumRoomID := uuid.New()
+	courseID := uuid.New()
+
+	course := makeCourse(courseID, "Discrete Maths")
+	course.ExpectedEnrollment = ptr(int32(40))
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Rooms: []*models.Room{
+			models.NewRoom(uuid.New(), "Auditorium", "lecture", uuid.New(), 300, nil, nil),
+			models.NewRoom(mediumRoomID, "Room 101", "lecture", uuid.New(), 50, nil, nil),
+			models.NewRoom(uuid.New(), "Seminar Room", "lecture", uuid.New(), 20, nil, nil),
+		},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, mediumRoomID, output.ScheduledSessions[0].RoomID)
+	assert.Equal(t, 10, output.SeatUsage.WastedSeats)
+}
+
+// TestGenerate_InsufficientCapacity_Failure tests the failure reason when no room is large enough
+func TestGenerate_InsufficientCapacity_Failure(t *testing.T) {
+	courseID := uuid.New()
+
+	course := makeCourse(courseID, "Intro to Psychology")
+	course.ExpectedEnrollment = ptr(int32(250))
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInsufficientCapacity, output.Failures[0].Reason)
+}
+
+// TestGenerate_Infeasible_ReturnsBestPartial tests that an unsolvable input still places as much as possible
+func TestGenerate_Infeasible_ReturnsBestPartial(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	cohort := &models.Cohort{ID: uuid.New(), Programme: "BSc Computer Science", Year: 1, CourseIDs: []uuid.UUID{a, b, c}}
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600}, // Two one-hour slots for three cohort courses
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{
+			makeRoom(uuid.New(), "Room 101", "lecture"),
+			makeRoom(uuid.New(), "Room 102", "lecture"),
+			makeRoom(uuid.New(), "Room 103", "lecture"),
+		},
+		Courses: []*models.Course{makeCourse(a, "A"), makeCourse(b, "B"), makeCourse(c, "C")},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), a, "lecture", 60, 1),
+			makeSession(uuid.New(), b, "lecture", 60, 1),
+			makeSession(uuid.New(), c, "lecture", 60, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 2)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonCohortClash, output.Failures[0].Reason)
+	assert.Equal(t, 1, output.CohortClashes[cohort.ID])
+}
+
+// TestGenerate_NodeLimit_FallsBack tests that exhausting the node budget still returns a valid schedule
+func TestGenerate_NodeLimit_FallsBack(t *testing.T) {
+	courseID := uuid.New()
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:  scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:   []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			SearchNodeLimit: 1,
+		},
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 3)},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+	assertNoClashes(t, output.ScheduledSessions)
+}
+
+// TestGenerate_DeadlinePassed_ReturnsIncomplete tests that running out of time still returns a
+// valid schedule, flagged incomplete
+func TestGenerate_DeadlinePassed_ReturnsIncomplete(t *testing.T) {
+	courseID := uuid.New()
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	output, err := csp.NewCSPScheduler().Generate(ctx, &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 3)},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, output.Incomplete)
+	assertNoClashes(t, output.ScheduledSessions)
+}
+
+// TestGenerate_Solved_NotIncomplete tests that a search finishing within MaxDuration isn't flagged
+func TestGenerate_Solved_NotIncomplete(t *testing.T) {
+	courseID := uuid.New()
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			MaxDuration:    60_000,
+		},
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	assert.False(t, output.Incomplete)
+	assert.Len(t, output.ScheduledSessions, 1)
+}
+
+// TestGenerate_Cancelled_Error tests that a cancelled context stops the search with its error
+func TestGenerate_Cancelled_Error(t *testing.T) {
+	courseID := uuid.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	output, err := csp.NewCSPScheduler().Generate(ctx, &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, output)
+}
+
+// TestGenerate_SpreadAcrossDays tests that a course's sessions are spread across days when possible
+func TestGenerate_SpreadAcrossDays(t *testing.T) {
+	courseID := uuid.New()
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 3)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+
+	days := make(map[int]bool)
+	for _, s := range output.ScheduledSessions {
+		days[s.Day] = true
+	}
+	assert.Len(t, days, 3, "Sessions should be on different days")
+}
+
+// TestGenerate_RoomBlackout_Avoided tests that no placement falls inside a room's blacked-out time
+func TestGenerate_RoomBlackout_Avoided(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 4)},
+		RoomBlackouts: []*models.RoomBlackout{
+			models.NewRoomBlackout(uuid.New(), roomID, 0, 480, 600, models.RecurrenceWeekly, nil, nil, nil, nil),
+			models.NewRoomBlackout(uuid.New(), roomID, 1, 600, 720, models.RecurrenceWeekly, nil, nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 4)
+	for _, s := range output.ScheduledSessions {
+		if s.Day == 0 {
+			assert.GreaterOrEqual(t, s.StartTime, 600)
+		} else {
+			assert.LessOrEqual(t, s.EndTime, 600)
+		}
+	}
+}
+
+// TestGenerate_Pin_Honoured tests that pins are placed as given and searched sessions avoid them,
+// even when the pinned room is of another type
+func TestGenerate_Pin_Honoured(t *testing.T) {
+	labID := uuid.New()
+	lectureID := uuid.New()
+	courseID := uuid.New()
+	pinnedID := uuid.New()
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:        scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:         []scheduler.Day{scheduler.Monday},
+			PreferredSlotDuration: 60,
+			Pins:                  []models.SessionPin{{CourseSessionID: pinnedID, Day: 0, StartTime: 540, RoomID: &labID}},
+		},
+		Rooms: []*models.Room{
+			makeRoom(labID, "Lab 1", "lab"),
+			makeRoom(lectureID, "Room 101", "lecture"),
+		},
+		Courses: []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{
+			makeSession(pinnedID, courseID, "lecture", 60, 2),
+			makeSession(uuid.New(), uuid.New(), "lab", 60, 3),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 5)
+	assert.Empty(t, output.Failures)
+	assertNoClashes(t, output.ScheduledSessions)
+
+	pinned := 0
+	for _, s := range output.ScheduledSessions {
+		if *s.CourseSessionID == pinnedID && s.RoomID == labID {
+			assert.Equal(t, 540, s.StartTime)
+			pinned++
+		}
+	}
+	assert.Equal(t, 1, pinned)
+}
+
+// TestGenerate_Pin_Conflict tests that pins sharing an instructor at the same time fail generation
+func TestGenerate_Pin_Conflict(t *testing.T) {
+	instructorID := uuid.New()
+	courseID := uuid.New()
+	first := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+	second := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+	first.InstructorID, second.InstructorID = &instructorID, &instructorID
+
+	_, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			Pins: []models.SessionPin{
+				{CourseSessionID: first.ID, Day: 0, StartTime: 480},
+				{CourseSessionID: second.ID, Day: 0, StartTime: 510},
+			},
+		},
+		Rooms: []*models.Room{
+			makeRoom(uuid.New(), "Room 101", "lecture"),
+			makeRoom(uuid.New(), "Room 102", "lecture"),
+		},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{first, second},
+	})
+
+	assert.ErrorIs(t, err, scheduler.ErrPinConflict)
+}
+
+// TestGenerate_TravelTimeBetweenBuildings tests that a cohort gets time to walk between buildings
+func TestGenerate_TravelTimeBetweenBuildings(t *testing.T) {
+	lectureRoom := makeRoom(uuid.New(), "Room 101", "lecture")
+	labRoom := makeRoom(uuid.New(), "Lab 1", "lab")
+	a, b := uuid.New(), uuid.New()
+	cohort := &models.Cohort{ID: uuid.New(), Programme: "BSc Physics", Year: 1, CourseIDs: []uuid.UUID{a, b}}
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:   []*models.Room{lectureRoom, labRoom},
+		Courses: []*models.Course{makeCourse(a, "A"), makeCourse(b, "B")},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), a, "lecture", 60, 1),
+			makeSession(uuid.New(), b, "lab", 60, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+		BuildingDistances: []*models.BuildingDistance{
+			models.NewBuildingDistance(lectureRoom.Building, labRoom.Building, 30, nil, nil),
+		},
+	}
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	first, second := output.ScheduledSessions[0], output.ScheduledSessions[1]
+	if second.StartTime < first.StartTime {
+		first, second = second, first
+	}
+	assert.GreaterOrEqual(t, second.StartTime-first.EndTime, 30)
+
+	// Two hours leave no time for the walk
+	input.Config.OperatingHours = scheduler.TimeRange{Start: 480, End: 600}
+	output, err = csp.NewCSPScheduler().Generate(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonCohortClash, output.Failures[0].Reason)
+}
+
+// TestGenerate_DailyLimits tests that the search keeps every course and instructor within the daily limits
+func TestGenerate_DailyLimits(t *testing.T) {
+	roomID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	instructorID := uuid.New()
+
+	session1 := makeSession(uuid.New(), course1ID, "lecture", 60, 3)
+	session1.InstructorID = &instructorID
+	session2 := makeSession(uuid.New(), course2ID, "lecture", 60, 2)
+	session2.InstructorID = &instructorID
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:             scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:              []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			MaxCourseSessionsPerDay:    2,
+			MaxInstructorMinutesPerDay: 150,
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{session1, session2},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 4)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorDailyLimit, output.Failures[0].Reason)
+
+	type courseDay struct {
+		course uuid.UUID
+		day    int
+	}
+	perCourseDay := make(map[courseDay]int)
+	minutes := make(map[int]int)
+	for _, s := range output.ScheduledSessions {
+		perCourseDay[courseDay{s.CourseID, s.Day}]++
+		minutes[s.Day] += s.EndTime - s.StartTime
+	}
+	for _, n := range perCourseDay {
+		assert.LessOrEqual(t, n, 2)
+	}
+	for _, m := range minutes {
+		assert.LessOrEqual(t, m, 150)
+	}
+}
+
+// TestGenerate_SessionConstraints tests that the search keeps a course's labs after its lecture and its lectures apart
+func TestGenerate_SessionConstraints(t *testing.T) {
+	courseID := uuid.New()
+	lectureID := uuid.New()
+	labID := uuid.New()
+
+	lecture := models.NewCourseSession(lectureID, courseID, "lecture", "lecture", ptr(int32(60)), ptr(int32(2)), nil, nil)
+	lab := models.NewCourseSession(labID, courseID, "lab", "lab", ptr(int32(120)), ptr(int32(1)), nil, nil)
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday},
+		},
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture"), makeRoom(uuid.New(), "Lab 1", "lab")},
+		Courses:        []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{lab, lecture},
+		SessionConstraints: []*models.SessionConstraint{
+			models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintAfter, "lecture", "lab", nil, nil, nil),
+			models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintMinDaysApart, "lecture", "lecture", ptr(int32(2)), nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+	assert.Empty(t, output.Violations)
+
+	var lectureDays []int
+	firstLectureEnd := -1
+	for _, s := range output.ScheduledSessions {
+		if *s.CourseSessionID == lectureID {
+			lectureDays = append(lectureDays, s.Day)
+			if end := s.Day*24*60 + s.EndTime; firstLectureEnd < 0 || end < firstLectureEnd {
+				firstLectureEnd = end
+			}
+		}
+	}
+	require.Len(t, lectureDays, 2)
+	assert.ElementsMatch(t, []int{0, 2}, lectureDays)
+
+	for _, s := range output.ScheduledSessions {
+		if *s.CourseSessionID == labID {
+			assert.GreaterOrEqual(t, s.Day*24*60+s.StartTime, firstLectureEnd)
+		}
+	}
+}
+
+// TestGenerate_LinkedSessions tests that every block and section of an occurrence is placed together
+func TestGenerate_LinkedSessions(t *testing.T) {
+	room1ID := uuid.New()
+	room2ID := uuid.New()
+	courseID := uuid.New()
+
+	lab := models.NewCourseSession(uuid.New(), courseID, "lab", "lab", ptr(int32(60)), ptr(int32(2)), nil, nil)
+	lab.Blocks = ptr(int32(2))
+	lab.ParallelSections = ptr(int32(2))
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{makeRoom(room1ID, "Lab 1", "lab"), makeRoom(room2ID, "Lab 2", "lab")},
+		Courses:        []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{lab},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 8)
+	assert.Empty(t, output.Failures)
+	assertNoClashes(t, output.ScheduledSessions)
+
+	type occurrence struct {
+		day, start int
+	}
+	parts := make(map[occurrence][]*models.ScheduledSession)
+	for _, s := range output.ScheduledSessions {
+		key := occurrence{s.Day, s.StartTime - s.Block*60}
+		parts[key] = append(parts[key], s)
+	}
+	require.Len(t, parts, 2)
+	for _, ps := range parts {
+		assert.Len(t, ps, 4)
+	}
+}
+
+// TestGenerate_ReportsProgressPerOccurrence tests that progress counts occurrences, not their parallel sections
+func TestGenerate_ReportsProgressPerOccurrence(t *testing.T) {
+	courseID := uuid.New()
+	tutorial := makeSession(uuid.New(), courseID, "tutorial", 60, 2)
+	tutorial.ParallelSections = ptr(int32(3))
+
+	var events []scheduler.Progress
+	_, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Rooms: []*models.Room{
+			makeRoom(uuid.New(), "Room 201", "tutorial"),
+			makeRoom(uuid.New(), "Room 202", "tutorial"),
+			makeRoom(uuid.New(), "Room 203", "tutorial"),
+		},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{tutorial},
+		Progress: func(p scheduler.Progress) {
+			events = append(events, p)
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	for _, e := range events {
+		assert.Equal(t, 2, e.SessionsTotal)
+		assert.LessOrEqual(t, e.SessionsPlaced, 2)
+	}
+
+	last := events[len(events)-1]
+	assert.Equal(t, 2, last.SessionsPlaced)
+	assert.Equal(t, 0, last.SessionsFailed)
+}
+
+// TestGenerate_ParallelSections_NotEnoughRooms tests that no section is placed when there are too few rooms for all of them
+func TestGenerate_ParallelSections_NotEnoughRooms(t *testing.T) {
+	courseID := uuid.New()
+	tutorial := makeSession(uuid.New(), courseID, "tutorial", 60, 1)
+	tutorial.ParallelSections = ptr(int32(3))
+
+	output, err := csp.NewCSPScheduler().Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 201", "tutorial"), makeRoom(uuid.New(), "Room 202", "tutorial")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{tutorial},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonSectionRooms, output.Failures[0].Reason)
+}
@@ -1,7 +1,9 @@
 package greedy_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -38,7 +40,7 @@ func TestGenerate_SingleSession_Success(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -64,7 +66,7 @@ func TestGenerate_MultipleSessions_SpreadAcrossDays(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 3)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -94,7 +96,7 @@ func TestGenerate_NoAvailableSlot_Failure(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 800, 6)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -121,7 +123,7 @@ func TestGenerate_MultipleRoomTypes(t *testing.T) {
 	labSession := models.NewCourseSession(uuid.New(), courseID, "lab", "lab", ptr(int32(90)), ptr(int32(1)), nil, nil)
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: []*models.CourseSession{lectureSession, labSession},
@@ -164,7 +166,7 @@ func TestGenerate_WithMinBreakBetweenSessions(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -200,7 +202,7 @@ func TestGenerate_WithPreferredSlotDuration(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -226,7 +228,7 @@ func TestGenerate_DefaultConfig(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         nil, // Should use default
 		Rooms:          rooms,
 		Courses:        courses,
@@ -248,7 +250,7 @@ func TestGenerate_DefaultConfig(t *testing.T) {
 // TestGenerate_EmptyInput tests handling of empty input
 func TestGenerate_EmptyInput(t *testing.T) {
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          []*models.Room{},
 		Courses:        []*models.Course{},
 		CourseSessions: []*models.CourseSession{},
@@ -281,7 +283,7 @@ func TestGenerate_HigherWeightCoursesScheduledFirst(t *testing.T) {
 	}
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Config:         config,
 		Rooms:          rooms,
 		Courses:        courses,
@@ -312,7 +314,7 @@ func TestGenerate_NoMatchingRoomType(t *testing.T) {
 	sessions := []*models.CourseSession{makeSession(uuid.New(), courseID, "chemistry_lab", 60, 1)} // No chemistry_lab room
 
 	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
-	output, err := sched.Generate(&scheduler.Input{
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
 		Rooms:          rooms,
 		Courses:        courses,
 		CourseSessions: sessions,
@@ -322,3 +324,1221 @@ func TestGenerate_NoMatchingRoomType(t *testing.T) {
 	assert.Empty(t, output.ScheduledSessions)
 	assert.NotEmpty(t, output.Failures)
 }
+
+// TestGenerate_InstructorNotDoubleBooked tests that sessions sharing an instructor never overlap
+func TestGenerate_InstructorNotDoubleBooked(t *testing.T) {
+	room1ID := uuid.New()
+	room2ID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	instructorID := uuid.New()
+
+	rooms := []*models.Room{
+		makeRoom(room1ID, "Room 101", "lecture"),
+		makeRoom(room2ID, "Room 102", "lecture"),
+	}
+	courses := []*models.Course{
+		makeCourse(course1ID, "Math 101"),
+		makeCourse(course2ID, "Math 201"),
+	}
+
+	session1 := makeSession(uuid.New(), course1ID, "lecture", 60, 1)
+	session1.InstructorID = &instructorID
+	session2 := makeSession(uuid.New(), course2ID, "lecture", 60, 1)
+	session2.InstructorID = &instructorID
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 600}, // 8AM-10AM
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          rooms,
+		Courses:        courses,
+		CourseSessions: []*models.CourseSession{session1, session2},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+
+	s1, s2 := output.ScheduledSessions[0], output.ScheduledSessions[1]
+	overlaps := s1.StartTime < s2.EndTime && s2.StartTime < s1.EndTime
+	assert.False(t, overlaps, "Sessions with the same instructor should not overlap")
+	assert.Equal(t, instructorID, *s1.InstructorID)
+}
+
+// TestGenerate_InstructorClash_Failure tests that instructor clashes are reported as the failure reason
+func TestGenerate_InstructorClash_Failure(t *testing.T) {
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	instructorID := uuid.New()
+
+	rooms := []*models.Room{
+		makeRoom(uuid.New(), "Room 101", "lecture"),
+		makeRoom(uuid.New(), "Room 102", "lecture"),
+	}
+	courses := []*models.Course{
+		makeCourse(course1ID, "Math 101"),
+		makeCourse(course2ID, "Math 201"),
+	}
+
+	session1 := makeSession(uuid.New(), course1ID, "lecture", 60, 1)
+	session1.InstructorID = &instructorID
+	session2 := makeSession(uuid.New(), course2ID, "lecture", 60, 1)
+	session2.InstructorID = &instructorID
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 540}, // Only one hour available
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:         config,
+		Rooms:          rooms,
+		Courses:        courses,
+		CourseSessions: []*models.CourseSession{session1, session2},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorUnavailable, output.Failures[0].Reason)
+}
+
+// TestGenerate_CohortCoursesNotOverlapping tests that courses taken by the same cohort are never scheduled at the same time
+func TestGenerate_CohortCoursesNotOverlapping(t *testing.T) {
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+
+	rooms := []*models.Room{
+		makeRoom(uuid.New(), "Room 101", "lecture"),
+		makeRoom(uuid.New(), "Room 102", "lecture"),
+	}
+	courses := []*models.Course{
+		makeCourse(course1ID, "Math 101"),
+		makeCourse(course2ID, "Physics 101"),
+	}
+	cohort := &models.Cohort{ID: uuid.New(), Programme: "BSc Computer Science", Year: 1, CourseIDs: []uuid.UUID{course1ID, course2ID}}
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 600}, // 8AM-10AM
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:  config,
+		Rooms:   rooms,
+		Courses: courses,
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2ID, "lecture", 60, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+	assert.Empty(t, output.CohortClashes)
+
+	s1, s2 := output.ScheduledSessions[0], output.ScheduledSessions[1]
+	overlaps := s1.StartTime < s2.EndTime && s2.StartTime < s1.EndTime
+	assert.False(t, overlaps, "Courses in the same cohort should not overlap")
+}
+
+// TestGenerate_CohortClash_Failure tests that cohort clashes are reported and counted per cohort
+func TestGenerate_CohortClash_Failure(t *testing.T) {
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	course3ID := uuid.New()
+
+	rooms := []*models.Room{
+		makeRoom(uuid.New(), "Room 101", "lecture"),
+		makeRoom(uuid.New(), "Room 102", "lecture"),
+		makeRoom(uuid.New(), "Room 103", "lecture"),
+	}
+	courses := []*models.Course{
+		makeCourse(course1ID, "Math 101"),
+		makeCourse(course2ID, "Physics 101"),
+		makeCourse(course3ID, "History 101"),
+	}
+	cohort := &models.Cohort{ID: uuid.New(), Programme: "BSc Computer Science", Year: 1, CourseIDs: []uuid.UUID{course1ID, course2ID}}
+
+	config := &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 540}, // Only one hour available
+		OperatingDays:  []scheduler.Day{scheduler.Monday},
+	}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config:  config,
+		Rooms:   rooms,
+		Courses: courses,
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course3ID, "lecture", 60, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+	})
+
+	require.NoError(t, err)
+	// The course outside the cohort is unaffected
+	require.Len(t, output.ScheduledSessions, 2)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonCohortClash, output.Failures[0].Reason)
+	assert.Equal(t, 1, output.CohortClashes[cohort.ID])
+}
+
+// TestGenerate_RoomTooSmall_Skipped tests that rooms below the expected enrollment are never used
+func TestGenerate_RoomTooSmall_Skipped(t *testing.T) {
+	smallRoomID := uuid.New()
+	bigRoomID := uuid.New()
+	courseID := uuid.New()
+
+	rooms := []*models.Room{
+		models.NewRoom(smallRoomID, "Seminar Room", "lecture", uuid.New(), 20, nil, nil),
+		models.NewRoom(bigRoomID, "Auditorium", "lecture", uuid.New(), 300, nil, nil),
+	}
+	course := makeCourse(courseID, "Intro to Psychology")
+	course.ExpectedEnrollment = ptr(int32(250))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          rooms,
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 2)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	for _, s := range output.ScheduledSessions {
+		assert.Equal(t, bigRoomID, s.RoomID)
+	}
+	assert.Equal(t, scheduler.SeatUsage{OfferedSeats: 600, FilledSeats: 500, WastedSeats: 100}, output.SeatUsage)
+}
+
+// TestGenerate_TightestFitRoom tests that the smallest room that fits is preferred
+func TestGenerate_TightestFitRoom(t *testing.T) {
+	mediumRoomID := uuid.New()
+	courseID := uuid.New()
+
+	rooms := []*models.Room{
+		models.NewRoom(uuid.New(), "Auditorium", "lecture", uuid.New(), 300, nil, nil),
+		models.NewRoom(mediumRoomID, "Room 101", "lecture", uuid.New(), 50, nil, nil),
+		models.NewRoom(uuid.New(), "Seminar Room", "lecture", uuid.New(), 20, nil, nil),
+	}
+	course := makeCourse(courseID, "Discrete Maths")
+	course.ExpectedEnrollment = ptr(int32(40))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          rooms,
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, mediumRoomID, output.ScheduledSessions[0].RoomID)
+	assert.Equal(t, 10, output.SeatUsage.WastedSeats)
+}
+
+// TestGenerate_SessionEnrollmentOverridesCourse tests that a split lab uses its own, smaller enrollment
+func TestGenerate_SessionEnrollmentOverridesCourse(t *testing.T) {
+	labID := uuid.New()
+	courseID := uuid.New()
+
+	rooms := []*models.Room{
+		models.NewRoom(labID, "Computer Lab", "lab", uuid.New(), 25, nil, nil),
+	}
+	course := makeCourse(courseID, "Programming I")
+	course.ExpectedEnrollment = ptr(int32(200))
+
+	lab := models.NewCourseSession(uuid.New(), courseID, "lab", "lab", ptr(int32(120)), ptr(int32(1)), nil, nil)
+	lab.ExpectedEnrollment = ptr(int32(25))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          rooms,
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{lab},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, labID, output.ScheduledSessions[0].RoomID)
+	assert.Equal(t, 0, output.SeatUsage.WastedSeats)
+}
+
+// TestGenerate_InsufficientCapacity_Failure tests the failure reason when no room is large enough
+func TestGenerate_InsufficientCapacity_Failure(t *testing.T) {
+	courseID := uuid.New()
+
+	course := makeCourse(courseID, "Intro to Psychology")
+	course.ExpectedEnrollment = ptr(int32(250))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInsufficientCapacity, output.Failures[0].Reason)
+}
+
+// TestGenerate_RoomBlackout_Avoided tests that sessions are never placed in a room's blacked-out time
+func TestGenerate_RoomBlackout_Avoided(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+		RoomBlackouts: []*models.RoomBlackout{
+			// Morning maintenance, Monday 8AM-12PM
+			models.NewRoomBlackout(uuid.New(), roomID, 0, 480, 720, models.RecurrenceWeekly, nil, nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, 720, output.ScheduledSessions[0].StartTime)
+}
+
+// TestGenerate_RoomBlackedOut_UsesOtherRoom tests that a room closed for a whole day is skipped that day
+func TestGenerate_RoomBlackedOut_UsesOtherRoom(t *testing.T) {
+	closedID := uuid.New()
+	openID := uuid.New()
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{
+			makeRoom(closedID, "Room 101", "lecture"),
+			makeRoom(openID, "Room 102", "lecture"),
+		},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+		RoomBlackouts: []*models.RoomBlackout{
+			// One-off closure on Monday 1 September 2025
+			models.NewRoomBlackout(uuid.New(), closedID, 0, 0, 1440, models.RecurrenceOnce, ptr("2025-09-01"), nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, openID, output.ScheduledSessions[0].RoomID)
+}
+
+// TestGenerate_RoomBlackout_NoSlotLeft tests that a session fails when blackouts leave no gap long enough
+func TestGenerate_RoomBlackout_NoSlotLeft(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 120, 1)},
+		RoomBlackouts: []*models.RoomBlackout{
+			models.NewRoomBlackout(uuid.New(), roomID, 0, 570, 630, models.RecurrenceWeekly, nil, nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonNoAvailableSlot, output.Failures[0].Reason)
+}
+
+// TestGenerate_Pin_Honoured tests that a pinned occurrence is placed exactly where pinned and the rest fill in around it
+func TestGenerate_Pin_Honoured(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	sessionID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday},
+			Pins:           []models.SessionPin{{CourseSessionID: sessionID, Day: 2, StartTime: 600, RoomID: &roomID}},
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(sessionID, courseID, "lecture", 60, 2)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+
+	pinned := output.ScheduledSessions[0]
+	assert.Equal(t, 2, pinned.Day)
+	assert.Equal(t, 600, pinned.StartTime)
+	assert.Equal(t, 660, pinned.EndTime)
+	assert.Equal(t, roomID, pinned.RoomID)
+	assert.NotEqual(t, 2, output.ScheduledSessions[1].Day, "second occurrence should go on another day")
+}
+
+// TestGenerate_Pin_PicksRoom tests that a pin without a room gets a free room of the required type
+func TestGenerate_Pin_PicksRoom(t *testing.T) {
+	labID := uuid.New()
+	lectureID := uuid.New()
+	courseID := uuid.New()
+	sessionID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			Pins:           []models.SessionPin{{CourseSessionID: sessionID, Day: 0, StartTime: 900}},
+		},
+		Rooms: []*models.Room{
+			makeRoom(labID, "Lab 1", "lab"),
+			makeRoom(lectureID, "Room 101", "lecture"),
+		},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(sessionID, courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 1)
+	assert.Equal(t, lectureID, output.ScheduledSessions[0].RoomID)
+	assert.Equal(t, 900, output.ScheduledSessions[0].StartTime)
+}
+
+// TestGenerate_Pin_BlocksOthers tests that unpinned sessions are placed around a pin's room and time
+func TestGenerate_Pin_BlocksOthers(t *testing.T) {
+	roomID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	pinnedID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			Pins:           []models.SessionPin{{CourseSessionID: pinnedID, Day: 0, StartTime: 480}},
+		},
+		Rooms:   []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses: []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course2ID, "lecture", 60, 1),
+			makeSession(pinnedID, course1ID, "lecture", 60, 1),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Equal(t, 480, output.ScheduledSessions[0].StartTime)
+	assert.Equal(t, 540, output.ScheduledSessions[1].StartTime)
+}
+
+// TestGenerate_Pin_Conflict tests that two pins in the same room at the same time fail generation
+func TestGenerate_Pin_Conflict(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session1ID := uuid.New()
+	session2ID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	_, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			Pins: []models.SessionPin{
+				{CourseSessionID: session1ID, Day: 0, StartTime: 600, RoomID: &roomID},
+				{CourseSessionID: session2ID, Day: 0, StartTime: 630, RoomID: &roomID},
+			},
+		},
+		Rooms:   []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses: []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{
+			makeSession(session1ID, courseID, "lecture", 60, 1),
+			makeSession(session2ID, courseID, "lecture", 60, 1),
+		},
+	})
+
+	assert.ErrorIs(t, err, scheduler.ErrPinConflict)
+}
+
+// TestGenerate_Pin_Invalid tests that pins that can't be honoured as given fail generation
+func TestGenerate_Pin_Invalid(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	sessionID := uuid.New()
+
+	tests := []struct {
+		name string
+		pins []models.SessionPin
+	}{
+		{"unknown session", []models.SessionPin{{CourseSessionID: uuid.New(), Day: 0, StartTime: 600}}},
+		{"outside operating days", []models.SessionPin{{CourseSessionID: sessionID, Day: 5, StartTime: 600}}},
+		{"runs past closing", []models.SessionPin{{CourseSessionID: sessionID, Day: 0, StartTime: 1230}}},
+		{"more pins than sessions", []models.SessionPin{
+			{CourseSessionID: sessionID, Day: 0, StartTime: 600},
+			{CourseSessionID: sessionID, Day: 0, StartTime: 900},
+		}},
+		{"blacked out room", []models.SessionPin{{CourseSessionID: sessionID, Day: 0, StartTime: 480, RoomID: &roomID}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+			_, err := sched.Generate(context.Background(), &scheduler.Input{
+				Config: &scheduler.Config{
+					OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+					OperatingDays:  []scheduler.Day{scheduler.Monday},
+					Pins:           tt.pins,
+				},
+				Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+				Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+				CourseSessions: []*models.CourseSession{makeSession(sessionID, courseID, "lecture", 60, 1)},
+				RoomBlackouts: []*models.RoomBlackout{
+					models.NewRoomBlackout(uuid.New(), roomID, 0, 480, 540, models.RecurrenceWeekly, nil, nil, nil, nil),
+				},
+			})
+
+			assert.ErrorIs(t, err, scheduler.ErrInvalidPin)
+		})
+	}
+}
+
+// TestGenerate_ReportsProgress tests that one progress event is sent per course session placed
+func TestGenerate_ReportsProgress(t *testing.T) {
+	roomID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	course3ID := uuid.New()
+
+	var events []scheduler.Progress
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600}, // room for two sessions
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses: []*models.Course{
+			makeCourse(course1ID, "Math 101"),
+			makeCourse(course2ID, "Physics 101"),
+			makeCourse(course3ID, "Chemistry 101"),
+		},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course3ID, "lecture", 60, 1),
+		},
+		Progress: func(p scheduler.Progress) {
+			events = append(events, p)
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	require.Len(t, events, 3)
+
+	for i, e := range events {
+		assert.Equal(t, 3, e.SessionsTotal)
+		assert.Equal(t, i+1, e.SessionsPlaced+e.SessionsFailed)
+	}
+
+	last := events[len(events)-1]
+	assert.Equal(t, 2, last.SessionsPlaced)
+	assert.Equal(t, 1, last.SessionsFailed)
+	assert.Equal(t, 1.0, last.Fraction())
+}
+
+func TestGenerate_TimeLimit_ReturnsPartialResult(t *testing.T) {
+	roomID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	course3ID := uuid.New()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(ctx, &scheduler.Input{
+		Rooms: []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses: []*models.Course{
+			makeCourse(course1ID, "Math 101"),
+			makeCourse(course2ID, "Physics 101"),
+			makeCourse(course3ID, "Chemistry 101"),
+		},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course3ID, "lecture", 60, 1),
+		},
+		// Let the deadline pass after the first session
+		Progress: func(p scheduler.Progress) {
+			<-ctx.Done()
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, output.Incomplete)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 2)
+	for _, f := range output.Failures {
+		assert.Equal(t, scheduler.ReasonTimeLimit, f.Reason)
+	}
+}
+
+func TestGenerate_MaxDuration_ReturnsPartialResult(t *testing.T) {
+	roomID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			MaxDuration:    10,
+		},
+		Rooms: []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses: []*models.Course{
+			makeCourse(course1ID, "Math 101"),
+			makeCourse(course2ID, "Physics 101"),
+		},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2ID, "lecture", 60, 1),
+		},
+		Progress: func(p scheduler.Progress) {
+			time.Sleep(20 * time.Millisecond)
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, output.Incomplete)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonTimeLimit, output.Failures[0].Reason)
+}
+
+func TestGenerate_Cancelled_Error(t *testing.T) {
+	roomID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(ctx, &scheduler.Input{
+		Rooms: []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses: []*models.Course{
+			makeCourse(course1ID, "Math 101"),
+			makeCourse(course2ID, "Physics 101"),
+		},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2ID, "lecture", 60, 1),
+		},
+		Progress: func(p scheduler.Progress) {
+			cancel()
+		},
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, output)
+}
+
+func TestGenerate_Completes_NotIncomplete(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1260},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			MaxDuration:    60_000,
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 1)},
+	})
+
+	require.NoError(t, err)
+	assert.False(t, output.Incomplete)
+	assert.Len(t, output.ScheduledSessions, 1)
+}
+
+func TestGenerate_TravelTimeBetweenBuildings(t *testing.T) {
+	lectureRoom := makeRoom(uuid.New(), "Room 101", "lecture")
+	labRoom := makeRoom(uuid.New(), "Lab 1", "lab")
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	instructorID := uuid.New()
+
+	lecture := makeSession(uuid.New(), course1ID, "lecture", 60, 1)
+	lecture.InstructorID = &instructorID
+	lab := makeSession(uuid.New(), course2ID, "lab", 60, 1)
+	lab.InstructorID = &instructorID
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{lectureRoom, labRoom},
+		Courses:        []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Physics Lab")},
+		CourseSessions: []*models.CourseSession{lecture, lab},
+		BuildingDistances: []*models.BuildingDistance{
+			models.NewBuildingDistance(lectureRoom.Building, labRoom.Building, 30, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+
+	first, second := output.ScheduledSessions[0], output.ScheduledSessions[1]
+	if second.StartTime < first.StartTime {
+		first, second = second, first
+	}
+	assert.GreaterOrEqual(t, second.StartTime-first.EndTime, 30, "Instructor has no time to walk between buildings")
+}
+
+func TestGenerate_TravelTime_SameBuildingBackToBack(t *testing.T) {
+	buildingID := uuid.New()
+	lectureRoom := models.NewRoom(uuid.New(), "Room 101", "lecture", buildingID, 30, nil, nil)
+	labRoom := models.NewRoom(uuid.New(), "Lab 1", "lab", buildingID, 30, nil, nil)
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	cohort := &models.Cohort{ID: uuid.New(), Programme: "BSc Physics", Year: 1, CourseIDs: []uuid.UUID{course1ID, course2ID}}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600}, // exactly two sessions
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:   []*models.Room{lectureRoom, labRoom},
+		Courses: []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Physics Lab")},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 1),
+			makeSession(uuid.New(), course2ID, "lab", 60, 1),
+		},
+		Cohorts: []*models.Cohort{cohort},
+		BuildingDistances: []*models.BuildingDistance{
+			models.NewBuildingDistance(buildingID, uuid.New(), 30, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+}
+
+// TestGenerate_SpreadsAcrossConfiguredDays tests that a course's sessions use every operating day before doubling up,
+// whatever the length of the week
+func TestGenerate_SpreadsAcrossConfiguredDays(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday, scheduler.Thursday, scheduler.Friday, scheduler.Saturday},
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 6)},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 6)
+
+	days := make(map[int]bool)
+	for _, s := range output.ScheduledSessions {
+		days[s.Day] = true
+	}
+	assert.Len(t, days, 6, "Each session should be on a different day")
+}
+
+// TestGenerate_MaxCourseSessionsPerDay tests that a course never has more sessions on a day than allowed
+func TestGenerate_MaxCourseSessionsPerDay(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:          scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:           []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			MaxCourseSessionsPerDay: 2,
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 5)},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 4)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonCourseDailyLimit, output.Failures[0].Reason)
+
+	perDay := make(map[int]int)
+	for _, s := range output.ScheduledSessions {
+		perDay[s.Day]++
+	}
+	assert.Equal(t, map[int]int{0: 2, 1: 2}, perDay)
+}
+
+// TestGenerate_MaxInstructorMinutesPerDay tests that an instructor's teaching on a day stays within the limit
+func TestGenerate_MaxInstructorMinutesPerDay(t *testing.T) {
+	room1ID := uuid.New()
+	room2ID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	instructorID := uuid.New()
+
+	session1 := makeSession(uuid.New(), course1ID, "lecture", 120, 1)
+	session1.InstructorID = &instructorID
+	session2 := makeSession(uuid.New(), course2ID, "lecture", 120, 1)
+	session2.InstructorID = &instructorID
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:             scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:              []scheduler.Day{scheduler.Monday},
+			MaxInstructorMinutesPerDay: 180,
+		},
+		Rooms:          []*models.Room{makeRoom(room1ID, "Room 101", "lecture"), makeRoom(room2ID, "Room 102", "lecture")},
+		Courses:        []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{session1, session2},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonInstructorDailyLimit, output.Failures[0].Reason)
+}
+
+// TestGenerate_MaxCohortMinutesPerDay tests that a cohort's contact time is moved to another day once a day is full
+func TestGenerate_MaxCohortMinutesPerDay(t *testing.T) {
+	roomID := uuid.New()
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	cohort := &models.Cohort{ID: uuid.New(), Programme: "BSc Physics", Year: 1, CourseIDs: []uuid.UUID{course1ID, course2ID}}
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:         scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:          []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			MaxCohortMinutesPerDay: 120,
+		},
+		Rooms:   []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses: []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{
+			makeSession(uuid.New(), course1ID, "lecture", 60, 2),
+			makeSession(uuid.New(), course2ID, "lecture", 60, 2),
+		},
+		Cohorts: []*models.Cohort{cohort},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 4)
+	assert.Empty(t, output.Failures)
+
+	minutes := make(map[int]int)
+	for _, s := range output.ScheduledSessions {
+		minutes[s.Day] += s.EndTime - s.StartTime
+	}
+	assert.Equal(t, map[int]int{0: 120, 1: 120}, minutes)
+}
+
+func makeTypedSession(id, courseID uuid.UUID, sessionType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(id, courseID, sessionType, sessionType, ptr(duration), ptr(numSessions), nil, nil)
+}
+
+func weekMinute(s *models.ScheduledSession, minute int) int {
+	return s.Day*24*60 + minute
+}
+
+// TestGenerate_SessionConstraint_After tests that a lab is placed after the lecture even when it is listed first
+func TestGenerate_SessionConstraint_After(t *testing.T) {
+	courseID := uuid.New()
+	lectureID := uuid.New()
+	labID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:   []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture"), makeRoom(uuid.New(), "Lab 1", "lab")},
+		Courses: []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{
+			makeTypedSession(labID, courseID, "lab", 120, 1),
+			makeTypedSession(lectureID, courseID, "lecture", 60, 1),
+		},
+		SessionConstraints: []*models.SessionConstraint{
+			models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintAfter, "lecture", "lab", nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+	assert.Empty(t, output.Violations)
+
+	byID := make(map[uuid.UUID]*models.ScheduledSession)
+	for _, s := range output.ScheduledSessions {
+		byID[*s.CourseSessionID] = s
+	}
+	lecture, lab := byID[lectureID], byID[labID]
+	assert.GreaterOrEqual(t, weekMinute(lab, lab.StartTime), weekMinute(lecture, lecture.EndTime))
+}
+
+// TestGenerate_SessionConstraint_MinDaysApart tests that a course's lectures are kept the given number of days apart
+func TestGenerate_SessionConstraint_MinDaysApart(t *testing.T) {
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday},
+		},
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 2)},
+		SessionConstraints: []*models.SessionConstraint{
+			models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintMinDaysApart, "lecture", "lecture", ptr(int32(2)), nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+	assert.Empty(t, output.Violations)
+	assert.GreaterOrEqual(t, output.ScheduledSessions[1].Day-output.ScheduledSessions[0].Day, 2)
+}
+
+// TestGenerate_SessionConstraint_MinDaysApart_NoDayLeft tests that a session with no day far enough away fails
+func TestGenerate_SessionConstraint_MinDaysApart_NoDayLeft(t *testing.T) {
+	courseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{makeSession(uuid.New(), courseID, "lecture", 60, 2)},
+		SessionConstraints: []*models.SessionConstraint{
+			models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintMinDaysApart, "lecture", "lecture", ptr(int32(2)), nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 1)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonOrderingConstraint, output.Failures[0].Reason)
+}
+
+// TestGenerate_SessionConstraint_Consecutive tests that a tutorial starts straight after the lecture on the same day
+func TestGenerate_SessionConstraint_Consecutive(t *testing.T) {
+	courseID := uuid.New()
+	lectureID := uuid.New()
+	tutorialID := uuid.New()
+	otherCourseID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture"), makeRoom(uuid.New(), "Room 201", "tutorial")},
+		Courses: []*models.Course{
+			makeCourse(courseID, "Math 101"),
+			makeCourse(otherCourseID, "Physics 101"),
+		},
+		CourseSessions: []*models.CourseSession{
+			// Takes the tutorial room first thing, so the tutorial can't simply go at the start of the day
+			makeTypedSession(uuid.New(), otherCourseID, "tutorial", 180, 1),
+			makeTypedSession(tutorialID, courseID, "tutorial", 60, 1),
+			makeTypedSession(lectureID, courseID, "lecture", 120, 1),
+		},
+		SessionConstraints: []*models.SessionConstraint{
+			models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintConsecutive, "lecture", "tutorial", nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+	assert.Empty(t, output.Violations)
+
+	byID := make(map[uuid.UUID]*models.ScheduledSession)
+	for _, s := range output.ScheduledSessions {
+		if s.CourseSessionID != nil {
+			byID[*s.CourseSessionID] = s
+		}
+	}
+	lecture, tutorial := byID[lectureID], byID[tutorialID]
+	assert.Equal(t, lecture.Day, tutorial.Day)
+	assert.GreaterOrEqual(t, tutorial.StartTime, lecture.EndTime)
+	assert.LessOrEqual(t, tutorial.StartTime, lecture.EndTime+scheduler.ConsecutiveSlack)
+}
+
+// TestGenerate_SessionConstraint_PinViolation tests that a pin breaking a constraint is kept and reported
+func TestGenerate_SessionConstraint_PinViolation(t *testing.T) {
+	courseID := uuid.New()
+	labID := uuid.New()
+	constraintID := uuid.New()
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			Pins:           []models.SessionPin{{CourseSessionID: labID, Day: 0, StartTime: 480}},
+		},
+		Rooms:   []*models.Room{makeRoom(uuid.New(), "Room 101", "lecture"), makeRoom(uuid.New(), "Lab 1", "lab")},
+		Courses: []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{
+			makeTypedSession(uuid.New(), courseID, "lecture", 60, 1),
+			makeTypedSession(labID, courseID, "lab", 120, 1),
+		},
+		SessionConstraints: []*models.SessionConstraint{
+			models.NewSessionConstraint(constraintID, courseID, models.SessionConstraintAfter, "lecture", "lab", nil, nil, nil),
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+	require.Len(t, output.Violations, 1)
+	assert.Equal(t, constraintID, output.Violations[0].ConstraintID)
+	assert.Equal(t, models.SessionConstraintAfter, output.Violations[0].Kind)
+}
+
+// TestGenerate_LinkedBlocks tests that a double-period lab runs as two back-to-back blocks in one room
+func TestGenerate_LinkedBlocks(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	lab := makeTypedSession(uuid.New(), courseID, "lab", 60, 1)
+	lab.Blocks = ptr(int32(2))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:          scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:           []scheduler.Day{scheduler.Monday},
+			MinBreakBetweenSessions: 10,
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Lab 1", "lab")},
+		Courses:        []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{lab},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 2)
+	assert.Empty(t, output.Failures)
+
+	first, second := output.ScheduledSessions[0], output.ScheduledSessions[1]
+	assert.Equal(t, 0, first.Block)
+	assert.Equal(t, 1, second.Block)
+	assert.Equal(t, roomID, first.RoomID)
+	assert.Equal(t, roomID, second.RoomID)
+	assert.Equal(t, first.Day, second.Day)
+	assert.Equal(t, first.EndTime, second.StartTime, "blocks should follow each other without a break")
+}
+
+// TestGenerate_LinkedBlocks_NoRoomForBoth tests that an occurrence is not split when only one block fits
+func TestGenerate_LinkedBlocks_NoRoomForBoth(t *testing.T) {
+	courseID := uuid.New()
+	lab := makeTypedSession(uuid.New(), courseID, "lab", 90, 1)
+	lab.Blocks = ptr(int32(2))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 600},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Lab 1", "lab")},
+		Courses:        []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{lab},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+}
+
+// TestGenerate_ParallelSections tests that split groups meet at the same time in different rooms, with the
+// enrollment shared between them and the instructor in the first
+func TestGenerate_ParallelSections(t *testing.T) {
+	courseID := uuid.New()
+	instructorID := uuid.New()
+	course := makeCourse(courseID, "Math 101")
+	course.ExpectedEnrollment = ptr(int32(80))
+	tutorial := makeTypedSession(uuid.New(), courseID, "tutorial", 60, 1)
+	tutorial.ParallelSections = ptr(int32(3))
+	tutorial.InstructorID = &instructorID
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms: []*models.Room{
+			makeRoom(uuid.New(), "Room 201", "tutorial"),
+			makeRoom(uuid.New(), "Room 202", "tutorial"),
+			makeRoom(uuid.New(), "Room 203", "tutorial"),
+		},
+		Courses:        []*models.Course{course},
+		CourseSessions: []*models.CourseSession{tutorial},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+
+	rooms := make(map[uuid.UUID]bool)
+	for k, s := range output.ScheduledSessions {
+		assert.Equal(t, k, s.Section)
+		assert.Equal(t, output.ScheduledSessions[0].StartTime, s.StartTime)
+		rooms[s.RoomID] = true
+	}
+	assert.Len(t, rooms, 3, "each section should have its own room")
+	assert.Equal(t, &instructorID, output.ScheduledSessions[0].InstructorID)
+	assert.Nil(t, output.ScheduledSessions[1].InstructorID)
+	assert.Equal(t, 3*27, output.SeatUsage.FilledSeats)
+}
+
+// TestGenerate_ParallelSections_WaitForRooms tests that sections move to a time when enough rooms are free at once
+func TestGenerate_ParallelSections_WaitForRooms(t *testing.T) {
+	room1ID := uuid.New()
+	room2ID := uuid.New()
+	courseID := uuid.New()
+	otherID := uuid.New()
+	other := makeTypedSession(uuid.New(), otherID, "tutorial", 120, 1)
+	tutorial := makeTypedSession(uuid.New(), courseID, "tutorial", 60, 1)
+	tutorial.ParallelSections = ptr(int32(2))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			Pins:           []models.SessionPin{{CourseSessionID: other.ID, Day: 0, StartTime: 480, RoomID: &room2ID}},
+		},
+		Rooms:          []*models.Room{makeRoom(room1ID, "Room 201", "tutorial"), makeRoom(room2ID, "Room 202", "tutorial")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101"), makeCourse(otherID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{tutorial, other},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 3)
+	assert.Empty(t, output.Failures)
+	for _, s := range output.ScheduledSessions[1:] {
+		assert.GreaterOrEqual(t, s.StartTime, 600, "sections should wait until both rooms are free")
+	}
+}
+
+// TestGenerate_ParallelSections_NotEnoughRooms tests that no section is placed when there are too few rooms for all of them
+func TestGenerate_ParallelSections_NotEnoughRooms(t *testing.T) {
+	courseID := uuid.New()
+	tutorial := makeTypedSession(uuid.New(), courseID, "tutorial", 60, 1)
+	tutorial.ParallelSections = ptr(int32(3))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(uuid.New(), "Room 201", "tutorial"), makeRoom(uuid.New(), "Room 202", "tutorial")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{tutorial},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, output.ScheduledSessions)
+	require.Len(t, output.Failures, 1)
+	assert.Equal(t, scheduler.ReasonSectionRooms, output.Failures[0].Reason)
+}
+
+// TestGenerate_Pin_LinkedOccurrence tests that pinning a linked session holds every block and section
+func TestGenerate_Pin_LinkedOccurrence(t *testing.T) {
+	room1ID := uuid.New()
+	room2ID := uuid.New()
+	courseID := uuid.New()
+	lab := makeTypedSession(uuid.New(), courseID, "lab", 60, 1)
+	lab.Blocks = ptr(int32(2))
+	lab.ParallelSections = ptr(int32(2))
+
+	sched := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{})
+	output, err := sched.Generate(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+			Pins:           []models.SessionPin{{CourseSessionID: lab.ID, Day: 0, StartTime: 540, RoomID: &room2ID}},
+		},
+		Rooms:          []*models.Room{makeRoom(room1ID, "Lab 1", "lab"), makeRoom(room2ID, "Lab 2", "lab")},
+		Courses:        []*models.Course{makeCourse(courseID, "Chemistry 101")},
+		CourseSessions: []*models.CourseSession{lab},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.ScheduledSessions, 4)
+	assert.Empty(t, output.Failures)
+
+	expected := []struct {
+		room         uuid.UUID
+		start, block int
+		section      int
+	}{
+		{room2ID, 540, 0, 0},
+		{room2ID, 600, 1, 0},
+		{room1ID, 540, 0, 1},
+		{room1ID, 600, 1, 1},
+	}
+	for k, e := range expected {
+		s := output.ScheduledSessions[k]
+		assert.Equal(t, e.room, s.RoomID)
+		assert.Equal(t, e.start, s.StartTime)
+		assert.Equal(t, e.block, s.Block)
+		assert.Equal(t, e.section, s.Section)
+	}
+}
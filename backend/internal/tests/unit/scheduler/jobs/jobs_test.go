@@ -0,0 +1,283 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/jobs"
+)
+
+// waitFor polls the job until it reaches a finished status
+func waitFor(t *testing.T, m *jobs.Manager, userID string, id uuid.UUID) *jobs.Job {
+	t.Helper()
+
+	var job *jobs.Job
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = m.Get(userID, id)
+		require.NoError(t, err)
+		return job.Status.Finished()
+	}, 2*time.Second, 5*time.Millisecond)
+
+	return job
+}
+
+// blockingRun returns a RunFunc that waits for release or cancellation, and a channel closed once it starts
+func blockingRun(release <-chan struct{}) (jobs.RunFunc, <-chan struct{}) {
+	started := make(chan struct{})
+	return func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		close(started)
+		progress(scheduler.Progress{SessionsPlaced: 1, SessionsTotal: 2})
+		select {
+		case <-release:
+			return &scheduler.Output{}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}, started
+}
+
+func TestManager_Succeeds(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	output := &scheduler.Output{CohortClashes: map[uuid.UUID]int{}}
+	job, err := m.Submit("user-1", func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		return output, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusQueued, job.Status)
+
+	done := waitFor(t, m, "user-1", job.ID)
+	assert.Equal(t, jobs.StatusSucceeded, done.Status)
+	assert.Equal(t, 1.0, done.Progress)
+	assert.Same(t, output, done.Result)
+	assert.NotNil(t, done.StartedAt)
+	assert.NotNil(t, done.FinishedAt)
+}
+
+func TestManager_Fails(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	job, err := m.Submit("user-1", func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		return nil, errors.New("boom")
+	})
+	require.NoError(t, err)
+
+	done := waitFor(t, m, "user-1", job.ID)
+	assert.Equal(t, jobs.StatusFailed, done.Status)
+	assert.Equal(t, "boom", done.Error)
+	assert.Nil(t, done.Result)
+}
+
+func TestManager_ReportsProgress(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	release := make(chan struct{})
+	run, started := blockingRun(release)
+	job, err := m.Submit("user-1", run)
+	require.NoError(t, err)
+	<-started
+
+	require.Eventually(t, func() bool {
+		running, _ := m.Get("user-1", job.ID)
+		return running.Status == jobs.StatusRunning && running.Progress == 0.5
+	}, time.Second, 5*time.Millisecond)
+
+	running, err := m.Get("user-1", job.ID)
+	require.NoError(t, err)
+	require.NotNil(t, running.Stats)
+	assert.Equal(t, 1, running.Stats.SessionsPlaced)
+
+	close(release)
+	assert.Equal(t, jobs.StatusSucceeded, waitFor(t, m, "user-1", job.ID).Status)
+}
+
+func TestManager_CancelRunning(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	run, started := blockingRun(make(chan struct{}))
+	job, err := m.Submit("user-1", run)
+	require.NoError(t, err)
+	<-started
+
+	cancelled, err := m.Cancel("user-1", job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusCancelled, cancelled.Status)
+
+	done := waitFor(t, m, "user-1", job.ID)
+	assert.Equal(t, jobs.StatusCancelled, done.Status)
+	assert.Nil(t, done.Result)
+
+	_, err = m.Cancel("user-1", job.ID)
+	assert.ErrorIs(t, err, jobs.ErrFinished)
+}
+
+func TestManager_CancelQueued_NeverRuns(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	release := make(chan struct{})
+	first, started := blockingRun(release)
+	_, err := m.Submit("user-1", first)
+	require.NoError(t, err)
+	<-started
+
+	ran := false
+	queued, err := m.Submit("user-1", func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		ran = true
+		return &scheduler.Output{}, nil
+	})
+	require.NoError(t, err)
+
+	_, err = m.Cancel("user-1", queued.ID)
+	require.NoError(t, err)
+	close(release)
+
+	// A third job runs after the cancelled one would have, so the worker has moved past it
+	last, err := m.Submit("user-1", func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		return &scheduler.Output{}, nil
+	})
+	require.NoError(t, err)
+	waitFor(t, m, "user-1", last.ID)
+
+	assert.False(t, ran)
+}
+
+func TestManager_ScopedToUser(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	job, err := m.Submit("user-1", func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		return &scheduler.Output{}, nil
+	})
+	require.NoError(t, err)
+	waitFor(t, m, "user-1", job.ID)
+
+	_, err = m.Get("user-2", job.ID)
+	assert.ErrorIs(t, err, jobs.ErrNotFound)
+
+	_, err = m.Cancel("user-2", job.ID)
+	assert.ErrorIs(t, err, jobs.ErrNotFound)
+
+	assert.Empty(t, m.List("user-2"))
+	require.Len(t, m.List("user-1"), 1, "finished jobs stay listed")
+}
+
+func TestManager_ListNewestFirst(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	var ids []uuid.UUID
+	for range 3 {
+		job, err := m.Submit("user-1", func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+			return &scheduler.Output{}, nil
+		})
+		require.NoError(t, err)
+		ids = append(ids, job.ID)
+		time.Sleep(time.Millisecond)
+	}
+
+	list := m.List("user-1")
+	require.Len(t, list, 3)
+	assert.Equal(t, ids[2], list[0].ID)
+	assert.Equal(t, ids[0], list[2].ID)
+}
+
+func TestManager_QueueFull(t *testing.T) {
+	m := jobs.NewManager(1, 1, 0)
+	defer m.Close()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	run, started := blockingRun(release)
+	_, err := m.Submit("user-1", run)
+	require.NoError(t, err)
+	<-started
+
+	wait, _ := blockingRun(release)
+	_, err = m.Submit("user-1", wait)
+	require.NoError(t, err)
+
+	_, err = m.Submit("user-1", wait)
+	assert.ErrorIs(t, err, jobs.ErrQueueFull)
+}
+
+func TestManager_Close(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+
+	run, started := blockingRun(make(chan struct{}))
+	job, err := m.Submit("user-1", run)
+	require.NoError(t, err)
+	<-started
+
+	m.Close()
+
+	done, err := m.Get("user-1", job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusCancelled, done.Status)
+
+	_, err = m.Submit("user-1", run)
+	assert.ErrorIs(t, err, jobs.ErrClosed)
+}
+
+func TestManager_Watch(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	release := make(chan struct{})
+	run, started := blockingRun(release)
+	job, err := m.Submit("user-1", run)
+	require.NoError(t, err)
+
+	updates, stop, err := m.Watch("user-1", job.ID)
+	require.NoError(t, err)
+	defer stop()
+
+	<-started
+	close(release)
+
+	// Snapshots may be skipped for a slow reader, but the last one is always the finished job
+	var last jobs.Job
+	for update := range updates {
+		last = update
+	}
+	assert.Equal(t, jobs.StatusSucceeded, last.Status)
+	assert.NotNil(t, last.Result)
+}
+
+func TestManager_Watch_Finished(t *testing.T) {
+	m := jobs.NewManager(1, 0, 0)
+	defer m.Close()
+
+	job, err := m.Submit("user-1", func(ctx context.Context, progress scheduler.ProgressFunc) (*scheduler.Output, error) {
+		return &scheduler.Output{}, nil
+	})
+	require.NoError(t, err)
+	waitFor(t, m, "user-1", job.ID)
+
+	updates, stop, err := m.Watch("user-1", job.ID)
+	require.NoError(t, err)
+	defer stop()
+
+	update, ok := <-updates
+	require.True(t, ok)
+	assert.Equal(t, jobs.StatusSucceeded, update.Status)
+
+	_, ok = <-updates
+	assert.False(t, ok, "channel is closed after the final snapshot")
+
+	_, _, err = m.Watch("user-2", job.ID)
+	assert.ErrorIs(t, err, jobs.ErrNotFound)
+}
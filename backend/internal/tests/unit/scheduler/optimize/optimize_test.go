@@ -0,0 +1,364 @@
+package optimize_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/optimize"
+)
+
+// Test helpers
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(id uuid.UUID, name, roomType string) *models.Room {
+	return models.NewRoom(id, name, roomType, uuid.New(), 30, nil, nil)
+}
+
+func makeCourse(id uuid.UUID, name string) *models.Course {
+	return models.NewCourse(id, name, nil, nil)
+}
+
+func makeSession(id, courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(id, courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil)
+}
+
+// assertNoClashes checks that no room or instructor is double-booked
+func assertNoClashes(t *testing.T, sessions []*models.ScheduledSession) {
+	t.Helper()
+
+	for i, a := range sessions {
+		for _, b := range sessions[i+1:] {
+			if a.Day != b.Day || a.StartTime >= b.EndTime || b.StartTime >= a.EndTime {
+				continue
+			}
+			assert.NotEqual(t, a.RoomID, b.RoomID, "Room is double-booked")
+			if a.InstructorID != nil && b.InstructorID != nil {
+				assert.NotEqual(t, *a.InstructorID, *b.InstructorID, "Instructor is double-booked")
+			}
+		}
+	}
+}
+
+// TestOptimize_ReducesCost tests that a lumpy schedule is improved without breaking hard constraints
+func TestOptimize_ReducesCost(t *testing.T) {
+	instructorID := uuid.New()
+
+	var rooms []*models.Room
+	var courses []*models.Course
+	var sessions []*models.CourseSession
+	var placed []*models.ScheduledSession
+
+	// Every session packed into Monday 8AM, one per room
+	for i := range 4 {
+		room := makeRoom(uuid.New(), "Room", "lecture")
+		courseID := uuid.New()
+		session := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+		if i%2 == 0 {
+			session.InstructorID = &instructorID
+		}
+
+		rooms = append(rooms, room)
+		courses = append(courses, makeCourse(courseID, "Course"))
+		sessions = append(sessions, session)
+		placed = append(placed, &models.ScheduledSession{
+			CourseID:        courseID,
+			CourseSessionID: &session.ID,
+			RoomID:          room.ID,
+			InstructorID:    session.InstructorID,
+			Day:             0,
+			StartTime:       480 + 60*(i/2), // instructor's two sessions can't share a slot
+			EndTime:         540 + 60*(i/2),
+		})
+	}
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:        scheduler.TimeRange{Start: 480, End: 1020},
+			OperatingDays:         []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday},
+			PreferredSlotDuration: 60,
+		},
+		Rooms:          rooms,
+		Courses:        courses,
+		CourseSessions: sessions,
+	}
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(context.Background(), input, placed)
+
+	require.NoError(t, err)
+	require.Len(t, result.Sessions, len(placed))
+	assert.Less(t, result.FinalCost, result.InitialCost)
+	assert.Equal(t, optimize.SpreadObjective(result.Sessions), result.FinalCost)
+	assertNoClashes(t, result.Sessions)
+
+	for _, s := range result.Sessions {
+		assert.GreaterOrEqual(t, s.StartTime, 480)
+		assert.LessOrEqual(t, s.EndTime, 1020)
+		assert.Equal(t, 60, s.EndTime-s.StartTime)
+	}
+}
+
+// TestOptimize_DoesNotModifyInput tests that the sessions passed in are left untouched
+func TestOptimize_DoesNotModifyInput(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 2)
+
+	original := []*models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600},
+	}
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, original)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, original[1].Day)
+	assert.Equal(t, 540, original[1].StartTime)
+	// Meeting twice on one day is penalised, so the optimizer should split the sessions
+	assert.NotEqual(t, result.Sessions[0].Day, result.Sessions[1].Day)
+}
+
+// TestOptimize_RespectsRoomBlackouts tests that sessions are never moved into blacked-out time
+func TestOptimize_RespectsRoomBlackouts(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 2)
+
+	// Both sessions on Monday; the rest of the week is closed except Wednesday afternoon
+	original := []*models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600},
+	}
+	blackouts := []*models.RoomBlackout{
+		models.NewRoomBlackout(uuid.New(), roomID, 1, 0, 1440, models.RecurrenceWeekly, nil, nil, nil, nil),
+		models.NewRoomBlackout(uuid.New(), roomID, 2, 0, 780, models.RecurrenceWeekly, nil, nil, nil, nil),
+		models.NewRoomBlackout(uuid.New(), roomID, 3, 0, 1440, models.RecurrenceWeekly, nil, nil, nil, nil),
+		models.NewRoomBlackout(uuid.New(), roomID, 4, 0, 1440, models.RecurrenceWeekly, nil, nil, nil, nil),
+	}
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(context.Background(), &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+		RoomBlackouts:  blackouts,
+	}, original)
+
+	require.NoError(t, err)
+	for _, s := range result.Sessions {
+		assert.Contains(t, []int{0, 2}, s.Day)
+		if s.Day == 2 {
+			assert.GreaterOrEqual(t, s.StartTime, 780)
+		}
+	}
+	assert.NotEqual(t, result.Sessions[0].Day, result.Sessions[1].Day)
+}
+
+// TestOptimize_UnmatchedSessionsStayPut tests that sessions without a known course session are not moved
+func TestOptimize_UnmatchedSessionsStayPut(t *testing.T) {
+	roomID := uuid.New()
+	unknown := &models.ScheduledSession{CourseID: uuid.New(), RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540}
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(context.Background(), &scheduler.Input{
+		Rooms: []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+	}, []*models.ScheduledSession{unknown})
+
+	require.NoError(t, err)
+	require.Len(t, result.Sessions, 1)
+	assert.Equal(t, *unknown, *result.Sessions[0])
+	assert.Zero(t, result.Moves)
+}
+
+// TestOptimize_NilObjective tests that an objective is required
+func TestOptimize_NilObjective(t *testing.T) {
+	_, err := optimize.NewAnnealer(nil).Optimize(context.Background(), &scheduler.Input{}, nil)
+
+	require.Error(t, err)
+}
+
+// TestOptimize_PinnedSessionsStayPut tests that a session matching one of the config's pins is never moved
+func TestOptimize_PinnedSessionsStayPut(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 2)
+
+	// Both occurrences on Monday morning; only the unpinned one may move
+	original := []*models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600},
+	}
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:        scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:         []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+			PreferredSlotDuration: 60,
+			Pins:                  []models.SessionPin{{CourseSessionID: session.ID, Day: 0, StartTime: 540}},
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, original)
+
+	require.NoError(t, err)
+	assert.Equal(t, *original[1], *result.Sessions[1])
+	assert.Equal(t, 1, result.Sessions[0].Day, "unpinned occurrence should move off the pinned day")
+}
+
+// TestOptimize_DeadlinePassed_ReturnsIncomplete tests that running out of time returns the best
+// state found so far, flagged incomplete
+func TestOptimize_DeadlinePassed_ReturnsIncomplete(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 2)
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(ctx, &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, []*models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Incomplete)
+	assert.Equal(t, 0, result.Moves)
+	assert.Equal(t, result.InitialCost, result.FinalCost)
+	assert.Len(t, result.Sessions, 2)
+}
+
+// TestOptimize_Cancelled_Error tests that a cancelled context stops the run with its error
+func TestOptimize_Cancelled_Error(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(ctx, &scheduler.Input{
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, []*models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, result)
+}
+
+// TestOptimize_KeepsTravelTime tests that sessions sharing an instructor are never moved closer than the walk between their buildings
+func TestOptimize_KeepsTravelTime(t *testing.T) {
+	lectureRoom := makeRoom(uuid.New(), "Room 101", "lecture")
+	labRoom := makeRoom(uuid.New(), "Lab 1", "lab")
+	course1ID := uuid.New()
+	course2ID := uuid.New()
+	instructorID := uuid.New()
+	lecture := makeSession(uuid.New(), course1ID, "lecture", 60, 1)
+	lab := makeSession(uuid.New(), course2ID, "lab", 60, 1)
+
+	original := []*models.ScheduledSession{
+		{CourseID: course1ID, CourseSessionID: &lecture.ID, RoomID: lectureRoom.ID, InstructorID: &instructorID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: course2ID, CourseSessionID: &lab.ID, RoomID: labRoom.ID, InstructorID: &instructorID, Day: 0, StartTime: 600, EndTime: 660},
+	}
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday},
+		},
+		Rooms:          []*models.Room{lectureRoom, labRoom},
+		Courses:        []*models.Course{makeCourse(course1ID, "Math 101"), makeCourse(course2ID, "Physics Lab")},
+		CourseSessions: []*models.CourseSession{lecture, lab},
+		BuildingDistances: []*models.BuildingDistance{
+			models.NewBuildingDistance(lectureRoom.Building, labRoom.Building, 30, nil, nil),
+		},
+	}, original)
+
+	require.NoError(t, err)
+	a, b := result.Sessions[0], result.Sessions[1]
+	assert.True(t, a.StartTime >= b.EndTime+30 || b.StartTime >= a.EndTime+30, "Instructor has no time to walk between buildings")
+}
+
+// TestOptimize_KeepsDailyLimits tests that the optimizer never moves a session onto a day that is already at a limit
+func TestOptimize_KeepsDailyLimits(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 3)
+
+	original := []*models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 1, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 2, StartTime: 480, EndTime: 540},
+	}
+
+	result, err := optimize.NewAnnealer(func(sessions []*models.ScheduledSession) float64 {
+		// Reward piling everything onto Monday
+		cost := 0.0
+		for _, s := range sessions {
+			if s.Day != 0 {
+				cost++
+			}
+		}
+		return cost
+	}).Optimize(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours:          scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:           []scheduler.Day{scheduler.Monday, scheduler.Tuesday, scheduler.Wednesday},
+			MaxCourseSessionsPerDay: 2,
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, original)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result.FinalCost)
+
+	perDay := make(map[int]int)
+	for _, s := range result.Sessions {
+		perDay[s.Day]++
+	}
+	assert.Equal(t, 2, perDay[0])
+}
+
+// TestOptimize_LinkedSessionsStayPut tests that the parts of a linked occurrence are never moved apart
+func TestOptimize_LinkedSessionsStayPut(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+	session.Blocks = ptr(int32(2))
+
+	original := []*models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600, Block: 1},
+	}
+
+	result, err := optimize.NewAnnealer(optimize.SpreadObjective).Optimize(context.Background(), &scheduler.Input{
+		Config: &scheduler.Config{
+			OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+			OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+		},
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, original)
+
+	require.NoError(t, err)
+	assert.Equal(t, *original[0], *result.Sessions[0])
+	assert.Equal(t, *original[1], *result.Sessions[1])
+}
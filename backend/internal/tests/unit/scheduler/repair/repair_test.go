@@ -0,0 +1,348 @@
+package repair_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/greedy/weight"
+)
+
+// Test helpers
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(id uuid.UUID, name, roomType string) *models.Room {
+	return models.NewRoom(id, name, roomType, uuid.New(), 30, nil, nil)
+}
+
+func makeCourse(id uuid.UUID, name string) *models.Course {
+	return models.NewCourse(id, name, nil, nil)
+}
+
+func makeSession(id, courseID uuid.UUID, roomType string, duration, numSessions int32) *models.CourseSession {
+	return models.NewCourseSession(id, courseID, roomType, "lecture", ptr(duration), ptr(numSessions), nil, nil)
+}
+
+func testConfig() *scheduler.Config {
+	return &scheduler.Config{
+		OperatingHours: scheduler.TimeRange{Start: 480, End: 720},
+		OperatingDays:  []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
+	}
+}
+
+// repair plans a repair of current and runs the greedy scheduler on the result
+func repair(t *testing.T, input *scheduler.Input, current []models.ScheduledSession) (*scheduler.RepairPlan, *scheduler.RepairResult) {
+	t.Helper()
+
+	plan, err := scheduler.PlanRepair(input, input.Config, current, nil)
+	require.NoError(t, err)
+
+	input.Config = plan.Config
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+
+	return plan, plan.Result(output)
+}
+
+// TestRepair_NothingChanged tests that a schedule that is still valid comes back exactly as it was
+func TestRepair_NothingChanged(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 2)
+
+	current := []models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 1, StartTime: 600, EndTime: 660},
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600},
+	}
+
+	plan, result := repair(t, &scheduler.Input{
+		Config:         testConfig(),
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, current)
+
+	assert.Empty(t, plan.Invalidated)
+	assert.Equal(t, 2, result.Kept)
+	assert.Empty(t, result.Moved)
+	assert.Empty(t, result.Added)
+	assert.Empty(t, result.Removed)
+	require.Len(t, result.Output.ScheduledSessions, 2)
+	assert.Equal(t, current[0], *result.Output.ScheduledSessions[0])
+	assert.Equal(t, current[1], *result.Output.ScheduledSessions[1])
+}
+
+// TestRepair_ChangedDuration_OnlyThatSessionMoves tests that a session whose duration changed is
+// re-placed around the others, which stay put
+func TestRepair_ChangedDuration_OnlyThatSessionMoves(t *testing.T) {
+	roomID := uuid.New()
+	mathID := uuid.New()
+	physicsID := uuid.New()
+	math := makeSession(uuid.New(), mathID, "lecture", 60, 1)
+	physics := makeSession(uuid.New(), physicsID, "lecture", 120, 1) // was 60 minutes
+
+	current := []models.ScheduledSession{
+		{CourseID: mathID, CourseSessionID: &math.ID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600},
+		{CourseID: physicsID, CourseSessionID: &physics.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+	}
+
+	_, result := repair(t, &scheduler.Input{
+		Config:         testConfig(),
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(mathID, "Math 101"), makeCourse(physicsID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{math, physics},
+	}, current)
+
+	assert.Equal(t, 1, result.Kept)
+	require.Len(t, result.Moved, 1)
+	assert.Equal(t, physics.ID, result.Moved[0].CourseSessionID)
+	assert.Equal(t, current[1], result.Moved[0].From)
+	assert.Equal(t, 120, result.Moved[0].To.EndTime-result.Moved[0].To.StartTime)
+	assert.Empty(t, result.Added)
+	assert.Empty(t, result.Removed)
+	assert.Contains(t, result.Output.ScheduledSessions, &current[0])
+}
+
+// TestRepair_DeletedRoom tests that sessions in a room that no longer exists are moved to another room
+func TestRepair_DeletedRoom(t *testing.T) {
+	goneID := uuid.New()
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+
+	current := []models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: goneID, Day: 0, StartTime: 480, EndTime: 540},
+	}
+
+	_, result := repair(t, &scheduler.Input{
+		Config:         testConfig(),
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 102", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}, current)
+
+	require.Len(t, result.Moved, 1)
+	assert.Equal(t, roomID, result.Moved[0].To.RoomID)
+}
+
+// TestRepair_StalePinnedRoom tests that an inherited pin to a room that has been deleted is dropped
+// and reported, and the session it held is moved like any other
+func TestRepair_StalePinnedRoom(t *testing.T) {
+	goneID := uuid.New()
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+
+	current := []models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: goneID, Day: 0, StartTime: 480, EndTime: 540},
+	}
+	pin := models.SessionPin{CourseSessionID: session.ID, Day: 0, StartTime: 480, RoomID: &goneID}
+
+	input := &scheduler.Input{
+		Config:         testConfig(),
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 102", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+	}
+
+	plan, err := scheduler.PlanRepair(input, input.Config, current, []models.SessionPin{pin})
+	require.NoError(t, err)
+	assert.Empty(t, plan.Pins)
+	assert.Equal(t, []models.SessionPin{pin}, plan.RemovedPins)
+
+	input.Config = plan.Config
+	output, err := greedy.NewGreedyScheduler(&weight.TotalTimeWeight{}).Generate(context.Background(), input)
+	require.NoError(t, err)
+	result := plan.Result(output)
+
+	assert.Equal(t, []models.SessionPin{pin}, result.RemovedPins)
+	require.Len(t, result.Moved, 1)
+	assert.Equal(t, roomID, result.Moved[0].To.RoomID)
+
+	// The same pin sent by the caller is still an error
+	config := *testConfig()
+	config.Pins = []models.SessionPin{pin}
+	_, err = scheduler.PlanRepair(input, &config, current, nil)
+	assert.ErrorIs(t, err, scheduler.ErrInvalidPin)
+}
+
+// TestRepair_NewAndDeletedSessions tests that new occurrences are added and those of deleted
+// course sessions or reduced counts are removed
+func TestRepair_NewAndDeletedSessions(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	kept := makeSession(uuid.New(), courseID, "lecture", 60, 1) // was 2 a week
+	added := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+	deletedID := uuid.New()
+
+	current := []models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &kept.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &kept.ID, RoomID: roomID, Day: 1, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &deletedID, RoomID: roomID, Day: 0, StartTime: 600, EndTime: 660},
+	}
+
+	_, result := repair(t, &scheduler.Input{
+		Config:         testConfig(),
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{kept, added},
+	}, current)
+
+	assert.Equal(t, 1, result.Kept)
+	assert.Empty(t, result.Moved)
+	require.Len(t, result.Added, 1)
+	assert.Equal(t, added.ID, *result.Added[0].CourseSessionID)
+	require.Len(t, result.Removed, 2)
+	assert.Equal(t, current[1], *result.Removed[0])
+	assert.Equal(t, current[2], *result.Removed[1])
+}
+
+// TestRepair_NewCohortClash tests that when a new cohort makes two kept sessions clash, only the
+// later one moves
+func TestRepair_NewCohortClash(t *testing.T) {
+	room1ID := uuid.New()
+	room2ID := uuid.New()
+	mathID := uuid.New()
+	physicsID := uuid.New()
+	math := makeSession(uuid.New(), mathID, "lecture", 60, 1)
+	physics := makeSession(uuid.New(), physicsID, "lecture", 60, 1)
+
+	current := []models.ScheduledSession{
+		{CourseID: mathID, CourseSessionID: &math.ID, RoomID: room1ID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: physicsID, CourseSessionID: &physics.ID, RoomID: room2ID, Day: 0, StartTime: 480, EndTime: 540},
+	}
+
+	_, result := repair(t, &scheduler.Input{
+		Config: testConfig(),
+		Rooms: []*models.Room{
+			makeRoom(room1ID, "Room 101", "lecture"),
+			makeRoom(room2ID, "Room 102", "lecture"),
+		},
+		Courses:        []*models.Course{makeCourse(mathID, "Math 101"), makeCourse(physicsID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{math, physics},
+		Cohorts: []*models.Cohort{
+			{ID: uuid.New(), CourseIDs: []uuid.UUID{mathID, physicsID}},
+		},
+	}, current)
+
+	assert.Equal(t, 1, result.Kept)
+	require.Len(t, result.Moved, 1)
+	assert.Equal(t, physics.ID, result.Moved[0].CourseSessionID)
+	to := result.Moved[0].To
+	assert.False(t, to.Day == 0 && to.StartTime < 540, "moved session should no longer overlap the cohort's other session")
+}
+
+// TestRepair_BlackoutInvalidates tests that a new blackout over a kept session's slot moves it
+func TestRepair_BlackoutInvalidates(t *testing.T) {
+	roomID := uuid.New()
+	courseID := uuid.New()
+	session := makeSession(uuid.New(), courseID, "lecture", 60, 1)
+
+	current := []models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &session.ID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+	}
+
+	_, result := repair(t, &scheduler.Input{
+		Config:         testConfig(),
+		Rooms:          []*models.Room{makeRoom(roomID, "Room 101", "lecture")},
+		Courses:        []*models.Course{makeCourse(courseID, "Math 101")},
+		CourseSessions: []*models.CourseSession{session},
+		RoomBlackouts: []*models.RoomBlackout{
+			models.NewRoomBlackout(uuid.New(), roomID, 0, 480, 600, models.RecurrenceWeekly, nil, nil, nil, nil),
+		},
+	}, current)
+
+	require.Len(t, result.Moved, 1)
+	to := result.Moved[0].To
+	assert.False(t, to.Day == 0 && to.StartTime < 600)
+}
+
+// TestRepair_TravelTimeInvalidates tests that a new walking time between buildings moves a session that no longer leaves time for it
+func TestRepair_TravelTimeInvalidates(t *testing.T) {
+	room1 := makeRoom(uuid.New(), "Room 101", "lecture")
+	room2 := makeRoom(uuid.New(), "Room 201", "lecture")
+	mathID := uuid.New()
+	physicsID := uuid.New()
+	math := makeSession(uuid.New(), mathID, "lecture", 60, 1)
+	physics := makeSession(uuid.New(), physicsID, "lecture", 60, 1)
+
+	current := []models.ScheduledSession{
+		{CourseID: mathID, CourseSessionID: &math.ID, RoomID: room1.ID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: physicsID, CourseSessionID: &physics.ID, RoomID: room2.ID, Day: 0, StartTime: 540, EndTime: 600},
+	}
+
+	_, result := repair(t, &scheduler.Input{
+		Config:         testConfig(),
+		Rooms:          []*models.Room{room1, room2},
+		Courses:        []*models.Course{makeCourse(mathID, "Math 101"), makeCourse(physicsID, "Physics 101")},
+		CourseSessions: []*models.CourseSession{math, physics},
+		Cohorts: []*models.Cohort{
+			{ID: uuid.New(), CourseIDs: []uuid.UUID{mathID, physicsID}},
+		},
+		BuildingDistances: []*models.BuildingDistance{
+			models.NewBuildingDistance(room1.Building, room2.Building, 20, nil, nil),
+		},
+	}, current)
+
+	assert.Equal(t, 1, result.Kept)
+	require.Len(t, result.Moved, 1)
+	assert.Equal(t, physics.ID, result.Moved[0].CourseSessionID)
+	to := result.Moved[0].To
+	if to.Day == 0 && to.RoomID == room2.ID {
+		assert.GreaterOrEqual(t, to.StartTime, 560, "moved session should leave time to walk from the cohort's other session")
+	}
+}
+
+// TestRepair_LinkedOccurrence tests that a linked occurrence is kept whole while it still fits, and moves
+// whole when one of its parts doesn't
+func TestRepair_LinkedOccurrence(t *testing.T) {
+	room1ID := uuid.New()
+	room2ID := uuid.New()
+	courseID := uuid.New()
+	session := models.NewCourseSession(uuid.New(), courseID, "lab", "lab", ptr(int32(60)), ptr(int32(2)), nil, nil)
+	session.Blocks = ptr(int32(2))
+	session.ParallelSections = ptr(int32(2))
+
+	occurrence := func(day, start int) []models.ScheduledSession {
+		return []models.ScheduledSession{
+			{CourseID: courseID, CourseSessionID: &session.ID, RoomID: room1ID, Day: day, StartTime: start, EndTime: start + 60},
+			{CourseID: courseID, CourseSessionID: &session.ID, RoomID: room1ID, Day: day, StartTime: start + 60, EndTime: start + 120, Block: 1},
+			{CourseID: courseID, CourseSessionID: &session.ID, RoomID: room2ID, Day: day, StartTime: start, EndTime: start + 60, Section: 1},
+			{CourseID: courseID, CourseSessionID: &session.ID, RoomID: room2ID, Day: day, StartTime: start + 60, EndTime: start + 120, Block: 1, Section: 1},
+		}
+	}
+	current := append(occurrence(0, 480), occurrence(1, 600)...)
+
+	input := func() *scheduler.Input {
+		return &scheduler.Input{
+			Config:         testConfig(),
+			Rooms:          []*models.Room{makeRoom(room1ID, "Lab 1", "lab"), makeRoom(room2ID, "Lab 2", "lab")},
+			Courses:        []*models.Course{makeCourse(courseID, "Chemistry 101")},
+			CourseSessions: []*models.CourseSession{session},
+		}
+	}
+
+	plan, result := repair(t, input(), current)
+	assert.Empty(t, plan.Invalidated)
+	assert.Equal(t, 8, result.Kept)
+	assert.Len(t, result.Output.ScheduledSessions, 8)
+
+	// Black out the second lab on Tuesday: only the second section's parts are hit, but the whole occurrence moves
+	blocked := input()
+	blocked.RoomBlackouts = []*models.RoomBlackout{{ID: uuid.New(), RoomID: room2ID, Day: 1, StartTime: 660, EndTime: 720, Recurrence: models.RecurrenceWeekly}}
+
+	plan, result = repair(t, blocked, current)
+	assert.Len(t, plan.Invalidated, 4)
+	assert.Equal(t, 4, result.Kept)
+	assert.Len(t, result.Moved, 4)
+	assert.Empty(t, result.Removed)
+	for _, move := range result.Moved {
+		assert.Equal(t, 1, move.From.Day)
+	}
+}
@@ -0,0 +1,170 @@
+package scoring_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/scoring"
+)
+
+// Test helpers
+func ptr[T any](v T) *T { return &v }
+
+func makeRoom(id uuid.UUID, capacity int32) *models.Room {
+	return models.NewRoom(id, "Room", "lecture", uuid.New(), capacity, nil, nil)
+}
+
+func makeCourse(id uuid.UUID, name string) *models.Course {
+	return models.NewCourse(id, name, nil, nil)
+}
+
+func place(courseID, roomID uuid.UUID, day, start, end int) *models.ScheduledSession {
+	return &models.ScheduledSession{CourseID: courseID, RoomID: roomID, Day: day, StartTime: start, EndTime: end}
+}
+
+func TestCohortGaps(t *testing.T) {
+	roomID := uuid.New()
+	courseA, courseB, courseC := uuid.New(), uuid.New(), uuid.New()
+
+	input := &scheduler.Input{
+		Config: &scheduler.Config{MinBreakBetweenSessions: 15},
+		Cohorts: []*models.Cohort{
+			{ID: uuid.New(), Programme: "CS", Year: 1, CourseIDs: []uuid.UUID{courseA, courseB}},
+		},
+	}
+	data := scoring.NewData(input)
+
+	t.Run("counts idle hours between a cohort's sessions", func(t *testing.T) {
+		sessions := []*models.ScheduledSession{
+			place(courseB, roomID, 0, 720, 780), // 12:00-13:00
+			place(courseA, roomID, 0, 480, 540), // 8:00-9:00
+		}
+
+		assert.InDelta(t, 3.0, scoring.CohortGaps{}.Penalty(data, sessions), 1e-9)
+	})
+
+	t.Run("ignores the minimum break", func(t *testing.T) {
+		sessions := []*models.ScheduledSession{
+			place(courseA, roomID, 0, 480, 540),
+			place(courseB, roomID, 0, 555, 615),
+		}
+
+		assert.Zero(t, scoring.CohortGaps{}.Penalty(data, sessions))
+	})
+
+	t.Run("ignores other days and courses outside the cohort", func(t *testing.T) {
+		sessions := []*models.ScheduledSession{
+			place(courseA, roomID, 0, 480, 540),
+			place(courseB, roomID, 1, 720, 780),
+			place(courseC, roomID, 0, 900, 960),
+		}
+
+		assert.Zero(t, scoring.CohortGaps{}.Penalty(data, sessions))
+	})
+}
+
+func TestLateEvening(t *testing.T) {
+	roomID, courseID := uuid.New(), uuid.New()
+	late := scoring.LateEvening{Start: scoring.DefaultLateEveningStart}
+
+	sessions := []*models.ScheduledSession{
+		place(courseID, roomID, 0, 480, 540),   // morning
+		place(courseID, roomID, 1, 1050, 1110), // 17:30-18:30
+		place(courseID, roomID, 2, 1140, 1200), // 19:00-20:00
+	}
+
+	assert.InDelta(t, 1.5, late.Penalty(scoring.NewData(&scheduler.Input{}), sessions), 1e-9)
+}
+
+func TestSameDayRepeats(t *testing.T) {
+	roomID := uuid.New()
+	courseA, courseB := uuid.New(), uuid.New()
+
+	sessions := []*models.ScheduledSession{
+		place(courseA, roomID, 0, 480, 540),
+		place(courseA, roomID, 0, 600, 660),
+		place(courseA, roomID, 0, 720, 780),
+		place(courseA, roomID, 1, 480, 540),
+		place(courseB, roomID, 0, 900, 960),
+	}
+
+	assert.InDelta(t, 2.0, scoring.SameDayRepeats{}.Penalty(scoring.NewData(&scheduler.Input{}), sessions), 1e-9)
+}
+
+func TestRoomWaste(t *testing.T) {
+	roomID := uuid.New()
+	sized, unsized := uuid.New(), uuid.New()
+
+	sizedCourse := makeCourse(sized, "CS 101")
+	sizedCourse.ExpectedEnrollment = ptr(int32(25))
+
+	input := &scheduler.Input{
+		Rooms:   []*models.Room{makeRoom(roomID, 100)},
+		Courses: []*models.Course{sizedCourse, makeCourse(unsized, "CS 102")},
+	}
+
+	sessions := []*models.ScheduledSession{
+		place(sized, roomID, 0, 480, 540),
+		place(unsized, roomID, 1, 480, 540),
+	}
+
+	assert.InDelta(t, 0.75, scoring.RoomWaste{}.Penalty(scoring.NewData(input), sessions), 1e-9)
+}
+
+func TestInstructorPreferences(t *testing.T) {
+	roomID, courseID := uuid.New(), uuid.New()
+	flexibleID, morningID := uuid.New(), uuid.New()
+
+	input := &scheduler.Input{
+		Instructors: []*models.Instructor{
+			{ID: flexibleID, Name: "Dr. Flexible"},
+			{ID: morningID, Name: "Dr. Morning", PreferredStart: ptr(int32(480)), PreferredEnd: ptr(int32(720))},
+		},
+	}
+
+	flexible := place(courseID, roomID, 0, 1140, 1200)
+	flexible.InstructorID = &flexibleID
+
+	within := place(courseID, roomID, 0, 540, 600)
+	within.InstructorID = &morningID
+
+	straddling := place(courseID, roomID, 1, 690, 810) // 11:30-13:30
+	straddling.InstructorID = &morningID
+
+	sessions := []*models.ScheduledSession{flexible, within, straddling}
+
+	assert.InDelta(t, 1.5, scoring.InstructorPreferences{}.Penalty(scoring.NewData(input), sessions), 1e-9)
+}
+
+func TestModel_Score(t *testing.T) {
+	roomID, courseID := uuid.New(), uuid.New()
+
+	model := scoring.NewModel(
+		scoring.WeightedConstraint{Constraint: scoring.SameDayRepeats{}, Weight: 5},
+		scoring.WeightedConstraint{Constraint: scoring.LateEvening{Start: scoring.DefaultLateEveningStart}, Weight: 2},
+	)
+
+	sessions := []*models.ScheduledSession{
+		place(courseID, roomID, 0, 480, 540),
+		place(courseID, roomID, 0, 1080, 1140),
+	}
+
+	score := model.Score(&scheduler.Input{}, sessions)
+
+	assert.Equal(t, map[string]float64{"same_day_repeats": 5, "late_evening": 2}, score.Breakdown)
+	assert.InDelta(t, 7.0, score.Total, 1e-9)
+	assert.InDelta(t, score.Total, model.Objective(&scheduler.Input{})(sessions), 1e-9)
+}
+
+func TestDefaultModel_ReportsEveryConstraint(t *testing.T) {
+	score := scoring.DefaultModel().Score(&scheduler.Input{}, nil)
+
+	assert.Zero(t, score.Total)
+	for _, name := range []string{"cohort_gaps", "late_evening", "same_day_repeats", "room_waste", "instructor_preferences"} {
+		assert.Contains(t, score.Breakdown, name)
+	}
+}
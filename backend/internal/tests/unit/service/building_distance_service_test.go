@@ -0,0 +1,140 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+// buildingsRepo returns a building repository that only knows the given buildings
+func buildingsRepo(ids ...uuid.UUID) *mocks.MockBuildingRepository {
+	return &mocks.MockBuildingRepository{
+		GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Building, error) {
+			for _, known := range ids {
+				if known == id {
+					return models.NewBuilding(id, "Building", nil, nil), nil
+				}
+			}
+			return nil, repository.ErrNotFound
+		},
+	}
+}
+
+func TestBuildingDistanceService_Set(t *testing.T) {
+	ctx := context.Background()
+	fromID, toID := uuid.New(), uuid.New()
+	distance := models.NewBuildingDistance(fromID, toID, 10, nil, nil)
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingDistanceRepository{
+			SetFunc: func(ctx context.Context, d *models.BuildingDistance) (*models.BuildingDistance, error) {
+				return d, nil
+			},
+		}
+
+		svc := service.NewBuildingDistanceService(mockRepo, buildingsRepo(fromID, toID))
+		result, err := svc.Set(ctx, distance)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(10), result.Minutes)
+	})
+
+	t.Run("unknown building", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingDistanceRepository{
+			SetFunc: func(ctx context.Context, d *models.BuildingDistance) (*models.BuildingDistance, error) {
+				t.Fatal("distance should not be stored")
+				return nil, nil
+			},
+		}
+
+		svc := service.NewBuildingDistanceService(mockRepo, buildingsRepo(fromID))
+		result, err := svc.Set(ctx, distance)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingDistanceRepository{
+			SetFunc: func(ctx context.Context, d *models.BuildingDistance) (*models.BuildingDistance, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewBuildingDistanceService(mockRepo, buildingsRepo(fromID, toID))
+		result, err := svc.Set(ctx, distance)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBuildingDistanceService_GetByBuildingID(t *testing.T) {
+	ctx := context.Background()
+	buildingID := uuid.New()
+	distances := []*models.BuildingDistance{
+		models.NewBuildingDistance(buildingID, uuid.New(), 5, nil, nil),
+		models.NewBuildingDistance(buildingID, uuid.New(), 12, nil, nil),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingDistanceRepository{
+			GetByBuildingIDFunc: func(ctx context.Context, id uuid.UUID) ([]*models.BuildingDistance, error) {
+				assert.Equal(t, buildingID, id)
+				return distances, nil
+			},
+		}
+
+		svc := service.NewBuildingDistanceService(mockRepo, buildingsRepo(buildingID))
+		result, err := svc.GetByBuildingID(ctx, buildingID)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+
+	t.Run("unknown building", func(t *testing.T) {
+		svc := service.NewBuildingDistanceService(&mocks.MockBuildingDistanceRepository{}, buildingsRepo())
+		result, err := svc.GetByBuildingID(ctx, buildingID)
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestBuildingDistanceService_Delete(t *testing.T) {
+	ctx := context.Background()
+	fromID, toID := uuid.New(), uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingDistanceRepository{
+			DeleteFunc: func(ctx context.Context, from, to uuid.UUID) error {
+				assert.Equal(t, fromID, from)
+				assert.Equal(t, toID, to)
+				return nil
+			},
+		}
+
+		svc := service.NewBuildingDistanceService(mockRepo, buildingsRepo())
+		require.NoError(t, svc.Delete(ctx, fromID, toID))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockBuildingDistanceRepository{
+			DeleteFunc: func(ctx context.Context, from, to uuid.UUID) error {
+				return repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewBuildingDistanceService(mockRepo, buildingsRepo())
+		require.ErrorIs(t, svc.Delete(ctx, fromID, toID), repository.ErrNotFound)
+	})
+}
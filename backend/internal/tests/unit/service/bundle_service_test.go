@@ -0,0 +1,346 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+// workspace is one user's records, kept in memory by mock repositories
+type workspace struct {
+	roomTypes   []*models.RoomType
+	buildings   []models.Building
+	distances   []*models.BuildingDistance
+	rooms       []*models.Room
+	blackouts   []*models.RoomBlackout
+	instructors []*models.Instructor
+	courses     []models.Course
+	terms       []*models.Term
+	sessions    []*models.CourseSession
+	constraints []*models.SessionConstraint
+	cohorts     []*models.Cohort
+	schedules   []*models.Schedule
+}
+
+func (ws *workspace) schedule(id uuid.UUID) *models.Schedule {
+	for _, schedule := range ws.schedules {
+		if schedule.ID == id {
+			return schedule
+		}
+	}
+	return nil
+}
+
+func (ws *workspace) service() *service.BundleService {
+	return service.NewBundleService(
+		&mocks.MockRoomTypeRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomType, error) { return ws.roomTypes, nil },
+			CreateFunc: func(ctx context.Context, roomType *models.RoomType) (*models.RoomType, error) {
+				ws.roomTypes = append(ws.roomTypes, roomType)
+				return roomType, nil
+			},
+		},
+		&mocks.MockBuildingRepository{
+			ListFunc: func(ctx context.Context) ([]models.Building, error) { return ws.buildings, nil },
+			CreateFunc: func(ctx context.Context, building *models.Building) (*models.Building, error) {
+				ws.buildings = append(ws.buildings, *building)
+				return building, nil
+			},
+		},
+		&mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) { return ws.distances, nil },
+			SetFunc: func(ctx context.Context, distance *models.BuildingDistance) (*models.BuildingDistance, error) {
+				ws.distances = append(ws.distances, distance)
+				return distance, nil
+			},
+		},
+		&mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) { return ws.rooms, nil },
+			CreateFunc: func(ctx context.Context, room *models.Room) (*models.Room, error) {
+				ws.rooms = append(ws.rooms, room)
+				return room, nil
+			},
+		},
+		&mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) { return ws.blackouts, nil },
+			CreateFunc: func(ctx context.Context, blackout *models.RoomBlackout) (*models.RoomBlackout, error) {
+				ws.blackouts = append(ws.blackouts, blackout)
+				return blackout, nil
+			},
+		},
+		&mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) { return ws.instructors, nil },
+			CreateFunc: func(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error) {
+				ws.instructors = append(ws.instructors, instructor)
+				return instructor, nil
+			},
+		},
+		&mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) { return ws.courses, nil },
+			CreateFunc: func(ctx context.Context, course *models.Course) (*models.Course, error) {
+				ws.courses = append(ws.courses, *course)
+				return course, nil
+			},
+		},
+		&mocks.MockTermRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Term, error) { return ws.terms, nil },
+			CreateFunc: func(ctx context.Context, term *models.Term) (*models.Term, error) {
+				ws.terms = append(ws.terms, term)
+				return term, nil
+			},
+		},
+		&mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) { return ws.sessions, nil },
+			CreateFunc: func(ctx context.Context, session *models.CourseSession) (*models.CourseSession, error) {
+				ws.sessions = append(ws.sessions, session)
+				return session, nil
+			},
+		},
+		&mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) { return ws.constraints, nil },
+			CreateFunc: func(ctx context.Context, constraint *models.SessionConstraint) (*models.SessionConstraint, error) {
+				ws.constraints = append(ws.constraints, constraint)
+				return constraint, nil
+			},
+		},
+		&mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) { return ws.cohorts, nil },
+			CreateFunc: func(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error) {
+				ws.cohorts = append(ws.cohorts, cohort)
+				return cohort, nil
+			},
+		},
+		&mocks.MockScheduleRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Schedule, error) {
+				var schedules []*models.Schedule
+				for _, schedule := range ws.schedules {
+					if !schedule.IsArchived {
+						schedules = append(schedules, schedule)
+					}
+				}
+				return schedules, nil
+			},
+			ListArchivedFunc: func(ctx context.Context) ([]*models.Schedule, error) {
+				var schedules []*models.Schedule
+				for _, schedule := range ws.schedules {
+					if schedule.IsArchived {
+						schedules = append(schedules, schedule)
+					}
+				}
+				return schedules, nil
+			},
+			GetActiveFunc: func(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error) {
+				for _, schedule := range ws.schedules {
+					if schedule.IsActive && schedule.TermID == nil && termID == nil {
+						return schedule, nil
+					}
+				}
+				return nil, repository.ErrNotFound
+			},
+			CreateFunc: func(ctx context.Context, schedule *models.Schedule) (*models.Schedule, error) {
+				saved := *schedule
+				saved.IsActive, saved.IsArchived = false, false
+				ws.schedules = append(ws.schedules, &saved)
+				return &saved, nil
+			},
+			SetActiveFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				ws.schedule(id).IsActive = true
+				return ws.schedule(id), nil
+			},
+			ArchiveFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				ws.schedule(id).IsArchived = true
+				return ws.schedule(id), nil
+			},
+		},
+	)
+}
+
+// newSourceWorkspace returns a workspace with one of everything that refers to each other
+func newSourceWorkspace() *workspace {
+	science, engineering := uuid.New(), uuid.New()
+	room := uuid.New()
+	instructor := uuid.New()
+	course := uuid.New()
+	term := uuid.New()
+	session := uuid.New()
+
+	return &workspace{
+		roomTypes:   []*models.RoomType{{Name: "lecture_room"}},
+		buildings:   []models.Building{{ID: science, Name: "Science"}, {ID: engineering, Name: "Engineering"}},
+		distances:   []*models.BuildingDistance{{FromBuildingID: science, ToBuildingID: engineering, Minutes: 5}},
+		rooms:       []*models.Room{{ID: room, Name: "101", Type: "lecture_room", Building: science, Capacity: 40}},
+		blackouts:   []*models.RoomBlackout{{ID: uuid.New(), RoomID: room, Day: 0, StartTime: 480, EndTime: 540, Recurrence: models.RecurrenceWeekly}},
+		instructors: []*models.Instructor{{ID: instructor, Name: "Dr. Smith"}},
+		courses:     []models.Course{{ID: course, Name: "Math 101"}},
+		terms: []*models.Term{{
+			ID: term, Name: "Fall 2025", StartDate: "2025-09-01", EndDate: "2025-12-12", TeachingWeeks: 12,
+			CourseIDs: []uuid.UUID{course},
+		}},
+		sessions: []*models.CourseSession{{
+			ID: session, CourseID: course, Type: "lecture", RequiredRoom: "lecture_room",
+			Duration: ptr[int32](60), NumberOfSessions: ptr[int32](2), InstructorID: &instructor, TermID: &term,
+		}},
+		constraints: []*models.SessionConstraint{{ID: uuid.New(), CourseID: course, Kind: models.SessionConstraintAfter, FirstType: "lecture", SecondType: "lab"}},
+		cohorts:     []*models.Cohort{{ID: uuid.New(), Programme: "BSc Mathematics", Year: 1, CourseIDs: []uuid.UUID{course}}},
+		schedules: []*models.Schedule{
+			{
+				ID: uuid.New(), Name: "Fall draft", TermID: &term, IsActive: true,
+				Sessions: []models.ScheduledSession{{CourseID: course, CourseSessionID: &session, RoomID: room, InstructorID: &instructor, Day: 0, StartTime: 540, EndTime: 600}},
+				Pins:     []models.SessionPin{{CourseSessionID: session, Day: 0, StartTime: 540, RoomID: &room}},
+			},
+			{
+				ID: uuid.New(), Name: "Old", IsArchived: true,
+				Sessions: []models.ScheduledSession{{CourseID: course, RoomID: room, Day: 1, StartTime: 540, EndTime: 600}},
+			},
+		},
+	}
+}
+
+func TestBundleService_Export(t *testing.T) {
+	ctx := context.Background()
+	source := newSourceWorkspace()
+
+	bundle, err := source.service().Export(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.BundleVersion, bundle.Version)
+	assert.Len(t, bundle.Buildings, 2)
+	assert.Len(t, bundle.Rooms, 1)
+	assert.Len(t, bundle.CourseSessions, 1)
+	require.Len(t, bundle.Schedules, 2)
+	assert.True(t, bundle.Schedules[1].IsArchived)
+}
+
+func TestBundleService_Restore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("copies every record onto new ids", func(t *testing.T) {
+		source := newSourceWorkspace()
+		bundle, err := source.service().Export(ctx)
+		require.NoError(t, err)
+
+		target := &workspace{roomTypes: []*models.RoomType{{Name: "lecture_room"}}}
+		report, err := target.service().Restore(ctx, bundle)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{
+			"buildings": 2, "building_distances": 1, "rooms": 1, "room_blackouts": 1, "instructors": 1,
+			"courses": 1, "terms": 1, "course_sessions": 1, "session_constraints": 1, "cohorts": 1, "schedules": 2,
+		}, report.Created)
+		assert.Len(t, target.roomTypes, 1, "existing room types are reused")
+
+		buildings := map[string]uuid.UUID{}
+		for _, building := range target.buildings {
+			assert.NotEqual(t, source.buildings[0].ID, building.ID)
+			assert.NotEqual(t, source.buildings[1].ID, building.ID)
+			buildings[building.Name] = building.ID
+		}
+		assert.Equal(t, buildings["Science"], target.distances[0].FromBuildingID)
+		assert.Equal(t, buildings["Engineering"], target.distances[0].ToBuildingID)
+
+		room := target.rooms[0]
+		assert.NotEqual(t, source.rooms[0].ID, room.ID)
+		assert.Equal(t, buildings["Science"], room.Building)
+		assert.Equal(t, room.ID, target.blackouts[0].RoomID)
+
+		course := target.courses[0].ID
+		instructor := target.instructors[0].ID
+		term := target.terms[0].ID
+		session := target.sessions[0]
+		assert.NotEqual(t, source.courses[0].ID, course)
+		assert.Equal(t, []uuid.UUID{course}, target.terms[0].CourseIDs)
+		assert.Equal(t, course, session.CourseID)
+		assert.Equal(t, instructor, *session.InstructorID)
+		assert.Equal(t, term, *session.TermID)
+		assert.Equal(t, course, target.constraints[0].CourseID)
+		assert.Equal(t, []uuid.UUID{course}, target.cohorts[0].CourseIDs)
+
+		active := target.schedules[0]
+		assert.True(t, active.IsActive)
+		assert.Equal(t, term, *active.TermID)
+		assert.Equal(t, models.ScheduledSession{CourseID: course, CourseSessionID: &session.ID, RoomID: room.ID, InstructorID: &instructor, Day: 0, StartTime: 540, EndTime: 600}, active.Sessions[0])
+		assert.Equal(t, session.ID, active.Pins[0].CourseSessionID)
+		assert.Equal(t, room.ID, *active.Pins[0].RoomID)
+		assert.True(t, target.schedules[1].IsArchived)
+
+		// The source is left untouched
+		assert.Equal(t, source.rooms[0].ID, bundle.Rooms[0].ID)
+		assert.Equal(t, source.buildings[0].ID, source.rooms[0].Building)
+	})
+
+	t.Run("keeps the account's active schedule", func(t *testing.T) {
+		source := newSourceWorkspace()
+		source.schedules[1].IsArchived, source.schedules[1].IsActive = false, true
+		bundle, err := source.service().Export(ctx)
+		require.NoError(t, err)
+
+		existing := &models.Schedule{ID: uuid.New(), Name: "Current", IsActive: true}
+		target := &workspace{schedules: []*models.Schedule{existing}}
+		_, err = target.service().Restore(ctx, bundle)
+
+		require.NoError(t, err)
+		assert.True(t, existing.IsActive)
+		assert.False(t, target.schedules[2].IsActive)
+	})
+
+	t.Run("rejects names the account already has", func(t *testing.T) {
+		source := newSourceWorkspace()
+		bundle, err := source.service().Export(ctx)
+		require.NoError(t, err)
+
+		_, err = source.service().Restore(ctx, bundle)
+
+		require.ErrorIs(t, err, service.ErrInvalidBundle)
+		assert.Contains(t, err.Error(), `buildings[0]: building "Science" already exists`)
+		assert.Len(t, source.buildings, 2)
+	})
+
+	t.Run("rejects references to records outside the bundle", func(t *testing.T) {
+		source := newSourceWorkspace()
+		source.sessions[0].CourseID = uuid.New()
+		bundle, err := source.service().Export(ctx)
+		require.NoError(t, err)
+
+		target := &workspace{}
+		_, err = target.service().Restore(ctx, bundle)
+
+		require.ErrorIs(t, err, service.ErrInvalidBundle)
+		assert.Contains(t, err.Error(), "course_sessions[0]: course")
+		assert.Empty(t, target.buildings, "nothing is created")
+	})
+
+	t.Run("rejects other versions", func(t *testing.T) {
+		target := &workspace{}
+
+		_, err := target.service().Restore(ctx, &models.Bundle{Version: models.BundleVersion + 1})
+
+		assert.ErrorIs(t, err, service.ErrInvalidBundle)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		bundle, err := newSourceWorkspace().service().Export(ctx)
+		require.NoError(t, err)
+
+		failing := service.NewBundleService(
+			&mocks.MockRoomTypeRepository{ListFunc: func(ctx context.Context) ([]*models.RoomType, error) {
+				return nil, errors.New("database error")
+			}},
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		)
+
+		report, err := failing.Restore(ctx, bundle)
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, service.ErrInvalidBundle)
+		assert.Nil(t, report)
+	})
+}
@@ -0,0 +1,166 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/ical"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestCalendarFeedService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("generates a new token", func(t *testing.T) {
+		mockRepo := &mocks.MockCalendarFeedRepository{
+			CreateFunc: func(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+				return feed, nil
+			},
+		}
+		svc := service.NewCalendarFeedService(mockRepo, &mocks.MockScheduleRepository{}, &mocks.MockCalendarService{})
+
+		first, err := svc.Create(ctx, &models.CalendarFeed{ID: uuid.New(), Name: "Math 101", Token: "chosen-by-client"})
+		require.NoError(t, err)
+		second, err := svc.Create(ctx, &models.CalendarFeed{ID: uuid.New(), Name: "Math 101"})
+		require.NoError(t, err)
+
+		assert.GreaterOrEqual(t, len(first.Token), models.MinCalendarFeedTokenLength)
+		assert.NotEqual(t, "chosen-by-client", first.Token)
+		assert.NotEqual(t, first.Token, second.Token)
+		assert.NotContains(t, first.Token, "/")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockCalendarFeedRepository{
+			CreateFunc: func(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+				return nil, errors.New("database error")
+			},
+		}
+		svc := service.NewCalendarFeedService(mockRepo, &mocks.MockScheduleRepository{}, &mocks.MockCalendarService{})
+
+		result, err := svc.Create(ctx, &models.CalendarFeed{ID: uuid.New(), Name: "Math 101"})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCalendarFeedService_Owner(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.New()
+
+	mockRepo := &mocks.MockCalendarFeedRepository{
+		GetOwnerFunc: func(ctx context.Context, token string) (uuid.UUID, error) {
+			if token != "token" {
+				return uuid.Nil, repository.ErrNotFound
+			}
+			return owner, nil
+		},
+	}
+	svc := service.NewCalendarFeedService(mockRepo, &mocks.MockScheduleRepository{}, &mocks.MockCalendarService{})
+
+	result, err := svc.Owner(ctx, "token")
+	require.NoError(t, err)
+	assert.Equal(t, owner, result)
+
+	_, err = svc.Owner(ctx, "revoked")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestCalendarFeedService_ICal(t *testing.T) {
+	ctx := context.Background()
+	termID, courseID := uuid.New(), uuid.New()
+	feed := &models.CalendarFeed{ID: uuid.New(), Name: "Math 101 feed", Token: "token", TermID: &termID, CourseID: &courseID}
+
+	feedRepo := &mocks.MockCalendarFeedRepository{
+		GetByTokenFunc: func(ctx context.Context, token string) (*models.CalendarFeed, error) {
+			if token != feed.Token {
+				return nil, repository.ErrNotFound
+			}
+			return feed, nil
+		},
+	}
+
+	t.Run("exports the term's active schedule", func(t *testing.T) {
+		active := &models.Schedule{ID: uuid.New(), Name: "Fall 2025 v2", TermID: &termID}
+		scheduleRepo := &mocks.MockScheduleRepository{
+			GetActiveFunc: func(ctx context.Context, id *uuid.UUID) (*models.Schedule, error) {
+				require.Equal(t, &termID, id)
+				return active, nil
+			},
+		}
+		calendar := &mocks.MockCalendarService{
+			ICalFunc: func(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) (*ical.Calendar, error) {
+				assert.Equal(t, active.ID, scheduleID)
+				assert.Equal(t, &courseID, filter.CourseID)
+				assert.Empty(t, filter.From) // the term's dates
+				return &ical.Calendar{Name: active.Name, Events: []ical.Event{{UID: "event"}}}, nil
+			},
+		}
+		svc := service.NewCalendarFeedService(feedRepo, scheduleRepo, calendar)
+
+		result, err := svc.ICal(ctx, "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Math 101 feed", result.Name)
+		assert.Len(t, result.Events, 1)
+	})
+
+	t.Run("schedule without a term covers a window around today", func(t *testing.T) {
+		noTermFeed := &models.CalendarFeed{ID: uuid.New(), Name: "Everything", Token: "token"}
+		scheduleRepo := &mocks.MockScheduleRepository{
+			GetActiveFunc: func(ctx context.Context, id *uuid.UUID) (*models.Schedule, error) {
+				return &models.Schedule{ID: uuid.New()}, nil
+			},
+		}
+		calendar := &mocks.MockCalendarService{
+			ICalFunc: func(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) (*ical.Calendar, error) {
+				today := time.Now().Format(time.DateOnly)
+				assert.Less(t, filter.From, today)
+				assert.Greater(t, filter.To, today)
+				return &ical.Calendar{}, nil
+			},
+		}
+		svc := service.NewCalendarFeedService(&mocks.MockCalendarFeedRepository{
+			GetByTokenFunc: func(ctx context.Context, token string) (*models.CalendarFeed, error) {
+				return noTermFeed, nil
+			},
+		}, scheduleRepo, calendar)
+
+		_, err := svc.ICal(ctx, "token")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("no active schedule gives an empty calendar", func(t *testing.T) {
+		scheduleRepo := &mocks.MockScheduleRepository{
+			GetActiveFunc: func(ctx context.Context, id *uuid.UUID) (*models.Schedule, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+		svc := service.NewCalendarFeedService(feedRepo, scheduleRepo, &mocks.MockCalendarService{})
+
+		result, err := svc.ICal(ctx, "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Math 101 feed", result.Name)
+		assert.Empty(t, result.Events)
+	})
+
+	t.Run("revoked token", func(t *testing.T) {
+		svc := service.NewCalendarFeedService(feedRepo, &mocks.MockScheduleRepository{}, &mocks.MockCalendarService{})
+
+		_, err := svc.ICal(ctx, "revoked")
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
@@ -0,0 +1,320 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestCalendarService_Occurrences(t *testing.T) {
+	ctx := context.Background()
+	courseA, courseB := uuid.New(), uuid.New()
+	roomA, roomB := uuid.New(), uuid.New()
+
+	// 2025-09-01 is a Monday. Week 3 is a reading week, so teaching runs into week 5.
+	term := &models.Term{
+		ID:            uuid.New(),
+		Name:          "Fall 2025",
+		StartDate:     "2025-09-01",
+		EndDate:       "2025-10-10",
+		TeachingWeeks: 4,
+		Holidays: []models.TermHoliday{
+			{Name: "Public holiday", StartDate: "2025-09-03", EndDate: "2025-09-03"},
+			{Name: "Reading week", StartDate: "2025-09-15", EndDate: "2025-09-19"},
+		},
+	}
+
+	science, engineering := uuid.New(), uuid.New()
+	lecture := &models.CourseSession{ID: uuid.New(), CourseID: courseA, Type: "lecture"}
+	instructor := &models.Instructor{ID: uuid.New(), Name: "Dr. Smith"}
+
+	schedule := &models.Schedule{
+		ID:     uuid.New(),
+		Name:   "Fall 2025",
+		TermID: &term.ID,
+		Sessions: []models.ScheduledSession{
+			{CourseID: courseA, CourseSessionID: &lecture.ID, RoomID: roomA, InstructorID: &instructor.ID, Day: 0, StartTime: 540, EndTime: 600},
+			{CourseID: courseB, RoomID: roomB, Day: 2, StartTime: 660, EndTime: 720},
+			{CourseID: courseA, RoomID: roomB, Day: 2, StartTime: 480, EndTime: 540},
+		},
+	}
+
+	newService := func(schedule *models.Schedule) *service.CalendarService {
+		scheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				if id != schedule.ID {
+					return nil, repository.ErrNotFound
+				}
+				return schedule, nil
+			},
+		}
+		termRepo := &mocks.MockTermRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Term, error) {
+				return term, nil
+			},
+		}
+		roomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return []*models.Room{
+					{ID: roomA, Name: "Room 101", Building: science},
+					{ID: roomB, Name: "Lab 2", Building: engineering},
+				}, nil
+			},
+		}
+		buildingRepo := &mocks.MockBuildingRepository{
+			ListFunc: func(ctx context.Context) ([]models.Building, error) {
+				return []models.Building{{ID: science, Name: "Science"}, {ID: engineering, Name: "Engineering"}}, nil
+			},
+		}
+		courseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return []models.Course{{ID: courseA, Name: "Math 101"}, {ID: courseB, Name: "Physics 101"}}, nil
+			},
+		}
+		courseSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return []*models.CourseSession{lecture}, nil
+			},
+		}
+		instructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{instructor}, nil
+			},
+		}
+		return service.NewCalendarService(scheduleRepo, termRepo, roomRepo, buildingRepo, courseRepo, courseSessionRepo, instructorRepo)
+	}
+
+	type dated struct {
+		date      string
+		week      int
+		startTime int
+	}
+	datesOf := func(occurrences []models.Occurrence) []dated {
+		result := make([]dated, len(occurrences))
+		for i, o := range occurrences {
+			result[i] = dated{o.Date, o.Week, o.StartTime}
+		}
+		return result
+	}
+
+	t.Run("expands the term's teaching weeks around holidays", func(t *testing.T) {
+		occurrences, err := newService(schedule).Occurrences(ctx, schedule.ID, models.OccurrenceFilter{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []dated{
+			{"2025-09-01", 1, 540},
+			{"2025-09-08", 2, 540},
+			{"2025-09-10", 2, 480},
+			{"2025-09-10", 2, 660},
+			{"2025-09-22", 3, 540},
+			{"2025-09-24", 3, 480},
+			{"2025-09-24", 3, 660},
+			{"2025-09-29", 4, 540},
+			{"2025-10-01", 4, 480},
+			{"2025-10-01", 4, 660},
+		}, datesOf(occurrences))
+		assert.Equal(t, courseA, occurrences[2].CourseID)
+		assert.Equal(t, roomB, occurrences[2].RoomID)
+		assert.Equal(t, 540, occurrences[2].EndTime)
+	})
+
+	t.Run("narrows to from and to", func(t *testing.T) {
+		occurrences, err := newService(schedule).Occurrences(ctx, schedule.ID, models.OccurrenceFilter{From: "2025-09-15", To: "2025-09-22"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []dated{{"2025-09-22", 3, 540}}, datesOf(occurrences))
+	})
+
+	t.Run("filters by room, course, building and instructor", func(t *testing.T) {
+		svc := newService(schedule)
+
+		byRoom, err := svc.Occurrences(ctx, schedule.ID, models.OccurrenceFilter{RoomID: &roomA})
+		require.NoError(t, err)
+		assert.Len(t, byRoom, 4)
+
+		byCourse, err := svc.Occurrences(ctx, schedule.ID, models.OccurrenceFilter{CourseID: &courseB})
+		require.NoError(t, err)
+		assert.Len(t, byCourse, 3)
+		for _, o := range byCourse {
+			assert.Equal(t, courseB, o.CourseID)
+		}
+
+		byBuilding, err := svc.Occurrences(ctx, schedule.ID, models.OccurrenceFilter{BuildingID: &engineering})
+		require.NoError(t, err)
+		assert.Len(t, byBuilding, 6)
+
+		byInstructor, err := svc.Occurrences(ctx, schedule.ID, models.OccurrenceFilter{InstructorID: &instructor.ID})
+		require.NoError(t, err)
+		assert.Len(t, byInstructor, 4)
+	})
+
+	t.Run("a holiday on the only day a schedule meets on still counts the week", func(t *testing.T) {
+		// Monday 2025-09-08 is a holiday, but the rest of its week is taught
+		original := term
+		mondayHoliday := *term
+		mondayHoliday.Holidays = []models.TermHoliday{{Name: "Public holiday", StartDate: "2025-09-08", EndDate: "2025-09-08"}}
+		term = &mondayHoliday
+		defer func() { term = original }()
+
+		mondays := &models.Schedule{
+			ID:       uuid.New(),
+			Name:     "Mondays",
+			TermID:   &term.ID,
+			Sessions: []models.ScheduledSession{{CourseID: courseA, RoomID: roomA, Day: 0, StartTime: 540, EndTime: 600}},
+		}
+
+		occurrences, err := newService(mondays).Occurrences(ctx, mondays.ID, models.OccurrenceFilter{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []dated{
+			{"2025-09-01", 1, 540},
+			{"2025-09-15", 3, 540},
+			{"2025-09-22", 4, 540},
+		}, datesOf(occurrences))
+	})
+
+	t.Run("schedule without a term meets every week between the dates", func(t *testing.T) {
+		noTerm := &models.Schedule{ID: uuid.New(), Name: "Weekly", Sessions: schedule.Sessions}
+		svc := newService(noTerm)
+
+		_, err := svc.Occurrences(ctx, noTerm.ID, models.OccurrenceFilter{})
+		require.ErrorIs(t, err, service.ErrInvalidDateRange)
+
+		occurrences, err := svc.Occurrences(ctx, noTerm.ID, models.OccurrenceFilter{From: "2025-09-01", To: "2025-09-08"})
+		require.NoError(t, err)
+		assert.Equal(t, []dated{
+			{"2025-09-01", 0, 540},
+			{"2025-09-03", 0, 480},
+			{"2025-09-03", 0, 660},
+			{"2025-09-08", 0, 540},
+		}, datesOf(occurrences))
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		svc := newService(schedule)
+
+		for _, filter := range []models.OccurrenceFilter{
+			{From: "09/01/2025"},
+			{From: "2025-09-10", To: "2025-09-01"},
+			{From: "2025-01-01", To: "2026-01-02"},
+		} {
+			_, err := svc.Occurrences(ctx, schedule.ID, filter)
+			assert.ErrorIs(t, err, service.ErrInvalidDateRange, "filter %+v", filter)
+		}
+	})
+
+	t.Run("schedule not found", func(t *testing.T) {
+		_, err := newService(schedule).Occurrences(ctx, uuid.New(), models.OccurrenceFilter{})
+
+		require.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func TestCalendarService_ICal(t *testing.T) {
+	ctx := context.Background()
+	courseA, courseB := uuid.New(), uuid.New()
+	roomA, roomB := uuid.New(), uuid.New()
+	science := uuid.New()
+	lecture := &models.CourseSession{ID: uuid.New(), CourseID: courseA, Type: "lecture"}
+	instructor := &models.Instructor{ID: uuid.New(), Name: "Dr. Smith"}
+
+	// Monday 2025-09-15 falls in the reading week, so the Monday session skips it
+	term := &models.Term{
+		ID:            uuid.New(),
+		Name:          "Fall 2025",
+		StartDate:     "2025-09-01",
+		EndDate:       "2025-10-10",
+		TeachingWeeks: 4,
+		Holidays: []models.TermHoliday{
+			{Name: "Reading week", StartDate: "2025-09-15", EndDate: "2025-09-19"},
+		},
+	}
+	schedule := &models.Schedule{
+		ID:     uuid.New(),
+		Name:   "Fall 2025",
+		TermID: &term.ID,
+		Sessions: []models.ScheduledSession{
+			{CourseID: courseA, CourseSessionID: &lecture.ID, RoomID: roomA, InstructorID: &instructor.ID, Day: 0, StartTime: 540, EndTime: 600},
+			{CourseID: courseB, RoomID: roomB, Day: 4, StartTime: 660, EndTime: 720},
+		},
+	}
+
+	svc := service.NewCalendarService(
+		&mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return schedule, nil
+			},
+		},
+		&mocks.MockTermRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Term, error) {
+				return term, nil
+			},
+		},
+		&mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return []*models.Room{{ID: roomA, Name: "Room 101", Building: science}}, nil
+			},
+		},
+		&mocks.MockBuildingRepository{
+			ListFunc: func(ctx context.Context) ([]models.Building, error) {
+				return []models.Building{{ID: science, Name: "Science"}}, nil
+			},
+		},
+		&mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return []models.Course{{ID: courseA, Name: "Math 101"}, {ID: courseB, Name: "Physics 101"}}, nil
+			},
+		},
+		&mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return []*models.CourseSession{lecture}, nil
+			},
+		},
+		&mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{instructor}, nil
+			},
+		},
+	)
+
+	t.Run("one weekly event per session", func(t *testing.T) {
+		calendar, err := svc.ICal(ctx, schedule.ID, models.OccurrenceFilter{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Fall 2025", calendar.Name)
+		require.Len(t, calendar.Events, 2)
+
+		monday := calendar.Events[0]
+		assert.Equal(t, "Math 101 Lecture", monday.Summary)
+		assert.Equal(t, "Room 101, Science", monday.Location)
+		assert.Equal(t, "Instructor: Dr. Smith", monday.Description)
+		assert.Equal(t, time.Date(2025, 9, 1, 9, 0, 0, 0, time.UTC), monday.Start)
+		assert.Equal(t, time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC), monday.End)
+		assert.Equal(t, time.Date(2025, 9, 29, 0, 0, 0, 0, time.UTC), monday.Until)
+		assert.Equal(t, []time.Time{time.Date(2025, 9, 15, 9, 0, 0, 0, time.UTC)}, monday.ExDates)
+
+		friday := calendar.Events[1]
+		assert.Equal(t, "Physics 101", friday.Summary)
+		assert.Empty(t, friday.Location) // room unknown
+		assert.Equal(t, time.Date(2025, 9, 5, 11, 0, 0, 0, time.UTC), friday.Start)
+		assert.NotEqual(t, monday.UID, friday.UID)
+	})
+
+	t.Run("a range with one occurrence doesn't repeat", func(t *testing.T) {
+		calendar, err := svc.ICal(ctx, schedule.ID, models.OccurrenceFilter{From: "2025-09-01", To: "2025-09-07", CourseID: &courseA})
+
+		require.NoError(t, err)
+		require.Len(t, calendar.Events, 1)
+		assert.True(t, calendar.Events[0].Until.IsZero())
+		assert.Empty(t, calendar.Events[0].ExDates)
+	})
+}
@@ -0,0 +1,229 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+// importFixture is an import service over mock repositories holding one building, room type,
+// course and instructor, which records what the import creates
+type importFixture struct {
+	svc      *service.ImportService
+	building uuid.UUID
+	course   uuid.UUID
+
+	buildings []*models.Building
+	rooms     []*models.Room
+	courses   []*models.Course
+	sessions  []*models.CourseSession
+}
+
+func newImportFixture() *importFixture {
+	f := &importFixture{building: uuid.New(), course: uuid.New()}
+	instructor := uuid.New()
+
+	buildingRepo := &mocks.MockBuildingRepository{
+		ListFunc: func(ctx context.Context) ([]models.Building, error) {
+			return []models.Building{{ID: f.building, Name: "Science"}}, nil
+		},
+		CreateFunc: func(ctx context.Context, building *models.Building) (*models.Building, error) {
+			f.buildings = append(f.buildings, building)
+			return building, nil
+		},
+	}
+	roomTypeRepo := &mocks.MockRoomTypeRepository{
+		ListFunc: func(ctx context.Context) ([]*models.RoomType, error) {
+			return []*models.RoomType{{Name: "lecture_room"}}, nil
+		},
+	}
+	roomRepo := &mocks.MockRoomRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+			return []*models.Room{{ID: uuid.New(), Name: "101", Building: f.building, Type: "lecture_room", Capacity: 40}}, nil
+		},
+		CreateFunc: func(ctx context.Context, room *models.Room) (*models.Room, error) {
+			f.rooms = append(f.rooms, room)
+			return room, nil
+		},
+	}
+	courseRepo := &mocks.MockCourseRepository{
+		ListFunc: func(ctx context.Context) ([]models.Course, error) {
+			return []models.Course{{ID: f.course, Name: "Math 101"}}, nil
+		},
+		CreateFunc: func(ctx context.Context, course *models.Course) (*models.Course, error) {
+			f.courses = append(f.courses, course)
+			return course, nil
+		},
+	}
+	courseSessionRepo := &mocks.MockCourseSessionRepository{
+		ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+			return []*models.CourseSession{{ID: uuid.New(), CourseID: f.course, Type: "lecture"}}, nil
+		},
+		CreateFunc: func(ctx context.Context, session *models.CourseSession) (*models.CourseSession, error) {
+			f.sessions = append(f.sessions, session)
+			return session, nil
+		},
+	}
+	instructorRepo := &mocks.MockInstructorRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+			return []*models.Instructor{
+				{ID: instructor, Name: "Dr. Smith"},
+				{ID: uuid.New(), Name: "Dr. Jones"},
+			}, nil
+		},
+	}
+	termRepo := &mocks.MockTermRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Term, error) {
+			return nil, nil
+		},
+	}
+
+	f.svc = service.NewImportService(buildingRepo, roomTypeRepo, roomRepo, courseRepo, courseSessionRepo, instructorRepo, termRepo)
+	return f
+}
+
+func csvFiles(files map[models.ImportEntity]string) map[models.ImportEntity]io.Reader {
+	readers := make(map[models.ImportEntity]io.Reader, len(files))
+	for entity, content := range files {
+		readers[entity] = strings.NewReader(content)
+	}
+	return readers
+}
+
+func TestImportService_Import(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates rows that refer to new and existing records", func(t *testing.T) {
+		f := newImportFixture()
+
+		report, err := f.svc.Import(ctx, csvFiles(map[models.ImportEntity]string{
+			models.ImportBuildings: "name\nEngineering\n",
+			models.ImportRooms:     "Name,Building,Type,Capacity\nE1,Engineering,lecture_room,30\n102,Science,lecture_room,50\n",
+			models.ImportCourses:   "name,expected_enrollment\nPhysics 101,25\n",
+			models.ImportSessions:  "course,type,required_room,duration,number_of_sessions,instructor\nPhysics 101,lecture,lecture_room,60,2,Dr. Smith\nMath 101,tutorial,lecture_room,60,1,\n",
+		}), false)
+
+		require.NoError(t, err)
+		assert.Empty(t, report.Errors)
+		assert.Equal(t, 1, report.Created[models.ImportBuildings])
+		assert.Equal(t, 2, report.Created[models.ImportRooms])
+		assert.Equal(t, 1, report.Created[models.ImportCourses])
+		assert.Equal(t, 2, report.Created[models.ImportSessions])
+
+		require.Len(t, f.rooms, 2)
+		assert.Equal(t, f.buildings[0].ID, f.rooms[0].Building)
+		assert.Equal(t, f.building, f.rooms[1].Building)
+
+		require.Len(t, f.sessions, 2)
+		assert.Equal(t, f.courses[0].ID, f.sessions[0].CourseID)
+		assert.NotNil(t, f.sessions[0].InstructorID)
+		assert.Equal(t, f.course, f.sessions[1].CourseID)
+		assert.Nil(t, f.sessions[1].InstructorID)
+	})
+
+	t.Run("dry run reports without creating", func(t *testing.T) {
+		f := newImportFixture()
+
+		report, err := f.svc.Import(ctx, csvFiles(map[models.ImportEntity]string{
+			models.ImportBuildings: "name\nEngineering\nScience\n",
+		}), true)
+
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 2, report.Rows[models.ImportBuildings])
+		assert.Empty(t, report.Created)
+		assert.Empty(t, f.buildings)
+		require.Len(t, report.Errors, 1)
+		assert.Equal(t, models.ImportRowError{Entity: models.ImportBuildings, Row: 3, Message: `building "Science" already exists`}, report.Errors[0])
+	})
+
+	t.Run("reports every invalid row and creates nothing", func(t *testing.T) {
+		f := newImportFixture()
+
+		report, err := f.svc.Import(ctx, csvFiles(map[models.ImportEntity]string{
+			models.ImportBuildings: "name\nEngineering\n",
+			models.ImportRooms:     "name,building,type,capacity\n101,Science,lecture_room,40\nE1,Nowhere,lecture_room,30\nE2,Engineering,lab_room,30\nE3,Engineering,lecture_room,many\nE4,Engineering,lecture_room\n",
+			models.ImportSessions:  "course,type,required_room,duration,number_of_sessions,instructor\nMath 101,lecture,lecture_room,60,2,\nMath 101,lab,lecture_room,0,1,\nMath 101,seminar,lecture_room,60,1,\nMath 101,tutorial,lecture_room,60,1,Dr. Brown\n",
+		}), false)
+
+		require.ErrorIs(t, err, service.ErrInvalidImport)
+		assert.Empty(t, f.buildings)
+		assert.Empty(t, f.rooms)
+		assert.Empty(t, f.sessions)
+
+		var messages []string
+		for _, rowErr := range report.Errors {
+			messages = append(messages, rowErr.Message)
+		}
+		assert.Equal(t, []string{
+			`room "101" already exists in building "Science"`,
+			`building "Nowhere" not found`,
+			`room type "lab_room" not found`,
+			"capacity must be a whole number",
+			"expected 4 values, found 3",
+			`course "Math 101" already has a lecture session`,
+			"duration must be greater than 0",
+			"invalid session type: seminar",
+			`instructor "Dr. Brown" not found`,
+		}, messages)
+		assert.Equal(t, 2, report.Errors[0].Row)
+		assert.Equal(t, 6, report.Errors[4].Row)
+	})
+
+	t.Run("rejects duplicates within a file", func(t *testing.T) {
+		f := newImportFixture()
+
+		report, err := f.svc.Import(ctx, csvFiles(map[models.ImportEntity]string{
+			models.ImportCourses: "name\nPhysics 101\nPhysics 101\n",
+		}), true)
+
+		require.NoError(t, err)
+		require.Len(t, report.Errors, 1)
+		assert.Equal(t, 3, report.Errors[0].Row)
+	})
+
+	t.Run("reports problems with a whole file", func(t *testing.T) {
+		f := newImportFixture()
+
+		report, err := f.svc.Import(ctx, csvFiles(map[models.ImportEntity]string{
+			models.ImportBuildings: "",
+			models.ImportRooms:     "name,building,capacity\n",
+			models.ImportCourses:   "name,colour\n",
+			models.ImportSessions:  "\ufeffcourse,course\n",
+		}), true)
+
+		require.NoError(t, err)
+		assert.Equal(t, []models.ImportRowError{
+			{Entity: models.ImportBuildings, Message: "file is empty"},
+			{Entity: models.ImportRooms, Message: `missing column "type"`},
+			{Entity: models.ImportCourses, Message: `unknown column "colour"`},
+			{Entity: models.ImportSessions, Message: `column "course" appears more than once`},
+		}, report.Errors)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		failing := &mocks.MockBuildingRepository{
+			ListFunc: func(ctx context.Context) ([]models.Building, error) {
+				return nil, errors.New("database error")
+			},
+		}
+		svc := service.NewImportService(failing, &mocks.MockRoomTypeRepository{}, &mocks.MockRoomRepository{}, &mocks.MockCourseRepository{}, &mocks.MockCourseSessionRepository{}, &mocks.MockInstructorRepository{}, &mocks.MockTermRepository{})
+
+		report, err := svc.Import(ctx, csvFiles(map[models.ImportEntity]string{
+			models.ImportBuildings: "name\nEngineering\n",
+		}), false)
+
+		require.Error(t, err)
+		assert.Nil(t, report)
+	})
+}
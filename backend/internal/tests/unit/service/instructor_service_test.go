@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestInstructorService_Create(t *testing.T) {
+	ctx := context.Background()
+	instructor := &models.Instructor{ID: uuid.New(), Name: "Dr. Ada Lovelace"}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			CreateFunc: func(ctx context.Context, i *models.Instructor) (*models.Instructor, error) {
+				return instructor, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Create(ctx, instructor)
+
+		require.NoError(t, err)
+		assert.Equal(t, instructor.ID, result.ID)
+		assert.Equal(t, instructor.Name, result.Name)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			CreateFunc: func(ctx context.Context, i *models.Instructor) (*models.Instructor, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Create(ctx, instructor)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestInstructorService_GetByID(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	instructor := &models.Instructor{ID: id, Name: "Dr. Ada Lovelace"}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Instructor, error) {
+				return instructor, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.NoError(t, err)
+		assert.Equal(t, instructor.ID, result.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Instructor, error) {
+				return nil, errors.New("not found")
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.GetByID(ctx, id)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestInstructorService_List(t *testing.T) {
+	ctx := context.Background()
+	instructors := []*models.Instructor{
+		{ID: uuid.New(), Name: "Instructor A"},
+		{ID: uuid.New(), Name: "Instructor B"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return instructors, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.List(ctx)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestInstructorService_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				return nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		err := svc.Delete(ctx, id)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestInstructorService_Update(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	newName := "Prof. Ada Lovelace"
+	updates := &models.InstructorUpdate{Name: &newName}
+	updated := &models.Instructor{ID: id, Name: newName}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockInstructorRepository{
+			UpdateFunc: func(ctx context.Context, reqID uuid.UUID, u *models.InstructorUpdate) (*models.Instructor, error) {
+				return updated, nil
+			},
+		}
+
+		svc := service.NewInstructorService(mockRepo)
+		result, err := svc.Update(ctx, id, updates)
+
+		require.NoError(t, err)
+		assert.Equal(t, newName, result.Name)
+	})
+}
@@ -0,0 +1,27 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/ical"
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+)
+
+// MockCalendarService is a mock implementation of service.CalendarServiceInterface
+type MockCalendarService struct {
+	OccurrencesFunc func(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) ([]models.Occurrence, error)
+	ICalFunc        func(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) (*ical.Calendar, error)
+}
+
+var _ service.CalendarServiceInterface = (*MockCalendarService)(nil)
+
+func (m *MockCalendarService) Occurrences(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) ([]models.Occurrence, error) {
+	return m.OccurrencesFunc(ctx, scheduleID, filter)
+}
+
+func (m *MockCalendarService) ICal(ctx context.Context, scheduleID uuid.UUID, filter models.OccurrenceFilter) (*ical.Calendar, error) {
+	return m.ICalFunc(ctx, scheduleID, filter)
+}
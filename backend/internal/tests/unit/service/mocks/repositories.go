@@ -198,7 +198,9 @@ type MockScheduleRepository struct {
 	CreateFunc       func(ctx context.Context, schedule *models.Schedule) (*models.Schedule, error)
 	GetByIDFunc      func(ctx context.Context, id uuid.UUID) (*models.Schedule, error)
 	GetByNameFunc    func(ctx context.Context, name string) (*models.Schedule, error)
+	GetActiveFunc    func(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error)
 	ListFunc         func(ctx context.Context) ([]*models.Schedule, error)
+	ListByTermFunc   func(ctx context.Context, termID uuid.UUID) ([]*models.Schedule, error)
 	ListArchivedFunc func(ctx context.Context) ([]*models.Schedule, error)
 	DeleteFunc       func(ctx context.Context, id uuid.UUID) error
 	UpdateFunc       func(ctx context.Context, id uuid.UUID, updates *models.ScheduleUpdate) (*models.Schedule, error)
@@ -221,10 +223,18 @@ func (m *MockScheduleRepository) GetByName(ctx context.Context, name string) (*m
 	return m.GetByNameFunc(ctx, name)
 }
 
+func (m *MockScheduleRepository) GetActive(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error) {
+	return m.GetActiveFunc(ctx, termID)
+}
+
 func (m *MockScheduleRepository) List(ctx context.Context) ([]*models.Schedule, error) {
 	return m.ListFunc(ctx)
 }
 
+func (m *MockScheduleRepository) ListByTerm(ctx context.Context, termID uuid.UUID) ([]*models.Schedule, error) {
+	return m.ListByTermFunc(ctx, termID)
+}
+
 func (m *MockScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return m.DeleteFunc(ctx, id)
 }
@@ -248,3 +258,230 @@ func (m *MockScheduleRepository) Archive(ctx context.Context, id uuid.UUID) (*mo
 func (m *MockScheduleRepository) Unarchive(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
 	return m.UnarchiveFunc(ctx, id)
 }
+
+// MockInstructorRepository is a mock implementation of InstructorRepositoryInterface
+type MockInstructorRepository struct {
+	CreateFunc      func(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error)
+	CreateBatchFunc func(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error)
+	GetByIDFunc     func(ctx context.Context, id uuid.UUID) (*models.Instructor, error)
+	ListFunc        func(ctx context.Context) ([]*models.Instructor, error)
+	DeleteFunc      func(ctx context.Context, id uuid.UUID) error
+	UpdateFunc      func(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error)
+}
+
+var _ repository.InstructorRepositoryInterface = (*MockInstructorRepository)(nil)
+
+func (m *MockInstructorRepository) Create(ctx context.Context, instructor *models.Instructor) (*models.Instructor, error) {
+	return m.CreateFunc(ctx, instructor)
+}
+
+func (m *MockInstructorRepository) CreateBatch(ctx context.Context, instructors []*models.Instructor) ([]*models.Instructor, error) {
+	return m.CreateBatchFunc(ctx, instructors)
+}
+
+func (m *MockInstructorRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Instructor, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockInstructorRepository) List(ctx context.Context) ([]*models.Instructor, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockInstructorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *MockInstructorRepository) Update(ctx context.Context, id uuid.UUID, updates *models.InstructorUpdate) (*models.Instructor, error) {
+	return m.UpdateFunc(ctx, id, updates)
+}
+
+// MockCohortRepository is a mock implementation of CohortRepositoryInterface
+type MockCohortRepository struct {
+	CreateFunc  func(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error)
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (*models.Cohort, error)
+	ListFunc    func(ctx context.Context) ([]*models.Cohort, error)
+	DeleteFunc  func(ctx context.Context, id uuid.UUID) error
+	UpdateFunc  func(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error)
+}
+
+var _ repository.CohortRepositoryInterface = (*MockCohortRepository)(nil)
+
+func (m *MockCohortRepository) Create(ctx context.Context, cohort *models.Cohort) (*models.Cohort, error) {
+	return m.CreateFunc(ctx, cohort)
+}
+
+func (m *MockCohortRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Cohort, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockCohortRepository) List(ctx context.Context) ([]*models.Cohort, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockCohortRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *MockCohortRepository) Update(ctx context.Context, id uuid.UUID, updates *models.CohortUpdate) (*models.Cohort, error) {
+	return m.UpdateFunc(ctx, id, updates)
+}
+
+// MockTermRepository is a mock implementation of TermRepositoryInterface
+type MockTermRepository struct {
+	CreateFunc  func(ctx context.Context, term *models.Term) (*models.Term, error)
+	GetByIDFunc func(ctx context.Context, id uuid.UUID) (*models.Term, error)
+	ListFunc    func(ctx context.Context) ([]*models.Term, error)
+	DeleteFunc  func(ctx context.Context, id uuid.UUID) error
+	UpdateFunc  func(ctx context.Context, id uuid.UUID, updates *models.TermUpdate) (*models.Term, error)
+}
+
+var _ repository.TermRepositoryInterface = (*MockTermRepository)(nil)
+
+func (m *MockTermRepository) Create(ctx context.Context, term *models.Term) (*models.Term, error) {
+	return m.CreateFunc(ctx, term)
+}
+
+func (m *MockTermRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.Term, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockTermRepository) List(ctx context.Context) ([]*models.Term, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockTermRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *MockTermRepository) Update(ctx context.Context, id uuid.UUID, updates *models.TermUpdate) (*models.Term, error) {
+	return m.UpdateFunc(ctx, id, updates)
+}
+
+// MockRoomBlackoutRepository is a mock implementation of RoomBlackoutRepositoryInterface
+type MockRoomBlackoutRepository struct {
+	CreateFunc      func(ctx context.Context, blackout *models.RoomBlackout) (*models.RoomBlackout, error)
+	GetByIDFunc     func(ctx context.Context, id uuid.UUID) (*models.RoomBlackout, error)
+	GetByRoomIDFunc func(ctx context.Context, roomID uuid.UUID) ([]*models.RoomBlackout, error)
+	ListFunc        func(ctx context.Context) ([]*models.RoomBlackout, error)
+	DeleteFunc      func(ctx context.Context, id uuid.UUID) error
+	UpdateFunc      func(ctx context.Context, id uuid.UUID, updates *models.RoomBlackoutUpdate) (*models.RoomBlackout, error)
+}
+
+var _ repository.RoomBlackoutRepositoryInterface = (*MockRoomBlackoutRepository)(nil)
+
+func (m *MockRoomBlackoutRepository) Create(ctx context.Context, blackout *models.RoomBlackout) (*models.RoomBlackout, error) {
+	return m.CreateFunc(ctx, blackout)
+}
+
+func (m *MockRoomBlackoutRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.RoomBlackout, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockRoomBlackoutRepository) GetByRoomID(ctx context.Context, roomID uuid.UUID) ([]*models.RoomBlackout, error) {
+	return m.GetByRoomIDFunc(ctx, roomID)
+}
+
+func (m *MockRoomBlackoutRepository) List(ctx context.Context) ([]*models.RoomBlackout, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockRoomBlackoutRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+func (m *MockRoomBlackoutRepository) Update(ctx context.Context, id uuid.UUID, updates *models.RoomBlackoutUpdate) (*models.RoomBlackout, error) {
+	return m.UpdateFunc(ctx, id, updates)
+}
+
+// MockBuildingDistanceRepository is a mock implementation of BuildingDistanceRepositoryInterface
+type MockBuildingDistanceRepository struct {
+	SetFunc             func(ctx context.Context, distance *models.BuildingDistance) (*models.BuildingDistance, error)
+	GetByBuildingIDFunc func(ctx context.Context, buildingID uuid.UUID) ([]*models.BuildingDistance, error)
+	ListFunc            func(ctx context.Context) ([]*models.BuildingDistance, error)
+	DeleteFunc          func(ctx context.Context, fromBuildingID, toBuildingID uuid.UUID) error
+}
+
+var _ repository.BuildingDistanceRepositoryInterface = (*MockBuildingDistanceRepository)(nil)
+
+func (m *MockBuildingDistanceRepository) Set(ctx context.Context, distance *models.BuildingDistance) (*models.BuildingDistance, error) {
+	return m.SetFunc(ctx, distance)
+}
+
+func (m *MockBuildingDistanceRepository) GetByBuildingID(ctx context.Context, buildingID uuid.UUID) ([]*models.BuildingDistance, error) {
+	return m.GetByBuildingIDFunc(ctx, buildingID)
+}
+
+func (m *MockBuildingDistanceRepository) List(ctx context.Context) ([]*models.BuildingDistance, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockBuildingDistanceRepository) Delete(ctx context.Context, fromBuildingID, toBuildingID uuid.UUID) error {
+	return m.DeleteFunc(ctx, fromBuildingID, toBuildingID)
+}
+
+// MockSessionConstraintRepository is a mock implementation of SessionConstraintRepositoryInterface
+type MockSessionConstraintRepository struct {
+	CreateFunc        func(ctx context.Context, constraint *models.SessionConstraint) (*models.SessionConstraint, error)
+	GetByIDFunc       func(ctx context.Context, id uuid.UUID) (*models.SessionConstraint, error)
+	GetByCourseIDFunc func(ctx context.Context, courseID uuid.UUID) ([]*models.SessionConstraint, error)
+	ListFunc          func(ctx context.Context) ([]*models.SessionConstraint, error)
+	DeleteFunc        func(ctx context.Context, id uuid.UUID) error
+}
+
+var _ repository.SessionConstraintRepositoryInterface = (*MockSessionConstraintRepository)(nil)
+
+func (m *MockSessionConstraintRepository) Create(ctx context.Context, constraint *models.SessionConstraint) (*models.SessionConstraint, error) {
+	return m.CreateFunc(ctx, constraint)
+}
+
+func (m *MockSessionConstraintRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.SessionConstraint, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockSessionConstraintRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*models.SessionConstraint, error) {
+	return m.GetByCourseIDFunc(ctx, courseID)
+}
+
+func (m *MockSessionConstraintRepository) List(ctx context.Context) ([]*models.SessionConstraint, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockSessionConstraintRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
+
+// MockCalendarFeedRepository is a mock implementation of CalendarFeedRepositoryInterface
+type MockCalendarFeedRepository struct {
+	CreateFunc     func(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error)
+	GetByIDFunc    func(ctx context.Context, id uuid.UUID) (*models.CalendarFeed, error)
+	GetByTokenFunc func(ctx context.Context, token string) (*models.CalendarFeed, error)
+	GetOwnerFunc   func(ctx context.Context, token string) (uuid.UUID, error)
+	ListFunc       func(ctx context.Context) ([]*models.CalendarFeed, error)
+	DeleteFunc     func(ctx context.Context, id uuid.UUID) error
+}
+
+var _ repository.CalendarFeedRepositoryInterface = (*MockCalendarFeedRepository)(nil)
+
+func (m *MockCalendarFeedRepository) Create(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+	return m.CreateFunc(ctx, feed)
+}
+
+func (m *MockCalendarFeedRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.CalendarFeed, error) {
+	return m.GetByIDFunc(ctx, id)
+}
+
+func (m *MockCalendarFeedRepository) GetByToken(ctx context.Context, token string) (*models.CalendarFeed, error) {
+	return m.GetByTokenFunc(ctx, token)
+}
+
+func (m *MockCalendarFeedRepository) GetOwner(ctx context.Context, token string) (uuid.UUID, error) {
+	return m.GetOwnerFunc(ctx, token)
+}
+
+func (m *MockCalendarFeedRepository) List(ctx context.Context) ([]*models.CalendarFeed, error) {
+	return m.ListFunc(ctx)
+}
+
+func (m *MockCalendarFeedRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.DeleteFunc(ctx, id)
+}
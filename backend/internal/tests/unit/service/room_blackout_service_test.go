@@ -0,0 +1,91 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestRoomBlackoutService_Create(t *testing.T) {
+	ctx := context.Background()
+	blackout := models.NewRoomBlackout(uuid.New(), uuid.New(), 1, 480, 600, models.RecurrenceWeekly, nil, ptr("Maintenance"), nil, nil)
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockRoomBlackoutRepository{
+			CreateFunc: func(ctx context.Context, b *models.RoomBlackout) (*models.RoomBlackout, error) {
+				return blackout, nil
+			},
+		}
+
+		svc := service.NewRoomBlackoutService(mockRepo)
+		result, err := svc.Create(ctx, blackout)
+
+		require.NoError(t, err)
+		assert.Equal(t, blackout.ID, result.ID)
+		assert.Equal(t, blackout.RoomID, result.RoomID)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockRoomBlackoutRepository{
+			CreateFunc: func(ctx context.Context, b *models.RoomBlackout) (*models.RoomBlackout, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewRoomBlackoutService(mockRepo)
+		result, err := svc.Create(ctx, blackout)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRoomBlackoutService_GetByRoomID(t *testing.T) {
+	ctx := context.Background()
+	roomID := uuid.New()
+	blackouts := []*models.RoomBlackout{
+		models.NewRoomBlackout(uuid.New(), roomID, 0, 480, 540, models.RecurrenceWeekly, nil, nil, nil, nil),
+		models.NewRoomBlackout(uuid.New(), roomID, 4, 1080, 1260, models.RecurrenceWeekly, nil, nil, nil, nil),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockRoomBlackoutRepository{
+			GetByRoomIDFunc: func(ctx context.Context, reqRoomID uuid.UUID) ([]*models.RoomBlackout, error) {
+				assert.Equal(t, roomID, reqRoomID)
+				return blackouts, nil
+			},
+		}
+
+		svc := service.NewRoomBlackoutService(mockRepo)
+		result, err := svc.GetByRoomID(ctx, roomID)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestRoomBlackoutService_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockRoomBlackoutRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				return nil
+			},
+		}
+
+		svc := service.NewRoomBlackoutService(mockRepo)
+		err := svc.Delete(ctx, id)
+
+		require.NoError(t, err)
+	})
+}
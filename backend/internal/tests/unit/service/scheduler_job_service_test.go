@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
+	"github.com/TerrenceMurray/course-scheduler/internal/scheduler/jobs"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func newJobTestSchedulerService(sched scheduler.Scheduler, roomsErr error) *service.SchedulerService {
+	return service.NewSchedulerService(sched, &mocks.MockScheduleRepository{},
+		&mocks.MockRoomRepository{ListFunc: func(ctx context.Context) ([]*models.Room, error) { return []*models.Room{}, roomsErr }},
+		&mocks.MockCourseRepository{ListFunc: func(ctx context.Context) ([]models.Course, error) { return []models.Course{}, nil }},
+		&mocks.MockCourseSessionRepository{ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) { return []*models.CourseSession{}, nil }},
+		&mocks.MockCohortRepository{ListFunc: func(ctx context.Context) ([]*models.Cohort, error) { return []*models.Cohort{}, nil }},
+		&mocks.MockInstructorRepository{ListFunc: func(ctx context.Context) ([]*models.Instructor, error) { return []*models.Instructor{}, nil }},
+		&mocks.MockRoomBlackoutRepository{ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) { return []*models.RoomBlackout{}, nil }},
+		&mocks.MockBuildingDistanceRepository{ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) { return []*models.BuildingDistance{}, nil }},
+		&mocks.MockSessionConstraintRepository{ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) { return []*models.SessionConstraint{}, nil }},
+		&mocks.MockTermRepository{},
+	)
+}
+
+func TestSchedulerJobService_Submit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				return &scheduler.Output{ScheduledSessions: []*models.ScheduledSession{}}, nil
+			},
+		}
+
+		manager := jobs.NewManager(1, 0, 0)
+		defer manager.Close()
+		svc := service.NewSchedulerJobService(newJobTestSchedulerService(mockScheduler, nil), manager)
+
+		job, err := svc.Submit(ctx, "user-1", nil)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			job, err = svc.GetByID(ctx, "user-1", job.ID)
+			require.NoError(t, err)
+			return job.Status == jobs.StatusSucceeded
+		}, 2*time.Second, 5*time.Millisecond)
+
+		require.NotNil(t, job.Result)
+		assert.NotNil(t, job.Result.Score)
+
+		list, err := svc.List(ctx, "user-1")
+		require.NoError(t, err)
+		assert.Len(t, list, 1)
+	})
+
+	t.Run("input is read before the job is queued", func(t *testing.T) {
+		manager := jobs.NewManager(1, 0, 0)
+		defer manager.Close()
+		svc := service.NewSchedulerJobService(newJobTestSchedulerService(&mocks.MockScheduler{}, errors.New("db error")), manager)
+
+		job, err := svc.Submit(ctx, "user-1", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, job)
+		assert.Contains(t, err.Error(), "failed to fetch rooms")
+	})
+
+	t.Run("unknown algorithm", func(t *testing.T) {
+		manager := jobs.NewManager(1, 0, 0)
+		defer manager.Close()
+		svc := service.NewSchedulerJobService(newJobTestSchedulerService(&mocks.MockScheduler{}, nil), manager)
+
+		_, err := svc.Submit(ctx, "user-1", &scheduler.Config{Algorithm: "quantum"})
+
+		assert.ErrorIs(t, err, service.ErrUnknownAlgorithm)
+	})
+
+	t.Run("other users' jobs are hidden", func(t *testing.T) {
+		manager := jobs.NewManager(1, 0, 0)
+		defer manager.Close()
+		svc := service.NewSchedulerJobService(newJobTestSchedulerService(&mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				return &scheduler.Output{}, nil
+			},
+		}, nil), manager)
+
+		job, err := svc.Submit(ctx, "user-1", nil)
+		require.NoError(t, err)
+
+		_, err = svc.GetByID(ctx, "user-2", job.ID)
+		assert.ErrorIs(t, err, jobs.ErrNotFound)
+
+		_, err = svc.Cancel(ctx, "user-2", uuid.New())
+		assert.ErrorIs(t, err, jobs.ErrNotFound)
+	})
+}
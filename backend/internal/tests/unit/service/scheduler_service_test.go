@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
 	"github.com/TerrenceMurray/course-scheduler/internal/scheduler"
 	"github.com/TerrenceMurray/course-scheduler/internal/service"
 	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
@@ -38,7 +39,7 @@ func TestSchedulerService_Generate(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return &scheduler.Output{
 					ScheduledSessions: scheduledSessions,
 					Failures:          []*scheduler.FailedSession{},
@@ -64,14 +65,46 @@ func TestSchedulerService_Generate(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		output, err := svc.Generate(ctx, nil)
 
 		require.NoError(t, err)
 		assert.Len(t, output.ScheduledSessions, 1)
 		assert.Empty(t, output.Failures)
+		require.NotNil(t, output.Score)
+		assert.Contains(t, output.Score.Breakdown, "late_evening")
 	})
 
 	t.Run("error fetching rooms", func(t *testing.T) {
@@ -85,9 +118,14 @@ func TestSchedulerService_Generate(t *testing.T) {
 
 		mockCourseRepo := &mocks.MockCourseRepository{}
 		mockSessionRepo := &mocks.MockCourseSessionRepository{}
+		mockCohortRepo := &mocks.MockCohortRepository{}
+		mockInstructorRepo := &mocks.MockInstructorRepository{}
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{}
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{}
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{}
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		output, err := svc.Generate(ctx, nil)
 
 		require.Error(t, err)
@@ -111,9 +149,14 @@ func TestSchedulerService_Generate(t *testing.T) {
 		}
 
 		mockSessionRepo := &mocks.MockCourseSessionRepository{}
+		mockCohortRepo := &mocks.MockCohortRepository{}
+		mockInstructorRepo := &mocks.MockInstructorRepository{}
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{}
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{}
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{}
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		output, err := svc.Generate(ctx, nil)
 
 		require.Error(t, err)
@@ -142,9 +185,15 @@ func TestSchedulerService_Generate(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{}
+		mockInstructorRepo := &mocks.MockInstructorRepository{}
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{}
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{}
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		output, err := svc.Generate(ctx, nil)
 
 		require.Error(t, err)
@@ -152,9 +201,243 @@ func TestSchedulerService_Generate(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to fetch sessions")
 	})
 
+	t.Run("error fetching cohorts", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return nil, errors.New("database error")
+			},
+		}
+		mockInstructorRepo := &mocks.MockInstructorRepository{}
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{}
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{}
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
+		output, err := svc.Generate(ctx, nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch cohorts")
+	})
+
+	t.Run("error fetching instructors", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return nil, errors.New("database error")
+			},
+		}
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{}
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{}
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
+		output, err := svc.Generate(ctx, nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch instructors")
+	})
+
+	t.Run("error fetching room blackouts", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
+		output, err := svc.Generate(ctx, nil)
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "failed to fetch room blackouts")
+	})
+
+	t.Run("selects algorithm from config", func(t *testing.T) {
+		defaultScheduler := &mocks.MockScheduler{}
+		cspScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				return &scheduler.Output{ScheduledSessions: scheduledSessions}, nil
+			},
+		}
+
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := service.NewSchedulerService(defaultScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{}).
+			RegisterAlgorithm(scheduler.AlgorithmCSP, cspScheduler)
+		output, err := svc.Generate(ctx, &scheduler.Config{Algorithm: scheduler.AlgorithmCSP})
+
+		require.NoError(t, err)
+		assert.Len(t, output.ScheduledSessions, 1)
+	})
+
+	t.Run("unknown algorithm", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{}
+		mockRoomRepo := &mocks.MockRoomRepository{}
+		mockCourseRepo := &mocks.MockCourseRepository{}
+		mockSessionRepo := &mocks.MockCourseSessionRepository{}
+		mockCohortRepo := &mocks.MockCohortRepository{}
+		mockInstructorRepo := &mocks.MockInstructorRepository{}
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{}
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{}
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{}
+		mockScheduleRepo := &mocks.MockScheduleRepository{}
+
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
+		output, err := svc.Generate(ctx, &scheduler.Config{Algorithm: "simulated-annealing"})
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, service.ErrUnknownAlgorithm)
+	})
+
 	t.Run("scheduler error", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return nil, errors.New("scheduling failed")
 			},
 		}
@@ -177,9 +460,39 @@ func TestSchedulerService_Generate(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		output, err := svc.Generate(ctx, nil)
 
 		require.Error(t, err)
@@ -210,7 +523,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return &scheduler.Output{
 					ScheduledSessions: scheduledSessions,
 					Failures:          []*scheduler.FailedSession{},
@@ -236,6 +549,36 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{
 			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
 				assert.Equal(t, "Fall 2025", s.Name)
@@ -244,7 +587,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
 
 		require.NoError(t, err)
@@ -257,7 +600,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 
 	t.Run("generate error", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return nil, errors.New("scheduling failed")
 			},
 		}
@@ -280,9 +623,39 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
 
 		require.Error(t, err)
@@ -293,7 +666,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 
 	t.Run("save error returns output", func(t *testing.T) {
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return &scheduler.Output{
 					ScheduledSessions: scheduledSessions,
 					Failures:          []*scheduler.FailedSession{},
@@ -319,13 +692,43 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{
 			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
 				return nil, errors.New("database error")
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
 
 		require.Error(t, err)
@@ -342,10 +745,11 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			OperatingDays:           []scheduler.Day{scheduler.Monday, scheduler.Tuesday},
 			MinBreakBetweenSessions: 15,
 			PreferredSlotDuration:   60,
+			Pins:                    []models.SessionPin{{CourseSessionID: uuid.New(), Day: 1, StartTime: 600}},
 		}
 
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				// Verify config is passed through
 				assert.Equal(t, config, input.Config)
 				return &scheduler.Output{
@@ -373,18 +777,49 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{
 			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
 				return s, nil
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", config)
 
 		require.NoError(t, err)
 		assert.NotNil(t, schedule)
 		assert.NotNil(t, output)
+		assert.Equal(t, config.Pins, schedule.Pins)
 	})
 
 	t.Run("with failures", func(t *testing.T) {
@@ -395,7 +830,7 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 		}
 
 		mockScheduler := &mocks.MockScheduler{
-			GenerateFunc: func(input *scheduler.Input) (*scheduler.Output, error) {
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
 				return &scheduler.Output{
 					ScheduledSessions: scheduledSessions,
 					Failures: []*scheduler.FailedSession{
@@ -423,13 +858,43 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 			},
 		}
 
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
 		mockScheduleRepo := &mocks.MockScheduleRepository{
 			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
 				return s, nil
 			},
 		}
 
-		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo)
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
 		schedule, output, err := svc.GenerateAndSave(ctx, "Fall 2025", nil)
 
 		require.NoError(t, err)
@@ -439,3 +904,464 @@ func TestSchedulerService_GenerateAndSave(t *testing.T) {
 		assert.Equal(t, "no available slot", output.Failures[0].Reason)
 	})
 }
+
+func TestSchedulerService_Optimize(t *testing.T) {
+	ctx := context.Background()
+
+	roomID := uuid.New()
+	courseID := uuid.New()
+	sessionID := uuid.New()
+	scheduleID := uuid.New()
+
+	rooms := []*models.Room{
+		{ID: roomID, Name: "Room 101", Type: "lecture_room", Capacity: 100},
+	}
+	courses := []models.Course{
+		{ID: courseID, Name: "CS 101"},
+	}
+	sessions := []*models.CourseSession{
+		{ID: sessionID, CourseID: courseID, RequiredRoom: "lecture_room", Type: "lecture", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(2))},
+	}
+
+	// Both sessions on Monday morning; the optimizer should move one to another day
+	original := models.NewSchedule(scheduleID, "Fall 2025", []models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &sessionID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &sessionID, RoomID: roomID, Day: 0, StartTime: 540, EndTime: 600},
+	}, nil)
+
+	t.Run("success", func(t *testing.T) {
+		mockRoomRepo := &mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return rooms, nil
+			},
+		}
+
+		mockCourseRepo := &mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return courses, nil
+			},
+		}
+
+		mockSessionRepo := &mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return sessions, nil
+			},
+		}
+
+		mockCohortRepo := &mocks.MockCohortRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+				return []*models.Cohort{}, nil
+			},
+		}
+
+		mockInstructorRepo := &mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{}, nil
+			},
+		}
+
+		mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+			ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+				return []*models.RoomBlackout{}, nil
+			},
+		}
+
+		mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+			ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+				return []*models.BuildingDistance{}, nil
+			},
+		}
+
+		mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+			ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+				return []*models.SessionConstraint{}, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				assert.Equal(t, scheduleID, id)
+				return original, nil
+			},
+			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
+				assert.NotEqual(t, scheduleID, s.ID)
+				return s, nil
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
+		schedule, result, err := svc.Optimize(ctx, scheduleID, "", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Fall 2025 (optimized)", schedule.Name)
+		require.Len(t, schedule.Sessions, 2)
+		assert.NotEqual(t, schedule.Sessions[0].Day, schedule.Sessions[1].Day)
+		assert.Less(t, result.FinalCost, result.InitialCost)
+
+		// The original schedule is left untouched
+		assert.Equal(t, 0, original.Sessions[1].Day)
+	})
+
+	t.Run("keeps original pins", func(t *testing.T) {
+		pinned := *original
+		pinned.Pins = []models.SessionPin{{CourseSessionID: sessionID, Day: 0, StartTime: 540}}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return &pinned, nil
+			},
+			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
+				return s, nil
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo,
+			&mocks.MockRoomRepository{ListFunc: func(ctx context.Context) ([]*models.Room, error) { return rooms, nil }},
+			&mocks.MockCourseRepository{ListFunc: func(ctx context.Context) ([]models.Course, error) { return courses, nil }},
+			&mocks.MockCourseSessionRepository{ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) { return sessions, nil }},
+			&mocks.MockCohortRepository{ListFunc: func(ctx context.Context) ([]*models.Cohort, error) { return []*models.Cohort{}, nil }},
+			&mocks.MockInstructorRepository{ListFunc: func(ctx context.Context) ([]*models.Instructor, error) { return []*models.Instructor{}, nil }},
+			&mocks.MockRoomBlackoutRepository{ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) { return []*models.RoomBlackout{}, nil }},
+			&mocks.MockBuildingDistanceRepository{ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) { return []*models.BuildingDistance{}, nil }},
+			&mocks.MockSessionConstraintRepository{ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) { return []*models.SessionConstraint{}, nil }},
+			&mocks.MockTermRepository{},
+		)
+		schedule, _, err := svc.Optimize(ctx, scheduleID, "", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, pinned.Pins, schedule.Pins)
+		assert.Equal(t, original.Sessions[1], schedule.Sessions[1])
+	})
+
+	t.Run("schedule not found", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, &mocks.MockRoomRepository{}, &mocks.MockCourseRepository{}, &mocks.MockCourseSessionRepository{}, &mocks.MockCohortRepository{}, &mocks.MockInstructorRepository{}, &mocks.MockRoomBlackoutRepository{}, &mocks.MockBuildingDistanceRepository{}, &mocks.MockSessionConstraintRepository{}, &mocks.MockTermRepository{})
+		schedule, result, err := svc.Optimize(ctx, scheduleID, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, schedule)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func TestSchedulerService_Score(t *testing.T) {
+	ctx := context.Background()
+
+	roomID := uuid.New()
+	courseID := uuid.New()
+	instructorID := uuid.New()
+
+	mockRoomRepo := &mocks.MockRoomRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+			return []*models.Room{{ID: roomID, Name: "Room 101", Type: "lecture_room", Capacity: 100}}, nil
+		},
+	}
+
+	mockCourseRepo := &mocks.MockCourseRepository{
+		ListFunc: func(ctx context.Context) ([]models.Course, error) {
+			return []models.Course{{ID: courseID, Name: "CS 101"}}, nil
+		},
+	}
+
+	mockSessionRepo := &mocks.MockCourseSessionRepository{
+		ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+			return []*models.CourseSession{}, nil
+		},
+	}
+
+	mockCohortRepo := &mocks.MockCohortRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Cohort, error) {
+			return []*models.Cohort{}, nil
+		},
+	}
+
+	mockInstructorRepo := &mocks.MockInstructorRepository{
+		ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+			return []*models.Instructor{
+				{ID: instructorID, Name: "Dr. Smith", PreferredStart: ptr(int32(540)), PreferredEnd: ptr(int32(1020))},
+			}, nil
+		},
+	}
+
+	mockBlackoutRepo := &mocks.MockRoomBlackoutRepository{
+		ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) {
+			return []*models.RoomBlackout{}, nil
+		},
+	}
+
+	mockDistanceRepo := &mocks.MockBuildingDistanceRepository{
+		ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) {
+			return []*models.BuildingDistance{}, nil
+		},
+	}
+
+	mockConstraintRepo := &mocks.MockSessionConstraintRepository{
+		ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) {
+			return []*models.SessionConstraint{}, nil
+		},
+	}
+
+	svc := service.NewSchedulerService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{}, mockRoomRepo, mockCourseRepo, mockSessionRepo, mockCohortRepo, mockInstructorRepo, mockBlackoutRepo, mockDistanceRepo, mockConstraintRepo, &mocks.MockTermRepository{})
+
+	// One hour before the instructor's preferred start, one hour past 6 PM
+	score, err := svc.Score(ctx, nil, []models.ScheduledSession{
+		{CourseID: courseID, RoomID: roomID, InstructorID: &instructorID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, RoomID: roomID, Day: 1, StartTime: 1020, EndTime: 1140},
+	})
+
+	require.NoError(t, err)
+	assert.InDelta(t, 3.0, score.Breakdown["instructor_preferences"], 1e-9)
+	assert.InDelta(t, 2.0, score.Breakdown["late_evening"], 1e-9)
+	assert.InDelta(t, 5.0, score.Total, 1e-9)
+}
+
+func TestSchedulerService_Repair(t *testing.T) {
+	ctx := context.Background()
+
+	roomID := uuid.New()
+	courseID := uuid.New()
+	keptID := uuid.New()
+	changedID := uuid.New()
+
+	rooms := []*models.Room{
+		{ID: roomID, Name: "Room 101", Type: "lecture_room", Capacity: 100},
+	}
+	courses := []models.Course{
+		{ID: courseID, Name: "CS 101"},
+	}
+	sessions := []*models.CourseSession{
+		{ID: keptID, CourseID: courseID, RequiredRoom: "lecture_room", Type: "lecture", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(1))},
+		// Lengthened from 60 to 90 minutes since the active schedule was made
+		{ID: changedID, CourseID: courseID, RequiredRoom: "lecture_room", Type: "tutorial", Duration: ptr(int32(90)), NumberOfSessions: ptr(int32(1))},
+	}
+
+	active := models.NewSchedule(uuid.New(), "Fall 2025", []models.ScheduledSession{
+		{CourseID: courseID, CourseSessionID: &keptID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		{CourseID: courseID, CourseSessionID: &changedID, RoomID: roomID, Day: 1, StartTime: 480, EndTime: 540},
+	}, nil)
+	active.IsActive = true
+
+	newRepos := func() (*mocks.MockRoomRepository, *mocks.MockCourseRepository, *mocks.MockCourseSessionRepository, *mocks.MockCohortRepository, *mocks.MockInstructorRepository, *mocks.MockRoomBlackoutRepository, *mocks.MockBuildingDistanceRepository, *mocks.MockSessionConstraintRepository) {
+		return &mocks.MockRoomRepository{ListFunc: func(ctx context.Context) ([]*models.Room, error) { return rooms, nil }},
+			&mocks.MockCourseRepository{ListFunc: func(ctx context.Context) ([]models.Course, error) { return courses, nil }},
+			&mocks.MockCourseSessionRepository{ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) { return sessions, nil }},
+			&mocks.MockCohortRepository{ListFunc: func(ctx context.Context) ([]*models.Cohort, error) { return []*models.Cohort{}, nil }},
+			&mocks.MockInstructorRepository{ListFunc: func(ctx context.Context) ([]*models.Instructor, error) { return []*models.Instructor{}, nil }},
+			&mocks.MockRoomBlackoutRepository{ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) { return []*models.RoomBlackout{}, nil }},
+			&mocks.MockBuildingDistanceRepository{ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) { return []*models.BuildingDistance{}, nil }},
+			&mocks.MockSessionConstraintRepository{ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) { return []*models.SessionConstraint{}, nil }}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				// Only the still-valid session is held in place
+				require.Len(t, input.Config.Pins, 1)
+				assert.Equal(t, keptID, input.Config.Pins[0].CourseSessionID)
+				assert.Equal(t, roomID, *input.Config.Pins[0].RoomID)
+
+				return &scheduler.Output{
+					ScheduledSessions: []*models.ScheduledSession{
+						{CourseID: courseID, CourseSessionID: &keptID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+						{CourseID: courseID, CourseSessionID: &changedID, RoomID: roomID, Day: 1, StartTime: 480, EndTime: 570},
+					},
+				}, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetActiveFunc: func(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error) {
+				return active, nil
+			},
+			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
+				assert.NotEqual(t, active.ID, s.ID)
+				return s, nil
+			},
+		}
+
+		roomRepo, courseRepo, sessionRepo, cohortRepo, instructorRepo, blackoutRepo, distanceRepo, constraintRepo := newRepos()
+		svc := service.NewSchedulerService(mockScheduler, mockScheduleRepo, roomRepo, courseRepo, sessionRepo, cohortRepo, instructorRepo, blackoutRepo, distanceRepo, constraintRepo, &mocks.MockTermRepository{})
+		schedule, result, err := svc.Repair(ctx, "", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Fall 2025 (repaired)", schedule.Name)
+		assert.Len(t, schedule.Sessions, 2)
+		assert.Empty(t, schedule.Pins, "sessions kept by the repair are not stored as pins")
+		assert.Equal(t, 1, result.Kept)
+		require.Len(t, result.Moved, 1)
+		assert.Equal(t, changedID, result.Moved[0].CourseSessionID)
+		assert.Equal(t, 540, result.Moved[0].From.EndTime)
+		assert.Equal(t, 570, result.Moved[0].To.EndTime)
+		assert.NotNil(t, result.Output.Score)
+	})
+
+	t.Run("no active schedule", func(t *testing.T) {
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetActiveFunc: func(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error) {
+				return nil, repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewSchedulerService(&mocks.MockScheduler{}, mockScheduleRepo, &mocks.MockRoomRepository{}, &mocks.MockCourseRepository{}, &mocks.MockCourseSessionRepository{}, &mocks.MockCohortRepository{}, &mocks.MockInstructorRepository{}, &mocks.MockRoomBlackoutRepository{}, &mocks.MockBuildingDistanceRepository{}, &mocks.MockSessionConstraintRepository{}, &mocks.MockTermRepository{})
+		schedule, result, err := svc.Repair(ctx, "", nil)
+
+		require.Error(t, err)
+		assert.Nil(t, schedule)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func TestSchedulerService_Terms(t *testing.T) {
+	ctx := context.Background()
+
+	roomID := uuid.New()
+	offeredID := uuid.New()
+	otherCourseID := uuid.New()
+	everyTermID := uuid.New()
+	thisTermID := uuid.New()
+	otherTermID := uuid.New()
+	otherCourseSessionID := uuid.New()
+
+	term := &models.Term{ID: uuid.New(), Name: "Fall 2025", StartDate: "2025-09-01", EndDate: "2025-12-19", TeachingWeeks: 14, CourseIDs: []uuid.UUID{offeredID}}
+	otherTerm := uuid.New()
+
+	rooms := []*models.Room{
+		{ID: roomID, Name: "Room 101", Type: "lecture_room", Capacity: 100},
+	}
+	courses := []models.Course{
+		{ID: offeredID, Name: "CS 101"},
+		{ID: otherCourseID, Name: "CS 201"},
+	}
+	sessions := []*models.CourseSession{
+		{ID: everyTermID, CourseID: offeredID, RequiredRoom: "lecture_room", Type: "lecture", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(1))},
+		{ID: thisTermID, CourseID: offeredID, RequiredRoom: "lecture_room", Type: "lab", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(1)), TermID: &term.ID},
+		{ID: otherTermID, CourseID: offeredID, RequiredRoom: "lecture_room", Type: "tutorial", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(1)), TermID: &otherTerm},
+		{ID: otherCourseSessionID, CourseID: otherCourseID, RequiredRoom: "lecture_room", Type: "lecture", Duration: ptr(int32(60)), NumberOfSessions: ptr(int32(1))},
+	}
+	weeklyBlackout := &models.RoomBlackout{ID: uuid.New(), RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540, Recurrence: models.RecurrenceWeekly}
+	inTermBlackout := &models.RoomBlackout{ID: uuid.New(), RoomID: roomID, Day: 0, StartTime: 600, EndTime: 660, Recurrence: models.RecurrenceOnce, Date: ptr("2025-12-15")}
+	beforeTermBlackout := &models.RoomBlackout{ID: uuid.New(), RoomID: roomID, Day: 0, StartTime: 600, EndTime: 660, Recurrence: models.RecurrenceOnce, Date: ptr("2025-08-25")}
+	afterTermBlackout := &models.RoomBlackout{ID: uuid.New(), RoomID: roomID, Day: 0, StartTime: 720, EndTime: 780, Recurrence: models.RecurrenceOnce, Date: ptr("2026-01-05")}
+	blackouts := []*models.RoomBlackout{weeklyBlackout, inTermBlackout, beforeTermBlackout, afterTermBlackout}
+
+	newService := func(sched scheduler.Scheduler, scheduleRepo *mocks.MockScheduleRepository) *service.SchedulerService {
+		return service.NewSchedulerService(sched, scheduleRepo,
+			&mocks.MockRoomRepository{ListFunc: func(ctx context.Context) ([]*models.Room, error) { return rooms, nil }},
+			&mocks.MockCourseRepository{ListFunc: func(ctx context.Context) ([]models.Course, error) { return courses, nil }},
+			&mocks.MockCourseSessionRepository{ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) { return sessions, nil }},
+			&mocks.MockCohortRepository{ListFunc: func(ctx context.Context) ([]*models.Cohort, error) { return []*models.Cohort{}, nil }},
+			&mocks.MockInstructorRepository{ListFunc: func(ctx context.Context) ([]*models.Instructor, error) { return []*models.Instructor{}, nil }},
+			&mocks.MockRoomBlackoutRepository{ListFunc: func(ctx context.Context) ([]*models.RoomBlackout, error) { return blackouts, nil }},
+			&mocks.MockBuildingDistanceRepository{ListFunc: func(ctx context.Context) ([]*models.BuildingDistance, error) { return []*models.BuildingDistance{}, nil }},
+			&mocks.MockSessionConstraintRepository{ListFunc: func(ctx context.Context) ([]*models.SessionConstraint, error) { return []*models.SessionConstraint{}, nil }},
+			&mocks.MockTermRepository{GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Term, error) {
+				if id == term.ID {
+					return term, nil
+				}
+				return nil, repository.ErrNotFound
+			}},
+		)
+	}
+
+	sessionIDs := func(input *scheduler.Input) []uuid.UUID {
+		ids := make([]uuid.UUID, len(input.CourseSessions))
+		for i, session := range input.CourseSessions {
+			ids[i] = session.ID
+		}
+		return ids
+	}
+
+	t.Run("generates for the term only", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				require.Len(t, input.Courses, 1)
+				assert.Equal(t, offeredID, input.Courses[0].ID)
+				assert.ElementsMatch(t, []uuid.UUID{everyTermID, thisTermID}, sessionIDs(input))
+				assert.ElementsMatch(t, []*models.RoomBlackout{weeklyBlackout, inTermBlackout}, input.RoomBlackouts)
+
+				return &scheduler.Output{
+					ScheduledSessions: []*models.ScheduledSession{
+						{CourseID: offeredID, CourseSessionID: &everyTermID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+					},
+				}, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
+				return s, nil
+			},
+		}
+
+		svc := newService(mockScheduler, mockScheduleRepo)
+		schedule, _, err := svc.GenerateAndSave(ctx, "Fall draft", &scheduler.Config{TermID: &term.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, &term.ID, schedule.TermID)
+	})
+
+	t.Run("without a term skips term sessions", func(t *testing.T) {
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				assert.Len(t, input.Courses, 2)
+				assert.ElementsMatch(t, []uuid.UUID{everyTermID, otherCourseSessionID}, sessionIDs(input))
+				assert.ElementsMatch(t, blackouts, input.RoomBlackouts)
+				return &scheduler.Output{}, nil
+			},
+		}
+
+		svc := newService(mockScheduler, &mocks.MockScheduleRepository{})
+		_, err := svc.Generate(ctx, nil)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown term", func(t *testing.T) {
+		unknown := uuid.New()
+
+		svc := newService(&mocks.MockScheduler{}, &mocks.MockScheduleRepository{})
+		output, err := svc.Generate(ctx, &scheduler.Config{TermID: &unknown})
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.ErrorIs(t, err, service.ErrUnknownTerm)
+	})
+
+	t.Run("repairs the term's active schedule", func(t *testing.T) {
+		active := models.NewSchedule(uuid.New(), "Fall final", []models.ScheduledSession{
+			{CourseID: offeredID, CourseSessionID: &everyTermID, RoomID: roomID, Day: 0, StartTime: 480, EndTime: 540},
+		}, nil)
+		active.TermID = &term.ID
+		active.IsActive = true
+
+		mockScheduler := &mocks.MockScheduler{
+			GenerateFunc: func(ctx context.Context, input *scheduler.Input) (*scheduler.Output, error) {
+				return &scheduler.Output{ScheduledSessions: []*models.ScheduledSession{&active.Sessions[0]}}, nil
+			},
+		}
+
+		mockScheduleRepo := &mocks.MockScheduleRepository{
+			GetActiveFunc: func(ctx context.Context, termID *uuid.UUID) (*models.Schedule, error) {
+				require.NotNil(t, termID)
+				assert.Equal(t, term.ID, *termID)
+				return active, nil
+			},
+			CreateFunc: func(ctx context.Context, s *models.Schedule) (*models.Schedule, error) {
+				return s, nil
+			},
+		}
+
+		svc := newService(mockScheduler, mockScheduleRepo)
+		schedule, _, err := svc.Repair(ctx, "", &scheduler.Config{TermID: &term.ID})
+
+		require.NoError(t, err)
+		assert.Equal(t, &term.ID, schedule.TermID)
+	})
+}
@@ -0,0 +1,107 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestSessionConstraintService_Create(t *testing.T) {
+	ctx := context.Background()
+	constraint := models.NewSessionConstraint(uuid.New(), uuid.New(), models.SessionConstraintAfter, "lecture", "lab", nil, nil, nil)
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionConstraintRepository{
+			CreateFunc: func(ctx context.Context, c *models.SessionConstraint) (*models.SessionConstraint, error) {
+				return c, nil
+			},
+		}
+
+		svc := service.NewSessionConstraintService(mockRepo)
+		result, err := svc.Create(ctx, constraint)
+
+		require.NoError(t, err)
+		assert.Equal(t, constraint.ID, result.ID)
+		assert.Equal(t, models.SessionConstraintAfter, result.Kind)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionConstraintRepository{
+			CreateFunc: func(ctx context.Context, c *models.SessionConstraint) (*models.SessionConstraint, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewSessionConstraintService(mockRepo)
+		result, err := svc.Create(ctx, constraint)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSessionConstraintService_GetByCourseID(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+	minDays := int32(2)
+	constraints := []*models.SessionConstraint{
+		models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintAfter, "lecture", "lab", nil, nil, nil),
+		models.NewSessionConstraint(uuid.New(), courseID, models.SessionConstraintMinDaysApart, "lecture", "lecture", &minDays, nil, nil),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionConstraintRepository{
+			GetByCourseIDFunc: func(ctx context.Context, reqCourseID uuid.UUID) ([]*models.SessionConstraint, error) {
+				assert.Equal(t, courseID, reqCourseID)
+				return constraints, nil
+			},
+		}
+
+		svc := service.NewSessionConstraintService(mockRepo)
+		result, err := svc.GetByCourseID(ctx, courseID)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestSessionConstraintService_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionConstraintRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				assert.Equal(t, id, reqID)
+				return nil
+			},
+		}
+
+		svc := service.NewSessionConstraintService(mockRepo)
+		err := svc.Delete(ctx, id)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockSessionConstraintRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				return repository.ErrNotFound
+			},
+		}
+
+		svc := service.NewSessionConstraintService(mockRepo)
+		err := svc.Delete(ctx, id)
+
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
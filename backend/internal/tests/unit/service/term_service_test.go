@@ -0,0 +1,193 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func TestTermService_Create(t *testing.T) {
+	ctx := context.Background()
+	term := &models.Term{ID: uuid.New(), Name: "Fall 2025", StartDate: "2025-09-01", EndDate: "2025-12-19", TeachingWeeks: 14, CourseIDs: []uuid.UUID{uuid.New()}}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			CreateFunc: func(ctx context.Context, tm *models.Term) (*models.Term, error) {
+				return term, nil
+			},
+		}
+
+		svc := service.NewTermService(mockRepo, &mocks.MockScheduleRepository{})
+		result, err := svc.Create(ctx, term)
+
+		require.NoError(t, err)
+		assert.Equal(t, term.ID, result.ID)
+		assert.Equal(t, term.CourseIDs, result.CourseIDs)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			CreateFunc: func(ctx context.Context, tm *models.Term) (*models.Term, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		svc := service.NewTermService(mockRepo, &mocks.MockScheduleRepository{})
+		result, err := svc.Create(ctx, term)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTermService_GetByID(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	term := &models.Term{ID: id, Name: "Fall 2025", StartDate: "2025-09-01", EndDate: "2025-12-19", TeachingWeeks: 14}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Term, error) {
+				return term, nil
+			},
+		}
+
+		svc := service.NewTermService(mockRepo, &mocks.MockScheduleRepository{})
+		result, err := svc.GetByID(ctx, id)
+
+		require.NoError(t, err)
+		assert.Equal(t, term.ID, result.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			GetByIDFunc: func(ctx context.Context, reqID uuid.UUID) (*models.Term, error) {
+				return nil, errors.New("not found")
+			},
+		}
+
+		svc := service.NewTermService(mockRepo, &mocks.MockScheduleRepository{})
+		result, err := svc.GetByID(ctx, id)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestTermService_List(t *testing.T) {
+	ctx := context.Background()
+	terms := []*models.Term{
+		{ID: uuid.New(), Name: "Fall 2025", StartDate: "2025-09-01", EndDate: "2025-12-19", TeachingWeeks: 14},
+		{ID: uuid.New(), Name: "Spring 2026", StartDate: "2026-01-12", EndDate: "2026-05-01", TeachingWeeks: 15},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Term, error) {
+				return terms, nil
+			},
+		}
+
+		svc := service.NewTermService(mockRepo, &mocks.MockScheduleRepository{})
+		result, err := svc.List(ctx)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
+
+func TestTermService_Delete(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	otherTerm := uuid.New()
+	scheduleRepo := func(schedules, archived []*models.Schedule) *mocks.MockScheduleRepository {
+		return &mocks.MockScheduleRepository{
+			ListByTermFunc: func(ctx context.Context, termID uuid.UUID) ([]*models.Schedule, error) {
+				return schedules, nil
+			},
+			ListArchivedFunc: func(ctx context.Context) ([]*models.Schedule, error) {
+				return archived, nil
+			},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		deleted := false
+		mockRepo := &mocks.MockTermRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				deleted = true
+				return nil
+			},
+		}
+		archived := []*models.Schedule{{ID: uuid.New(), TermID: &otherTerm}, {ID: uuid.New()}}
+
+		svc := service.NewTermService(mockRepo, scheduleRepo(nil, archived))
+		err := svc.Delete(ctx, id)
+
+		require.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("term has schedules", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				t.Fatal("a term with schedules must not be deleted")
+				return nil
+			},
+		}
+		schedules := []*models.Schedule{{ID: uuid.New(), TermID: &id}}
+
+		svc := service.NewTermService(mockRepo, scheduleRepo(schedules, nil))
+		err := svc.Delete(ctx, id)
+
+		require.ErrorIs(t, err, service.ErrTermInUse)
+	})
+
+	t.Run("term has archived schedules", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			DeleteFunc: func(ctx context.Context, reqID uuid.UUID) error {
+				t.Fatal("a term with archived schedules must not be deleted")
+				return nil
+			},
+		}
+		archived := []*models.Schedule{{ID: uuid.New(), TermID: &otherTerm}, {ID: uuid.New(), TermID: &id}}
+
+		svc := service.NewTermService(mockRepo, scheduleRepo(nil, archived))
+		err := svc.Delete(ctx, id)
+
+		require.ErrorIs(t, err, service.ErrTermInUse)
+	})
+}
+
+func TestTermService_Update(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	newWeeks := int32(12)
+	courseIDs := []uuid.UUID{uuid.New()}
+	updates := &models.TermUpdate{TeachingWeeks: &newWeeks, CourseIDs: courseIDs}
+	updated := &models.Term{ID: id, Name: "Fall 2025", StartDate: "2025-09-01", EndDate: "2025-12-19", TeachingWeeks: newWeeks, CourseIDs: courseIDs}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := &mocks.MockTermRepository{
+			UpdateFunc: func(ctx context.Context, reqID uuid.UUID, u *models.TermUpdate) (*models.Term, error) {
+				return updated, nil
+			},
+		}
+
+		svc := service.NewTermService(mockRepo, &mocks.MockScheduleRepository{})
+		result, err := svc.Update(ctx, id, updates)
+
+		require.NoError(t, err)
+		assert.Equal(t, newWeeks, result.TeachingWeeks)
+		assert.Equal(t, courseIDs, result.CourseIDs)
+	})
+}
@@ -0,0 +1,287 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/models"
+	"github.com/TerrenceMurray/course-scheduler/internal/repository"
+	"github.com/TerrenceMurray/course-scheduler/internal/service"
+	"github.com/TerrenceMurray/course-scheduler/internal/tests/unit/service/mocks"
+)
+
+func newTimetableService(schedule *models.Schedule, rooms []*models.Room, buildings []models.Building, courses []models.Course, courseSessions []*models.CourseSession) *service.TimetableService {
+	return service.NewTimetableService(
+		&mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				if id != schedule.ID {
+					return nil, repository.ErrNotFound
+				}
+				return schedule, nil
+			},
+		},
+		&mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) { return rooms, nil },
+		},
+		&mocks.MockBuildingRepository{
+			ListFunc: func(ctx context.Context) ([]models.Building, error) { return buildings, nil },
+		},
+		&mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) { return courses, nil },
+		},
+		&mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) { return courseSessions, nil },
+		},
+		&mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) { return nil, nil },
+		},
+	)
+}
+
+func TestTimetableService_Grid(t *testing.T) {
+	ctx := context.Background()
+	math, physics := uuid.New(), uuid.New()
+	room101, lab2, deleted := uuid.New(), uuid.New(), uuid.New()
+	science, engineering := uuid.New(), uuid.New()
+	lecture := &models.CourseSession{ID: uuid.New(), CourseID: math, Type: "lecture"}
+	lab := &models.CourseSession{ID: uuid.New(), CourseID: physics, Type: "lab"}
+
+	schedule := &models.Schedule{
+		ID:   uuid.New(),
+		Name: "Fall 2025",
+		Sessions: []models.ScheduledSession{
+			{CourseID: physics, CourseSessionID: &lab.ID, RoomID: lab2, Day: 2, StartTime: 600, EndTime: 720},
+			{CourseID: math, CourseSessionID: &lecture.ID, RoomID: room101, Day: 0, StartTime: 540, EndTime: 600},
+			{CourseID: math, RoomID: room101, Day: 0, StartTime: 570, EndTime: 630},
+			{CourseID: physics, RoomID: deleted, Day: 2, StartTime: 540, EndTime: 560},
+		},
+	}
+	svc := newTimetableService(schedule,
+		[]*models.Room{{ID: room101, Name: "Room 101", Building: science}, {ID: lab2, Name: "Lab 2", Building: engineering}},
+		[]models.Building{{ID: science, Name: "Science"}, {ID: engineering, Name: "Engineering"}},
+		[]models.Course{{ID: math, Name: "Math 101"}, {ID: physics, Name: "Physics 101"}},
+		[]*models.CourseSession{lecture, lab},
+	)
+
+	timetable, err := svc.Grid(ctx, schedule.ID, 60)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Fall 2025", timetable.Name)
+	// Rooms by building then name; a deleted room is labelled by its ID, with no building
+	require.Len(t, timetable.Rooms, 3)
+	assert.Equal(t, deleted.String(), timetable.Rooms[0].Label())
+	assert.Equal(t, "Lab 2 (Engineering)", timetable.Rooms[1].Label())
+	assert.Equal(t, "Room 101 (Science)", timetable.Rooms[2].Label())
+
+	// Monday and Wednesday, each with the hours from 09:00 to 12:00
+	require.Len(t, timetable.Days, 2)
+	assert.Equal(t, 0, timetable.Days[0].Day)
+	assert.Equal(t, 2, timetable.Days[1].Day)
+	for _, day := range timetable.Days {
+		require.Len(t, day.Slots, 3)
+		assert.Equal(t, 540, day.Slots[0].StartTime)
+		assert.Equal(t, 720, day.Slots[2].EndTime)
+	}
+
+	monday, wednesday := timetable.Days[0].Slots, timetable.Days[1].Slots
+	assert.Equal(t, []string{"", "", "Math 101 Lecture / Math 101"}, monday[0].Cells, "sessions off the slots share a cell")
+	assert.Equal(t, []string{"", "", "Math 101"}, monday[1].Cells)
+	assert.Equal(t, []string{"", "", ""}, monday[2].Cells)
+	assert.Equal(t, []string{"Physics 101", "", ""}, wednesday[0].Cells)
+	assert.Equal(t, []string{"", "Physics 101 Lab", ""}, wednesday[1].Cells)
+	assert.Equal(t, []string{"", "Physics 101 Lab", ""}, wednesday[2].Cells)
+}
+
+func TestTimetableService_Grid_Slot(t *testing.T) {
+	ctx := context.Background()
+	course, room := uuid.New(), uuid.New()
+	schedule := &models.Schedule{
+		ID:       uuid.New(),
+		Name:     "Spring",
+		Sessions: []models.ScheduledSession{{CourseID: course, RoomID: room, Day: 4, StartTime: 545, EndTime: 640}},
+	}
+	svc := newTimetableService(schedule, nil, nil, nil, nil)
+
+	timetable, err := svc.Grid(ctx, schedule.ID, 30)
+	require.NoError(t, err)
+
+	// 09:05-10:40 covers the half hours from 09:00 to 11:00
+	require.Len(t, timetable.Days, 1)
+	slots := timetable.Days[0].Slots
+	require.Len(t, slots, 4)
+	assert.Equal(t, 540, slots[0].StartTime)
+	assert.Equal(t, 660, slots[3].EndTime)
+	for _, slot := range slots {
+		assert.Equal(t, []string{"Course"}, slot.Cells)
+	}
+
+	_, err = svc.Grid(ctx, schedule.ID, 1)
+	assert.ErrorIs(t, err, service.ErrInvalidTimetableSlot)
+	_, err = svc.Grid(ctx, schedule.ID, 300)
+	assert.ErrorIs(t, err, service.ErrInvalidTimetableSlot)
+
+	_, err = svc.Grid(ctx, uuid.New(), 60)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+func TestTimetableService_Grid_Empty(t *testing.T) {
+	schedule := &models.Schedule{ID: uuid.New(), Name: "Empty"}
+	svc := newTimetableService(schedule, nil, nil, nil, nil)
+
+	timetable, err := svc.Grid(context.Background(), schedule.ID, 60)
+	require.NoError(t, err)
+	assert.Empty(t, timetable.Rooms)
+	assert.Empty(t, timetable.Days)
+}
+
+func TestTimetableService_Weekly(t *testing.T) {
+	ctx := context.Background()
+	math, physics := uuid.New(), uuid.New()
+	room101, room102, lab2 := uuid.New(), uuid.New(), uuid.New()
+	science, engineering := uuid.New(), uuid.New()
+	lecture := &models.CourseSession{ID: uuid.New(), CourseID: math, Type: "lecture"}
+	instructor := uuid.New()
+
+	schedule := &models.Schedule{
+		ID:   uuid.New(),
+		Name: "Fall 2025",
+		Sessions: []models.ScheduledSession{
+			{CourseID: math, CourseSessionID: &lecture.ID, RoomID: room101, InstructorID: &instructor, Day: 0, StartTime: 540, EndTime: 660},
+			{CourseID: physics, RoomID: room102, Day: 0, StartTime: 600, EndTime: 720},
+			{CourseID: physics, RoomID: room101, Day: 0, StartTime: 690, EndTime: 750},
+			{CourseID: math, RoomID: lab2, Day: 3, StartTime: 840, EndTime: 900},
+		},
+	}
+	svc := service.NewTimetableService(
+		&mocks.MockScheduleRepository{
+			GetByIDFunc: func(ctx context.Context, id uuid.UUID) (*models.Schedule, error) {
+				if id != schedule.ID {
+					return nil, repository.ErrNotFound
+				}
+				return schedule, nil
+			},
+		},
+		&mocks.MockRoomRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Room, error) {
+				return []*models.Room{
+					{ID: room101, Name: "Room 101", Building: science},
+					{ID: room102, Name: "Room 102", Building: science},
+					{ID: lab2, Name: "Lab 2", Building: engineering},
+				}, nil
+			},
+		},
+		&mocks.MockBuildingRepository{
+			ListFunc: func(ctx context.Context) ([]models.Building, error) {
+				return []models.Building{{ID: science, Name: "Science"}, {ID: engineering, Name: "Engineering"}}, nil
+			},
+		},
+		&mocks.MockCourseRepository{
+			ListFunc: func(ctx context.Context) ([]models.Course, error) {
+				return []models.Course{{ID: math, Name: "Math 101"}, {ID: physics, Name: "Physics 101"}}, nil
+			},
+		},
+		&mocks.MockCourseSessionRepository{
+			ListFunc: func(ctx context.Context) ([]*models.CourseSession, error) {
+				return []*models.CourseSession{lecture}, nil
+			},
+		},
+		&mocks.MockInstructorRepository{
+			ListFunc: func(ctx context.Context) ([]*models.Instructor, error) {
+				return []*models.Instructor{{ID: instructor, Name: "Dr. Smith"}}, nil
+			},
+		},
+	)
+
+	t.Run("room", func(t *testing.T) {
+		timetable, err := svc.Weekly(ctx, schedule.ID, models.TimetableFilter{RoomID: &room101}, 60)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Room 101 (Science)", timetable.Title)
+		assert.Equal(t, "Fall 2025", timetable.Schedule)
+		// The whole schedule's days and hours, so every timetable lines up
+		assert.Equal(t, []int{0, 3}, timetable.Days)
+		assert.Equal(t, 540, timetable.StartTime)
+		assert.Equal(t, 900, timetable.EndTime)
+
+		require.Len(t, timetable.Entries, 2)
+		assert.Equal(t, models.TimetableEntry{Day: 0, StartTime: 540, EndTime: 660, Lines: []string{"Math 101 Lecture", "Instructor: Dr. Smith"}, Lane: 0, Lanes: 1}, timetable.Entries[0])
+		assert.Equal(t, []string{"Physics 101"}, timetable.Entries[1].Lines)
+	})
+
+	t.Run("course", func(t *testing.T) {
+		timetable, err := svc.Weekly(ctx, schedule.ID, models.TimetableFilter{CourseID: &math}, 60)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Math 101", timetable.Title)
+		require.Len(t, timetable.Entries, 2)
+		assert.Equal(t, []string{"Math 101 Lecture", "Room 101, Science", "Instructor: Dr. Smith"}, timetable.Entries[0].Lines)
+		assert.Equal(t, []string{"Math 101", "Lab 2, Engineering"}, timetable.Entries[1].Lines)
+	})
+
+	t.Run("building lays overlapping sessions side by side", func(t *testing.T) {
+		timetable, err := svc.Weekly(ctx, schedule.ID, models.TimetableFilter{BuildingID: &science}, 60)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Science", timetable.Title)
+		require.Len(t, timetable.Entries, 3)
+		assert.Equal(t, []string{"Physics 101", "Room 102"}, timetable.Entries[1].Lines)
+		// 09:00-11:00 and 10:00-12:00 overlap, and 11:30-12:30 reuses the first lane
+		for i, lane := range []int{0, 1, 0} {
+			assert.Equal(t, lane, timetable.Entries[i].Lane, "entry %d", i)
+			assert.Equal(t, 2, timetable.Entries[i].Lanes, "entry %d", i)
+		}
+	})
+
+	t.Run("room timetables", func(t *testing.T) {
+		timetables, err := svc.RoomTimetables(ctx, schedule.ID, science, 30)
+		require.NoError(t, err)
+
+		require.Len(t, timetables, 2)
+		assert.Equal(t, "Room 101 (Science)", timetables[0].Title)
+		assert.Equal(t, "Room 102 (Science)", timetables[1].Title)
+		assert.Len(t, timetables[1].Entries, 1)
+		assert.Equal(t, 30, timetables[1].Slot)
+		assert.Equal(t, "Fall 2025", timetables[1].Schedule)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		unknown := uuid.New()
+		for name, filter := range map[string]models.TimetableFilter{
+			"nothing":          {},
+			"two":              {RoomID: &room101, CourseID: &math},
+			"unknown room":     {RoomID: &unknown},
+			"unknown course":   {CourseID: &unknown},
+			"unknown building": {BuildingID: &unknown},
+		} {
+			_, err := svc.Weekly(ctx, schedule.ID, filter, 60)
+			assert.ErrorIs(t, err, service.ErrInvalidTimetableFilter, name)
+		}
+
+		_, err := svc.RoomTimetables(ctx, schedule.ID, unknown, 60)
+		assert.ErrorIs(t, err, service.ErrInvalidTimetableFilter)
+		_, err = svc.Weekly(ctx, schedule.ID, models.TimetableFilter{RoomID: &room101}, 0)
+		assert.ErrorIs(t, err, service.ErrInvalidTimetableSlot)
+		_, err = svc.Weekly(ctx, uuid.New(), models.TimetableFilter{RoomID: &room101}, 60)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func TestTimetableService_Weekly_Empty(t *testing.T) {
+	room := uuid.New()
+	schedule := &models.Schedule{ID: uuid.New(), Name: "Empty"}
+	svc := newTimetableService(schedule, []*models.Room{{ID: room, Name: "Room 101"}}, nil, nil, nil)
+
+	timetable, err := svc.Weekly(context.Background(), schedule.ID, models.TimetableFilter{RoomID: &room}, 60)
+	require.NoError(t, err)
+
+	// The default operating days and hours
+	assert.Equal(t, "Room 101", timetable.Title)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, timetable.Days)
+	assert.Equal(t, 480, timetable.StartTime)
+	assert.Equal(t, 1260, timetable.EndTime)
+	assert.Empty(t, timetable.Entries)
+}
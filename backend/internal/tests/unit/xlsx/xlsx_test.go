@@ -0,0 +1,99 @@
+package xlsx_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TerrenceMurray/course-scheduler/internal/xlsx"
+)
+
+// open encodes a workbook and returns the contents of its files by name
+func open(t *testing.T, workbook *xlsx.Workbook) map[string]string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, workbook.Encode(&buf))
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, f := range archive.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+
+		// Every part must be well-formed XML
+		decoder := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, f.Name)
+		}
+		files[f.Name] = string(data)
+	}
+	return files
+}
+
+func TestWorkbook_Encode(t *testing.T) {
+	files := open(t, &xlsx.Workbook{Sheets: []xlsx.Sheet{
+		{Name: "Monday", Header: true, Rows: [][]string{
+			{"Time", "Room 101 (Science)"},
+			{"09:00-10:00", "Math & Physics <1>"},
+			{"10:00-11:00", ""},
+		}},
+		{Name: "Tuesday", Rows: [][]string{{"a", "", "c"}}},
+	}})
+
+	assert.Len(t, files, 7)
+	assert.Contains(t, files["[Content_Types].xml"], `PartName="/xl/worksheets/sheet2.xml"`)
+	assert.Contains(t, files["xl/workbook.xml"], `<sheet name="Monday" sheetId="1" r:id="rId1"/><sheet name="Tuesday" sheetId="2" r:id="rId2"/>`)
+	assert.Contains(t, files["xl/_rels/workbook.xml.rels"], `Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles"`)
+
+	monday := files["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, monday, `state="frozen"`)
+	assert.Contains(t, monday, `<c r="B1" t="inlineStr" s="1"><is><t xml:space="preserve">Room 101 (Science)</t></is></c>`)
+	assert.Contains(t, monday, `<c r="B2" t="inlineStr"><is><t xml:space="preserve">Math &amp; Physics &lt;1&gt;</t></is></c>`)
+	assert.Contains(t, monday, `<row r="3"><c r="A3" t="inlineStr">`)
+	assert.NotContains(t, monday, `r="B3"`, "empty cells are left out")
+
+	tuesday := files["xl/worksheets/sheet2.xml"]
+	assert.NotContains(t, tuesday, "frozen")
+	assert.Contains(t, tuesday, `<c r="C1" t="inlineStr"><is><t xml:space="preserve">c</t></is></c>`)
+}
+
+func TestWorkbook_Encode_SheetNames(t *testing.T) {
+	files := open(t, &xlsx.Workbook{Sheets: []xlsx.Sheet{
+		{Name: "Week 1/2: [draft]"},
+		{Name: "week 1_2_ _draft_"},
+		{Name: "A very long sheet name that goes past the limit"},
+		{Name: "A very long sheet name that goes past the limit"},
+		{Name: ""},
+	}})
+
+	workbook := files["xl/workbook.xml"]
+	assert.Contains(t, workbook, `name="Week 1_2_ _draft_"`)
+	assert.Contains(t, workbook, `name="week 1_2_ _draft_ (2)"`, "names are unique ignoring case")
+	assert.Contains(t, workbook, `name="A very long sheet name that goe"`)
+	assert.Contains(t, workbook, `name="A very long sheet name that (2)"`)
+	assert.Contains(t, workbook, `name="Sheet5"`)
+}
+
+func TestWorkbook_Encode_NoSheets(t *testing.T) {
+	assert.Error(t, (&xlsx.Workbook{}).Encode(io.Discard))
+}
+
+func TestColumn(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 52: "BA", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, want, xlsx.Column(index))
+	}
+}
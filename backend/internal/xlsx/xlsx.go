@@ -0,0 +1,237 @@
+// Package xlsx writes Office Open XML spreadsheets (.xlsx) of plain text cells.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxSheetName is the longest name a sheet may have
+const MaxSheetName = 31
+
+// maxColumnWidth caps how wide a column is made for its longest cell (in characters)
+const maxColumnWidth = 60
+
+// Workbook is a spreadsheet of one or more sheets
+type Workbook struct {
+	Sheets []Sheet
+}
+
+// Sheet is a grid of text cells. Names are cleaned up and made unique when the workbook is
+// written, as spreadsheet apps refuse long names, repeated names and some punctuation.
+type Sheet struct {
+	Name string
+	Rows [][]string
+
+	// Header makes the first row bold and freezes it and the first column, so they stay in view
+	// while scrolling
+	Header bool
+}
+
+// Encode writes the workbook to w as a zip archive
+func (wb *Workbook) Encode(w io.Writer) error {
+	if len(wb.Sheets) == 0 {
+		return errors.New("a workbook needs at least one sheet")
+	}
+
+	names := sheetNames(wb.Sheets)
+	z := zip.NewWriter(w)
+
+	parts := []part{
+		{"[Content_Types].xml", func(w *bufio.Writer) { writeContentTypes(w, len(wb.Sheets)) }},
+		{"_rels/.rels", writeRootRels},
+		{"xl/workbook.xml", func(w *bufio.Writer) { writeWorkbook(w, names) }},
+		{"xl/_rels/workbook.xml.rels", func(w *bufio.Writer) { writeWorkbookRels(w, len(wb.Sheets)) }},
+		{"xl/styles.xml", writeStyles},
+	}
+	for i := range wb.Sheets {
+		sheet := &wb.Sheets[i]
+		parts = append(parts, part{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), func(w *bufio.Writer) { writeSheet(w, sheet) }})
+	}
+
+	for _, part := range parts {
+		f, err := z.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		bw := bufio.NewWriter(f)
+		bw.WriteString(xml.Header)
+		part.write(bw)
+		if err := bw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return z.Close()
+}
+
+// part is a file in the archive and the function that writes its XML
+type part struct {
+	name  string
+	write func(w *bufio.Writer)
+}
+
+func writeContentTypes(w *bufio.Writer, sheets int) {
+	w.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	w.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	w.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	w.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	w.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(w, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	w.WriteString(`</Types>`)
+}
+
+func writeRootRels(w *bufio.Writer) {
+	w.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	w.WriteString(`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>`)
+	w.WriteString(`</Relationships>`)
+}
+
+func writeWorkbook(w *bufio.Writer, names []string) {
+	w.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, name := range names {
+		fmt.Fprintf(w, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(name), i+1, i+1)
+	}
+	w.WriteString(`</sheets></workbook>`)
+}
+
+// writeWorkbookRels relates the workbook to its sheets, as rId1 to rIdN, and its styles
+func writeWorkbookRels(w *bufio.Writer, sheets int) {
+	w.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(w, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(w, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheets+1)
+	w.WriteString(`</Relationships>`)
+}
+
+// Cell styles, as indexes into cellXfs
+const (
+	styleNormal = 0
+	styleHeader = 1 // bold
+)
+
+func writeStyles(w *bufio.Writer) {
+	w.WriteString(`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	w.WriteString(`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>`)
+	w.WriteString(`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>`)
+	w.WriteString(`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>`)
+	w.WriteString(`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>`)
+	w.WriteString(`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>`)
+	w.WriteString(`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>`)
+	w.WriteString(`</styleSheet>`)
+}
+
+func writeSheet(w *bufio.Writer, sheet *Sheet) {
+	w.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if sheet.Header && len(sheet.Rows) > 0 {
+		w.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane xSplit="1" ySplit="1" topLeftCell="B2" activePane="bottomRight" state="frozen"/></sheetView></sheetViews>`)
+	}
+
+	if widths := columnWidths(sheet.Rows); len(widths) > 0 {
+		w.WriteString(`<cols>`)
+		for i, width := range widths {
+			fmt.Fprintf(w, `<col min="%d" max="%d" width="%d" customWidth="1"/>`, i+1, i+1, width)
+		}
+		w.WriteString(`</cols>`)
+	}
+
+	w.WriteString(`<sheetData>`)
+	for r, row := range sheet.Rows {
+		style := styleNormal
+		if sheet.Header && r == 0 {
+			style = styleHeader
+		}
+
+		fmt.Fprintf(w, `<row r="%d">`, r+1)
+		for c, value := range row {
+			if value == "" {
+				continue
+			}
+			fmt.Fprintf(w, `<c r="%s%d" t="inlineStr"`, Column(c), r+1)
+			if style != styleNormal {
+				fmt.Fprintf(w, ` s="%d"`, style)
+			}
+			fmt.Fprintf(w, `><is><t xml:space="preserve">%s</t></is></c>`, escape(value))
+		}
+		w.WriteString(`</row>`)
+	}
+	w.WriteString(`</sheetData></worksheet>`)
+}
+
+// columnWidths sizes each column to its longest line, within limits
+func columnWidths(rows [][]string) []int {
+	var widths []int
+	for _, row := range rows {
+		for c, value := range row {
+			for len(widths) <= c {
+				widths = append(widths, 10)
+			}
+			for _, line := range strings.Split(value, "\n") {
+				widths[c] = min(max(widths[c], utf8.RuneCountInString(line)+2), maxColumnWidth)
+			}
+		}
+	}
+	return widths
+}
+
+// Column names a zero-based column index as it is in a cell reference: A to Z, then AA onwards
+func Column(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+// sheetNames cleans up the sheets' names and makes them unique, ignoring case as spreadsheet
+// apps do
+func sheetNames(sheets []Sheet) []string {
+	names := make([]string, len(sheets))
+	used := make(map[string]bool, len(sheets))
+
+	for i, sheet := range sheets {
+		base := strings.Map(func(r rune) rune {
+			if strings.ContainsRune(`[]:*?/\`, r) {
+				return '_'
+			}
+			return r
+		}, strings.Trim(sheet.Name, "' "))
+		if base == "" {
+			base = fmt.Sprintf("Sheet%d", i+1)
+		}
+
+		name := truncate(base, MaxSheetName)
+		for n := 2; used[strings.ToLower(name)]; n++ {
+			suffix := fmt.Sprintf(" (%d)", n)
+			name = truncate(base, MaxSheetName-len(suffix)) + suffix
+		}
+		used[strings.ToLower(name)] = true
+		names[i] = name
+	}
+
+	return names
+}
+
+// truncate shortens s to at most n characters
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// escape escapes text for XML, replacing characters XML can't hold
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
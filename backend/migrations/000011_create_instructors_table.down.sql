@@ -0,0 +1,9 @@
+ALTER TABLE scheduler.course_sessions DROP CONSTRAINT IF EXISTS course_sessions_instructor_id_fkey;
+ALTER TABLE scheduler.course_sessions DROP COLUMN IF EXISTS instructor_id;
+
+DROP POLICY IF EXISTS instructors_select_policy ON scheduler.instructors;
+DROP POLICY IF EXISTS instructors_insert_policy ON scheduler.instructors;
+DROP POLICY IF EXISTS instructors_update_policy ON scheduler.instructors;
+DROP POLICY IF EXISTS instructors_delete_policy ON scheduler.instructors;
+
+DROP TABLE IF EXISTS scheduler.instructors;
@@ -0,0 +1,57 @@
+-- Instructors are the staff who teach course sessions
+CREATE TABLE scheduler.instructors (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    created_by UUID NOT NULL
+);
+
+-- Foreign key constraint
+ALTER TABLE scheduler.instructors ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+
+-- Unique constraint per user
+ALTER TABLE scheduler.instructors
+    ADD CONSTRAINT instructors_name_created_by_unique UNIQUE (name, created_by);
+
+-- Triggers
+CREATE TRIGGER update_instructors_timestamp
+BEFORE UPDATE ON scheduler.instructors
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+CREATE TRIGGER set_instructors_created_by
+BEFORE INSERT ON scheduler.instructors
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+-- Instructors are assigned per course session, since labs are often run by different staff
+ALTER TABLE scheduler.course_sessions ADD COLUMN instructor_id UUID NULL;
+ALTER TABLE scheduler.course_sessions
+    ADD CONSTRAINT course_sessions_instructor_id_fkey
+    FOREIGN KEY (instructor_id) REFERENCES scheduler.instructors(id) ON DELETE SET NULL;
+
+COMMENT ON TABLE scheduler.instructors IS 'Teaching staff that can be assigned to course sessions';
+COMMENT ON COLUMN scheduler.course_sessions.instructor_id IS 'Instructor teaching this session (NULL if unassigned)';
+
+-- Row-Level Security
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.instructors TO authenticated;
+
+ALTER TABLE scheduler.instructors ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.instructors FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY instructors_select_policy ON scheduler.instructors
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY instructors_insert_policy ON scheduler.instructors
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY instructors_update_policy ON scheduler.instructors
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY instructors_delete_policy ON scheduler.instructors
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
@@ -0,0 +1,12 @@
+DROP POLICY IF EXISTS cohort_courses_select_policy ON scheduler.cohort_courses;
+DROP POLICY IF EXISTS cohort_courses_insert_policy ON scheduler.cohort_courses;
+DROP POLICY IF EXISTS cohort_courses_update_policy ON scheduler.cohort_courses;
+DROP POLICY IF EXISTS cohort_courses_delete_policy ON scheduler.cohort_courses;
+
+DROP POLICY IF EXISTS cohorts_select_policy ON scheduler.cohorts;
+DROP POLICY IF EXISTS cohorts_insert_policy ON scheduler.cohorts;
+DROP POLICY IF EXISTS cohorts_update_policy ON scheduler.cohorts;
+DROP POLICY IF EXISTS cohorts_delete_policy ON scheduler.cohorts;
+
+DROP TABLE IF EXISTS scheduler.cohort_courses;
+DROP TABLE IF EXISTS scheduler.cohorts;
@@ -0,0 +1,98 @@
+-- Cohorts group students who take the same courses together (programme + year of study)
+-- e.g., "BSc Computer Science, Year 1"
+CREATE TABLE scheduler.cohorts (
+    id UUID PRIMARY KEY,
+    programme VARCHAR(255) NOT NULL,
+    year INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    created_by UUID NOT NULL
+);
+
+-- Foreign key constraint
+ALTER TABLE scheduler.cohorts ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+
+-- Constraints
+ALTER TABLE scheduler.cohorts
+    ADD CONSTRAINT cohorts_programme_year_created_by_unique UNIQUE (programme, year, created_by);
+ALTER TABLE scheduler.cohorts
+    ADD CONSTRAINT CHK_CohortYear CHECK (year > 0);
+
+-- Triggers
+CREATE TRIGGER update_cohorts_timestamp
+BEFORE UPDATE ON scheduler.cohorts
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+CREATE TRIGGER set_cohorts_created_by
+BEFORE INSERT ON scheduler.cohorts
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+-- Member courses of each cohort; sessions of courses sharing a cohort must never overlap
+CREATE TABLE scheduler.cohort_courses (
+    cohort_id UUID NOT NULL,
+    course_id UUID NOT NULL,
+    created_by UUID NOT NULL,
+    PRIMARY KEY (cohort_id, course_id)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.cohort_courses ADD FOREIGN KEY (cohort_id) REFERENCES scheduler.cohorts(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.cohort_courses ADD FOREIGN KEY (course_id) REFERENCES scheduler.courses(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.cohort_courses ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+
+-- Triggers
+CREATE TRIGGER set_cohort_courses_created_by
+BEFORE INSERT ON scheduler.cohort_courses
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.cohorts IS 'Student groups (programme + year) whose courses must not overlap';
+COMMENT ON COLUMN scheduler.cohorts.year IS 'Year of study within the programme (e.g., 1 for first-year students)';
+COMMENT ON TABLE scheduler.cohort_courses IS 'Courses taken together by a cohort';
+
+-- Row-Level Security
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.cohorts TO authenticated;
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.cohort_courses TO authenticated;
+
+ALTER TABLE scheduler.cohorts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.cohorts FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE scheduler.cohort_courses ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.cohort_courses FORCE ROW LEVEL SECURITY;
+
+-- Cohorts policies
+CREATE POLICY cohorts_select_policy ON scheduler.cohorts
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY cohorts_insert_policy ON scheduler.cohorts
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY cohorts_update_policy ON scheduler.cohorts
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY cohorts_delete_policy ON scheduler.cohorts
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+-- Cohort Courses policies
+CREATE POLICY cohort_courses_select_policy ON scheduler.cohort_courses
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY cohort_courses_insert_policy ON scheduler.cohort_courses
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY cohort_courses_update_policy ON scheduler.cohort_courses
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY cohort_courses_delete_policy ON scheduler.cohort_courses
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
@@ -0,0 +1,5 @@
+ALTER TABLE scheduler.course_sessions DROP CONSTRAINT IF EXISTS course_sessions_expected_enrollment_check;
+ALTER TABLE scheduler.course_sessions DROP COLUMN IF EXISTS expected_enrollment;
+
+ALTER TABLE scheduler.courses DROP CONSTRAINT IF EXISTS courses_expected_enrollment_check;
+ALTER TABLE scheduler.courses DROP COLUMN IF EXISTS expected_enrollment;
@@ -0,0 +1,9 @@
+-- Expected number of students attending; NULL means unknown, so any room of the required type will do
+ALTER TABLE scheduler.courses ADD COLUMN expected_enrollment INTEGER NULL;
+ALTER TABLE scheduler.courses
+    ADD CONSTRAINT courses_expected_enrollment_check CHECK (expected_enrollment > 0);
+
+-- Sessions may override the course enrollment, e.g. labs split into smaller groups
+ALTER TABLE scheduler.course_sessions ADD COLUMN expected_enrollment INTEGER NULL;
+ALTER TABLE scheduler.course_sessions
+    ADD CONSTRAINT course_sessions_expected_enrollment_check CHECK (expected_enrollment > 0);
@@ -0,0 +1,3 @@
+ALTER TABLE scheduler.instructors DROP CONSTRAINT IF EXISTS instructors_preferred_hours_check;
+ALTER TABLE scheduler.instructors DROP COLUMN IF EXISTS preferred_end;
+ALTER TABLE scheduler.instructors DROP COLUMN IF EXISTS preferred_start;
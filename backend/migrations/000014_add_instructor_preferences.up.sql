@@ -0,0 +1,8 @@
+-- Hours an instructor prefers to teach (minutes from midnight); sessions outside them are allowed but penalised
+ALTER TABLE scheduler.instructors ADD COLUMN preferred_start INTEGER NULL;
+ALTER TABLE scheduler.instructors ADD COLUMN preferred_end INTEGER NULL;
+ALTER TABLE scheduler.instructors
+    ADD CONSTRAINT instructors_preferred_hours_check CHECK (
+        (preferred_start IS NULL AND preferred_end IS NULL)
+        OR (preferred_start >= 0 AND preferred_end <= 1440 AND preferred_start < preferred_end)
+    );
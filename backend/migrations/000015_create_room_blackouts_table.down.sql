@@ -0,0 +1,6 @@
+DROP POLICY IF EXISTS room_blackouts_select_policy ON scheduler.room_blackouts;
+DROP POLICY IF EXISTS room_blackouts_insert_policy ON scheduler.room_blackouts;
+DROP POLICY IF EXISTS room_blackouts_update_policy ON scheduler.room_blackouts;
+DROP POLICY IF EXISTS room_blackouts_delete_policy ON scheduler.room_blackouts;
+
+DROP TABLE IF EXISTS scheduler.room_blackouts;
@@ -0,0 +1,73 @@
+-- Room blackouts block time in a room: maintenance windows, department-reserved hours, evening closures.
+-- Weekly blackouts repeat every week on the given day; one-off blackouts only apply on the given date.
+CREATE TABLE scheduler.room_blackouts (
+    id UUID PRIMARY KEY,
+    room_id UUID NOT NULL,
+    day INT NOT NULL,
+    start_time INT NOT NULL,
+    end_time INT NOT NULL,
+    recurrence VARCHAR(16) NOT NULL DEFAULT 'weekly',
+    date DATE NULL,
+    reason VARCHAR(255) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    created_by UUID NOT NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.room_blackouts ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+ALTER TABLE scheduler.room_blackouts
+    ADD CONSTRAINT room_blackouts_room_id_fkey
+    FOREIGN KEY (room_id) REFERENCES scheduler.rooms(id) ON DELETE CASCADE;
+
+-- Constraints
+ALTER TABLE scheduler.room_blackouts
+    ADD CONSTRAINT CHK_RoomBlackoutDay CHECK (day BETWEEN 0 AND 6);
+ALTER TABLE scheduler.room_blackouts
+    ADD CONSTRAINT CHK_RoomBlackoutTime CHECK (start_time >= 0 AND end_time <= 1440 AND start_time < end_time);
+ALTER TABLE scheduler.room_blackouts
+    ADD CONSTRAINT CHK_RoomBlackoutRecurrence CHECK (
+        (recurrence = 'weekly' AND date IS NULL) OR (recurrence = 'once' AND date IS NOT NULL)
+    );
+
+CREATE INDEX idx_room_blackouts_room_id ON scheduler.room_blackouts(room_id);
+
+-- Triggers
+CREATE TRIGGER update_room_blackouts_timestamp
+BEFORE UPDATE ON scheduler.room_blackouts
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+CREATE TRIGGER set_room_blackouts_created_by
+BEFORE INSERT ON scheduler.room_blackouts
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+COMMENT ON TABLE scheduler.room_blackouts IS 'Time ranges when a room cannot be scheduled';
+COMMENT ON COLUMN scheduler.room_blackouts.day IS 'Day of the week (0 = Monday, 6 = Sunday)';
+COMMENT ON COLUMN scheduler.room_blackouts.start_time IS 'Start of the blackout in minutes from midnight';
+COMMENT ON COLUMN scheduler.room_blackouts.end_time IS 'End of the blackout in minutes from midnight';
+COMMENT ON COLUMN scheduler.room_blackouts.recurrence IS 'weekly (every week on day) or once (only on date)';
+COMMENT ON COLUMN scheduler.room_blackouts.date IS 'Date of a one-off blackout (NULL for weekly blackouts)';
+
+-- Row-Level Security
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.room_blackouts TO authenticated;
+
+ALTER TABLE scheduler.room_blackouts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.room_blackouts FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY room_blackouts_select_policy ON scheduler.room_blackouts
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY room_blackouts_insert_policy ON scheduler.room_blackouts
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY room_blackouts_update_policy ON scheduler.room_blackouts
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY room_blackouts_delete_policy ON scheduler.room_blackouts
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
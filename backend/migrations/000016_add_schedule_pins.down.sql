@@ -0,0 +1 @@
+ALTER TABLE scheduler.schedules DROP COLUMN IF EXISTS pins;
@@ -0,0 +1,5 @@
+-- Pins fix session occurrences to a day/time (and optionally a room) when a schedule is generated.
+-- They are kept with the schedule so the generation request can be repeated or adjusted later.
+ALTER TABLE scheduler.schedules ADD COLUMN pins JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+COMMENT ON COLUMN scheduler.schedules.pins IS 'JSONB array: [{course_session_id, day (0-6), start_time (mins), room_id?}, ...]';
@@ -0,0 +1,6 @@
+DROP POLICY IF EXISTS building_distances_select_policy ON scheduler.building_distances;
+DROP POLICY IF EXISTS building_distances_insert_policy ON scheduler.building_distances;
+DROP POLICY IF EXISTS building_distances_update_policy ON scheduler.building_distances;
+DROP POLICY IF EXISTS building_distances_delete_policy ON scheduler.building_distances;
+
+DROP TABLE IF EXISTS scheduler.building_distances;
@@ -0,0 +1,62 @@
+-- Walking time between buildings. The scheduler leaves at least this long between back-to-back
+-- sessions of the same cohort or instructor in different buildings. Each pair is stored in both directions.
+CREATE TABLE scheduler.building_distances (
+    from_building_id UUID NOT NULL,
+    to_building_id UUID NOT NULL,
+    minutes INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    created_by UUID NOT NULL,
+    PRIMARY KEY (from_building_id, to_building_id)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.building_distances ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+ALTER TABLE scheduler.building_distances
+    ADD CONSTRAINT building_distances_from_building_id_fkey
+    FOREIGN KEY (from_building_id) REFERENCES scheduler.buildings(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.building_distances
+    ADD CONSTRAINT building_distances_to_building_id_fkey
+    FOREIGN KEY (to_building_id) REFERENCES scheduler.buildings(id) ON DELETE CASCADE;
+
+-- Constraints
+ALTER TABLE scheduler.building_distances
+    ADD CONSTRAINT CHK_BuildingDistanceDifferent CHECK (from_building_id <> to_building_id);
+ALTER TABLE scheduler.building_distances
+    ADD CONSTRAINT CHK_BuildingDistanceMinutes CHECK (minutes BETWEEN 0 AND 1440);
+
+-- Triggers
+CREATE TRIGGER update_building_distances_timestamp
+BEFORE UPDATE ON scheduler.building_distances
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+CREATE TRIGGER set_building_distances_created_by
+BEFORE INSERT ON scheduler.building_distances
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+COMMENT ON TABLE scheduler.building_distances IS 'Walking time between two buildings, stored in both directions';
+COMMENT ON COLUMN scheduler.building_distances.minutes IS 'Walking time in minutes';
+
+-- Row-Level Security
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.building_distances TO authenticated;
+
+ALTER TABLE scheduler.building_distances ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.building_distances FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY building_distances_select_policy ON scheduler.building_distances
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY building_distances_insert_policy ON scheduler.building_distances
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY building_distances_update_policy ON scheduler.building_distances
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY building_distances_delete_policy ON scheduler.building_distances
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
@@ -0,0 +1,6 @@
+DROP POLICY IF EXISTS session_constraints_select_policy ON scheduler.session_constraints;
+DROP POLICY IF EXISTS session_constraints_insert_policy ON scheduler.session_constraints;
+DROP POLICY IF EXISTS session_constraints_update_policy ON scheduler.session_constraints;
+DROP POLICY IF EXISTS session_constraints_delete_policy ON scheduler.session_constraints;
+
+DROP TABLE IF EXISTS scheduler.session_constraints;
@@ -0,0 +1,73 @@
+-- Ordering and separation rules between the session types of one course, e.g. "the lab comes after
+-- the lecture" or "lectures are at least two days apart". The scheduler enforces them and reports
+-- any it could not meet.
+CREATE TABLE scheduler.session_constraints (
+    id UUID PRIMARY KEY,
+    course_id UUID NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    first_type scheduler.course_session_type NOT NULL,
+    second_type scheduler.course_session_type NOT NULL,
+    min_days INT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    created_by UUID NOT NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.session_constraints ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+ALTER TABLE scheduler.session_constraints
+    ADD CONSTRAINT session_constraints_course_id_fkey
+    FOREIGN KEY (course_id) REFERENCES scheduler.courses(id) ON DELETE CASCADE;
+
+-- Constraints
+ALTER TABLE scheduler.session_constraints
+    ADD CONSTRAINT CHK_SessionConstraintKind CHECK (kind IN ('after', 'min_days_apart', 'consecutive'));
+ALTER TABLE scheduler.session_constraints
+    ADD CONSTRAINT CHK_SessionConstraintMinDays CHECK (
+        (kind = 'min_days_apart' AND min_days BETWEEN 1 AND 6) OR (kind <> 'min_days_apart' AND min_days IS NULL)
+    );
+ALTER TABLE scheduler.session_constraints
+    ADD CONSTRAINT CHK_SessionConstraintTypes CHECK (kind = 'min_days_apart' OR first_type <> second_type);
+ALTER TABLE scheduler.session_constraints
+    ADD CONSTRAINT UQ_SessionConstraint UNIQUE (course_id, kind, first_type, second_type, created_by);
+
+CREATE INDEX idx_session_constraints_course_id ON scheduler.session_constraints(course_id);
+
+-- Triggers
+CREATE TRIGGER update_session_constraints_timestamp
+BEFORE UPDATE ON scheduler.session_constraints
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+CREATE TRIGGER set_session_constraints_created_by
+BEFORE INSERT ON scheduler.session_constraints
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+COMMENT ON TABLE scheduler.session_constraints IS 'Ordering and separation rules between the session types of a course';
+COMMENT ON COLUMN scheduler.session_constraints.kind IS 'after, min_days_apart or consecutive';
+COMMENT ON COLUMN scheduler.session_constraints.first_type IS 'Session type the rule is relative to, e.g. the lecture a lab follows';
+COMMENT ON COLUMN scheduler.session_constraints.second_type IS 'Session type the rule places, e.g. the lab that follows a lecture';
+COMMENT ON COLUMN scheduler.session_constraints.min_days IS 'Fewest days between the two types (min_days_apart only)';
+
+-- Row-Level Security
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.session_constraints TO authenticated;
+
+ALTER TABLE scheduler.session_constraints ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.session_constraints FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY session_constraints_select_policy ON scheduler.session_constraints
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY session_constraints_insert_policy ON scheduler.session_constraints
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY session_constraints_update_policy ON scheduler.session_constraints
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY session_constraints_delete_policy ON scheduler.session_constraints
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
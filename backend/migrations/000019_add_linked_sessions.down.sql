@@ -0,0 +1,5 @@
+ALTER TABLE scheduler.course_sessions DROP CONSTRAINT IF EXISTS course_sessions_parallel_sections_check;
+ALTER TABLE scheduler.course_sessions DROP COLUMN IF EXISTS parallel_sections;
+
+ALTER TABLE scheduler.course_sessions DROP CONSTRAINT IF EXISTS course_sessions_blocks_check;
+ALTER TABLE scheduler.course_sessions DROP COLUMN IF EXISTS blocks;
@@ -0,0 +1,13 @@
+-- Linked sessions: each weekly occurrence runs as several back-to-back blocks in one room (e.g. a
+-- double-period lab), as several sections at the same time in different rooms (e.g. tutorials split
+-- into groups), or both. NULL means 1. The scheduler places every part of an occurrence or none of it.
+ALTER TABLE scheduler.course_sessions ADD COLUMN blocks INTEGER NULL;
+ALTER TABLE scheduler.course_sessions
+    ADD CONSTRAINT course_sessions_blocks_check CHECK (blocks BETWEEN 1 AND 4);
+
+ALTER TABLE scheduler.course_sessions ADD COLUMN parallel_sections INTEGER NULL;
+ALTER TABLE scheduler.course_sessions
+    ADD CONSTRAINT course_sessions_parallel_sections_check CHECK (parallel_sections BETWEEN 1 AND 20);
+
+COMMENT ON COLUMN scheduler.course_sessions.blocks IS 'Back-to-back blocks of duration minutes per occurrence, in the same room (NULL for 1)';
+COMMENT ON COLUMN scheduler.course_sessions.parallel_sections IS 'Sections meeting at the same time in different rooms per occurrence (NULL for 1)';
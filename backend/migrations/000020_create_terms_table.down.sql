@@ -0,0 +1,43 @@
+-- Revert to one active schedule and globally unique names per user
+CREATE OR REPLACE FUNCTION scheduler.deactivate_other_schedules()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- Deactivate all other schedules for the same user
+    UPDATE scheduler.schedules
+    SET is_active = FALSE
+    WHERE is_active = TRUE
+    AND id <> NEW.id
+    AND created_by = NEW.created_by;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS scheduler.idx_single_active_schedule_per_term;
+CREATE UNIQUE INDEX idx_single_active_schedule_per_user
+ON scheduler.schedules(created_by)
+WHERE is_active = TRUE;
+
+ALTER TABLE scheduler.schedules DROP CONSTRAINT IF EXISTS schedules_name_term_id_created_by_unique;
+ALTER TABLE scheduler.schedules
+    ADD CONSTRAINT schedules_name_created_by_unique UNIQUE (name, created_by);
+ALTER TABLE scheduler.schedules DROP CONSTRAINT IF EXISTS schedules_term_id_fkey;
+ALTER TABLE scheduler.schedules DROP COLUMN IF EXISTS term_id;
+
+ALTER TABLE scheduler.course_sessions DROP CONSTRAINT IF EXISTS course_sessions_unique;
+ALTER TABLE scheduler.course_sessions
+    ADD CONSTRAINT course_sessions_unique UNIQUE (course_id, type, created_by);
+ALTER TABLE scheduler.course_sessions DROP CONSTRAINT IF EXISTS course_sessions_term_id_fkey;
+ALTER TABLE scheduler.course_sessions DROP COLUMN IF EXISTS term_id;
+
+DROP POLICY IF EXISTS term_courses_select_policy ON scheduler.term_courses;
+DROP POLICY IF EXISTS term_courses_insert_policy ON scheduler.term_courses;
+DROP POLICY IF EXISTS term_courses_update_policy ON scheduler.term_courses;
+DROP POLICY IF EXISTS term_courses_delete_policy ON scheduler.term_courses;
+
+DROP POLICY IF EXISTS terms_select_policy ON scheduler.terms;
+DROP POLICY IF EXISTS terms_insert_policy ON scheduler.terms;
+DROP POLICY IF EXISTS terms_update_policy ON scheduler.terms;
+DROP POLICY IF EXISTS terms_delete_policy ON scheduler.terms;
+
+DROP TABLE IF EXISTS scheduler.term_courses;
+DROP TABLE IF EXISTS scheduler.terms;
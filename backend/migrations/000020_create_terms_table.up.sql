@@ -0,0 +1,149 @@
+-- Academic terms (e.g., "Fall 2025"). Courses are offered per term, course sessions may belong to
+-- a single term, and schedules are generated for one term at a time with one active schedule each.
+CREATE TABLE scheduler.terms (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    teaching_weeks INT NOT NULL,
+    holidays JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    created_by UUID NOT NULL
+);
+
+-- Foreign key constraint
+ALTER TABLE scheduler.terms ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+
+-- Constraints
+ALTER TABLE scheduler.terms
+    ADD CONSTRAINT terms_name_created_by_unique UNIQUE (name, created_by);
+ALTER TABLE scheduler.terms
+    ADD CONSTRAINT CHK_TermDates CHECK (end_date >= start_date);
+ALTER TABLE scheduler.terms
+    ADD CONSTRAINT CHK_TermTeachingWeeks CHECK (teaching_weeks > 0);
+
+-- Triggers
+CREATE TRIGGER update_terms_timestamp
+BEFORE UPDATE ON scheduler.terms
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+CREATE TRIGGER set_terms_created_by
+BEFORE INSERT ON scheduler.terms
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+-- Courses offered in each term
+CREATE TABLE scheduler.term_courses (
+    term_id UUID NOT NULL,
+    course_id UUID NOT NULL,
+    created_by UUID NOT NULL,
+    PRIMARY KEY (term_id, course_id)
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.term_courses ADD FOREIGN KEY (term_id) REFERENCES scheduler.terms(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.term_courses ADD FOREIGN KEY (course_id) REFERENCES scheduler.courses(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.term_courses ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+
+-- Triggers
+CREATE TRIGGER set_term_courses_created_by
+BEFORE INSERT ON scheduler.term_courses
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+-- Course sessions may be tied to one term; NULL means every term the course is offered in.
+-- A course may define the same session type once per term, plus once for all terms.
+-- A term's own sessions are deleted with it, which needs its schedules to be deleted first.
+ALTER TABLE scheduler.course_sessions ADD COLUMN term_id UUID NULL;
+ALTER TABLE scheduler.course_sessions
+    ADD CONSTRAINT course_sessions_term_id_fkey
+    FOREIGN KEY (term_id) REFERENCES scheduler.terms(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.course_sessions DROP CONSTRAINT IF EXISTS course_sessions_unique;
+ALTER TABLE scheduler.course_sessions
+    ADD CONSTRAINT course_sessions_unique UNIQUE NULLS NOT DISTINCT (course_id, type, term_id, created_by);
+
+-- Schedules belong to a term; names are unique and one schedule is active per term.
+-- Schedules without a term keep the old behaviour among themselves.
+-- A term can't be deleted while it has schedules, archived or not.
+ALTER TABLE scheduler.schedules ADD COLUMN term_id UUID NULL;
+ALTER TABLE scheduler.schedules
+    ADD CONSTRAINT schedules_term_id_fkey
+    FOREIGN KEY (term_id) REFERENCES scheduler.terms(id) ON DELETE RESTRICT;
+ALTER TABLE scheduler.schedules DROP CONSTRAINT IF EXISTS schedules_name_created_by_unique;
+ALTER TABLE scheduler.schedules
+    ADD CONSTRAINT schedules_name_term_id_created_by_unique UNIQUE NULLS NOT DISTINCT (name, term_id, created_by);
+
+DROP INDEX IF EXISTS scheduler.idx_single_active_schedule_per_user;
+CREATE UNIQUE INDEX idx_single_active_schedule_per_term
+ON scheduler.schedules(created_by, term_id) NULLS NOT DISTINCT
+WHERE is_active = TRUE;
+
+CREATE OR REPLACE FUNCTION scheduler.deactivate_other_schedules()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- Deactivate all other schedules for the same user and term
+    UPDATE scheduler.schedules
+    SET is_active = FALSE
+    WHERE is_active = TRUE
+    AND id <> NEW.id
+    AND created_by = NEW.created_by
+    AND term_id IS NOT DISTINCT FROM NEW.term_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Database catalog comments
+COMMENT ON TABLE scheduler.terms IS 'Academic terms that courses are offered in and schedules are generated for';
+COMMENT ON COLUMN scheduler.terms.start_date IS 'First day of the term';
+COMMENT ON COLUMN scheduler.terms.end_date IS 'Last day of the term';
+COMMENT ON COLUMN scheduler.terms.teaching_weeks IS 'Weeks of teaching in the term, not counting holidays';
+COMMENT ON COLUMN scheduler.terms.holidays IS 'JSONB array: [{name, start_date, end_date}, ...] of days without teaching';
+COMMENT ON TABLE scheduler.term_courses IS 'Courses offered in a term';
+COMMENT ON COLUMN scheduler.course_sessions.term_id IS 'Term this session is offered in (NULL for every term of its course)';
+COMMENT ON COLUMN scheduler.schedules.term_id IS 'Term the schedule was generated for (NULL if not tied to a term)';
+
+-- Row-Level Security
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.terms TO authenticated;
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.term_courses TO authenticated;
+
+ALTER TABLE scheduler.terms ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.terms FORCE ROW LEVEL SECURITY;
+
+ALTER TABLE scheduler.term_courses ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.term_courses FORCE ROW LEVEL SECURITY;
+
+-- Terms policies
+CREATE POLICY terms_select_policy ON scheduler.terms
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY terms_insert_policy ON scheduler.terms
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY terms_update_policy ON scheduler.terms
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY terms_delete_policy ON scheduler.terms
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+-- Term Courses policies
+CREATE POLICY term_courses_select_policy ON scheduler.term_courses
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY term_courses_insert_policy ON scheduler.term_courses
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY term_courses_update_policy ON scheduler.term_courses
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY term_courses_delete_policy ON scheduler.term_courses
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
@@ -0,0 +1,8 @@
+DROP POLICY IF EXISTS calendar_feeds_select_policy ON scheduler.calendar_feeds;
+DROP POLICY IF EXISTS calendar_feeds_insert_policy ON scheduler.calendar_feeds;
+DROP POLICY IF EXISTS calendar_feeds_update_policy ON scheduler.calendar_feeds;
+DROP POLICY IF EXISTS calendar_feeds_delete_policy ON scheduler.calendar_feeds;
+
+DROP FUNCTION IF EXISTS scheduler.calendar_feed_owner(TEXT);
+
+DROP TABLE IF EXISTS scheduler.calendar_feeds;
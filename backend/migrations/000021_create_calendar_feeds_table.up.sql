@@ -0,0 +1,83 @@
+-- Calendar feeds share the active schedule as an iCalendar feed at an unguessable URL that calendar
+-- clients can poll without signing in. A feed may be narrowed to a course, room or building.
+CREATE TABLE scheduler.calendar_feeds (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    token VARCHAR(64) NOT NULL,
+    term_id UUID NULL,
+    course_id UUID NULL,
+    room_id UUID NULL,
+    building_id UUID NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NULL,
+    created_by UUID NOT NULL
+);
+
+-- Foreign key constraints
+ALTER TABLE scheduler.calendar_feeds ADD FOREIGN KEY (created_by) REFERENCES auth.users(id);
+ALTER TABLE scheduler.calendar_feeds
+    ADD CONSTRAINT calendar_feeds_term_id_fkey
+    FOREIGN KEY (term_id) REFERENCES scheduler.terms(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.calendar_feeds
+    ADD CONSTRAINT calendar_feeds_course_id_fkey
+    FOREIGN KEY (course_id) REFERENCES scheduler.courses(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.calendar_feeds
+    ADD CONSTRAINT calendar_feeds_room_id_fkey
+    FOREIGN KEY (room_id) REFERENCES scheduler.rooms(id) ON DELETE CASCADE;
+ALTER TABLE scheduler.calendar_feeds
+    ADD CONSTRAINT calendar_feeds_building_id_fkey
+    FOREIGN KEY (building_id) REFERENCES scheduler.buildings(id) ON DELETE CASCADE;
+
+-- Constraints
+ALTER TABLE scheduler.calendar_feeds
+    ADD CONSTRAINT calendar_feeds_token_unique UNIQUE (token);
+
+-- Triggers
+CREATE TRIGGER update_calendar_feeds_timestamp
+BEFORE UPDATE ON scheduler.calendar_feeds
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_timestamp();
+
+CREATE TRIGGER set_calendar_feeds_created_by
+BEFORE INSERT ON scheduler.calendar_feeds
+FOR EACH ROW
+EXECUTE FUNCTION scheduler.update_created_by();
+
+-- Feed requests carry no user, so the owner of a token is looked up past row-level security.
+-- Only the owner is returned; the feed itself is then read as that user.
+CREATE OR REPLACE FUNCTION scheduler.calendar_feed_owner(feed_token TEXT)
+RETURNS UUID AS $$
+    SELECT created_by FROM scheduler.calendar_feeds WHERE token = feed_token;
+$$ LANGUAGE sql STABLE SECURITY DEFINER SET search_path = scheduler, pg_temp;
+
+REVOKE ALL ON FUNCTION scheduler.calendar_feed_owner(TEXT) FROM PUBLIC;
+GRANT EXECUTE ON FUNCTION scheduler.calendar_feed_owner(TEXT) TO authenticated;
+
+COMMENT ON TABLE scheduler.calendar_feeds IS 'Shareable iCalendar feeds of the active schedule';
+COMMENT ON COLUMN scheduler.calendar_feeds.token IS 'Unguessable token in the feed URL; deleting the feed revokes it';
+COMMENT ON COLUMN scheduler.calendar_feeds.term_id IS 'Term whose active schedule the feed shows (NULL for the active schedule without a term)';
+COMMENT ON COLUMN scheduler.calendar_feeds.course_id IS 'Only sessions of this course (NULL for every course)';
+COMMENT ON COLUMN scheduler.calendar_feeds.room_id IS 'Only sessions in this room (NULL for every room)';
+COMMENT ON COLUMN scheduler.calendar_feeds.building_id IS 'Only sessions in rooms of this building (NULL for every building)';
+
+-- Row-Level Security
+GRANT SELECT, INSERT, UPDATE, DELETE ON scheduler.calendar_feeds TO authenticated;
+
+ALTER TABLE scheduler.calendar_feeds ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scheduler.calendar_feeds FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY calendar_feeds_select_policy ON scheduler.calendar_feeds
+    FOR SELECT
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY calendar_feeds_insert_policy ON scheduler.calendar_feeds
+    FOR INSERT
+    WITH CHECK (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY calendar_feeds_update_policy ON scheduler.calendar_feeds
+    FOR UPDATE
+    USING (created_by = current_setting('app.current_user_id')::UUID);
+
+CREATE POLICY calendar_feeds_delete_policy ON scheduler.calendar_feeds
+    FOR DELETE
+    USING (created_by = current_setting('app.current_user_id')::UUID);